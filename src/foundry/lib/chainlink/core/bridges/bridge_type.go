@@ -21,6 +21,17 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// CircuitBreakerFailureThreshold is the number of consecutive failed
+	// requests after which requests to the bridge are short-circuited, 0
+	// disables the circuit breaker.
+	CircuitBreakerFailureThreshold uint32          `json:"circuitBreakerFailureThreshold"`
+	CircuitBreakerCoolDown         models.Interval `json:"circuitBreakerCoolDown"`
+	// FallbackURLs are tried after URL, according to URLStrategy.
+	FallbackURLs []models.WebURL `json:"fallbackURLs"`
+	URLStrategy  URLStrategy     `json:"urlStrategy"`
+	// HedgeDelay is how long a hedged request waits for a response before
+	// the request is also sent to the next URL.
+	HedgeDelay models.Interval `json:"hedgeDelay"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -62,6 +73,13 @@ type BridgeType struct {
 	MinimumContractPayment *assets.Link
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
+
+	CircuitBreakerFailureThreshold uint32
+	CircuitBreakerCoolDown         models.Interval
+
+	FallbackURLs WebURLs `db:"fallback_urls"`
+	URLStrategy  URLStrategy
+	HedgeDelay   models.Interval
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -92,6 +110,13 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+
+			CircuitBreakerFailureThreshold: btr.CircuitBreakerFailureThreshold,
+			CircuitBreakerCoolDown:         btr.CircuitBreakerCoolDown,
+
+			FallbackURLs: btr.FallbackURLs,
+			URLStrategy:  btr.URLStrategy,
+			HedgeDelay:   btr.HedgeDelay,
 		}, nil
 }
 
@@ -0,0 +1,215 @@
+package bridges
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultCircuitBreakerCoolDown is used when a bridge enables the circuit
+// breaker without configuring a cool-down.
+const DefaultCircuitBreakerCoolDown = 30 * time.Second
+
+var ErrCircuitOpen = errors.New("bridge circuit breaker is open")
+
+// CircuitState is the state of a bridge circuit breaker.
+type CircuitState string
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects all requests until the cool-down has passed.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single probe request through, which decides
+	// whether the circuit closes again or re-opens.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// CircuitBreakerState is a snapshot of a bridge circuit breaker.
+type CircuitBreakerState struct {
+	State               CircuitState
+	ConsecutiveFailures uint32
+	FailureThreshold    uint32
+	CoolDown            time.Duration
+	OpenedAt            *time.Time
+	LastError           string
+}
+
+// CircuitBreaker tracks consecutive failed requests to a bridge. Once
+// FailureThreshold requests in a row have failed the circuit opens and
+// requests are rejected with ErrCircuitOpen instead of being sent. After the
+// cool-down a single probe request is let through: if it succeeds the circuit
+// closes, otherwise it opens again. A threshold of 0 disables the breaker.
+type CircuitBreaker struct {
+	name BridgeName
+	now  func() time.Time
+
+	mu                  sync.Mutex
+	failureThreshold    uint32
+	coolDown            time.Duration
+	state               CircuitState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	probing             bool
+	lastError           string
+}
+
+func newCircuitBreaker(name BridgeName, now func() time.Time) *CircuitBreaker {
+	return &CircuitBreaker{name: name, now: now, state: CircuitClosed}
+}
+
+func (cb *CircuitBreaker) configure(failureThreshold uint32, coolDown time.Duration) {
+	if coolDown <= 0 {
+		coolDown = DefaultCircuitBreakerCoolDown
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.failureThreshold = failureThreshold
+	cb.coolDown = coolDown
+	if failureThreshold == 0 {
+		cb.reset()
+	}
+}
+
+func (cb *CircuitBreaker) reset() {
+	cb.state = CircuitClosed
+	cb.consecutiveFailures = 0
+	cb.probing = false
+}
+
+// Allow returns ErrCircuitOpen if a request to the bridge must not be sent.
+// Every allowed request must be followed by a call to Record or Release.
+func (cb *CircuitBreaker) Allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case CircuitOpen:
+		if remaining := cb.coolDown - cb.now().Sub(cb.openedAt); remaining > 0 {
+			return fmt.Errorf("%w for bridge %s, retrying in %s: %s", ErrCircuitOpen, cb.name, remaining.Round(time.Millisecond), cb.lastError)
+		}
+		cb.state = CircuitHalfOpen
+		cb.probing = true
+		return nil
+	case CircuitHalfOpen:
+		if cb.probing {
+			return fmt.Errorf("%w for bridge %s, waiting for probe request: %s", ErrCircuitOpen, cb.name, cb.lastError)
+		}
+		cb.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record updates the breaker with the outcome of an allowed request. A nil
+// error counts as a success.
+func (cb *CircuitBreaker) Record(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.failureThreshold == 0 {
+		return
+	}
+	if err == nil {
+		cb.reset()
+		return
+	}
+
+	cb.lastError = err.Error()
+	cb.consecutiveFailures++
+	if cb.state == CircuitHalfOpen || cb.consecutiveFailures >= cb.failureThreshold {
+		cb.state = CircuitOpen
+		cb.openedAt = cb.now()
+		cb.probing = false
+	}
+}
+
+// Release ends an allowed request without recording an outcome, e.g. when it
+// was cancelled by the node. If it was the probe of a half-open circuit, the
+// next request is let through as the probe.
+func (cb *CircuitBreaker) Release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.probing = false
+}
+
+// State returns a snapshot of the breaker.
+func (cb *CircuitBreaker) State() CircuitBreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	s := CircuitBreakerState{
+		State:               cb.state,
+		ConsecutiveFailures: cb.consecutiveFailures,
+		FailureThreshold:    cb.failureThreshold,
+		CoolDown:            cb.coolDown,
+		LastError:           cb.lastError,
+	}
+	if cb.state != CircuitClosed {
+		openedAt := cb.openedAt
+		s.OpenedAt = &openedAt
+	}
+	return s
+}
+
+// CircuitBreakers holds the circuit breaker of every bridge used by the node.
+type CircuitBreakers struct {
+	now func() time.Time
+
+	mu       sync.RWMutex
+	breakers map[BridgeName]*CircuitBreaker
+}
+
+func NewCircuitBreakers() *CircuitBreakers {
+	return &CircuitBreakers{now: time.Now, breakers: make(map[BridgeName]*CircuitBreaker)}
+}
+
+// Get returns the circuit breaker of the bridge, configured with the
+// bridge's current threshold and cool-down.
+func (cbs *CircuitBreakers) Get(bt BridgeType) *CircuitBreaker {
+	cbs.mu.Lock()
+	cb, ok := cbs.breakers[bt.Name]
+	if !ok {
+		cb = newCircuitBreaker(bt.Name, cbs.now)
+		cbs.breakers[bt.Name] = cb
+	}
+	cbs.mu.Unlock()
+
+	cb.configure(bt.CircuitBreakerFailureThreshold, bt.CircuitBreakerCoolDown.Duration())
+	return cb
+}
+
+// State returns a snapshot of the bridge's circuit breaker, if it has been
+// used since the node started.
+func (cbs *CircuitBreakers) State(name BridgeName) (CircuitBreakerState, bool) {
+	cbs.mu.RLock()
+	cb, ok := cbs.breakers[name]
+	cbs.mu.RUnlock()
+	if !ok {
+		return CircuitBreakerState{}, false
+	}
+	return cb.State(), true
+}
+
+// HealthReport reports every bridge whose circuit is not closed as unhealthy.
+func (cbs *CircuitBreakers) HealthReport() map[string]error {
+	cbs.mu.RLock()
+	names := make([]BridgeName, 0, len(cbs.breakers))
+	for name := range cbs.breakers {
+		names = append(names, name)
+	}
+	cbs.mu.RUnlock()
+
+	report := make(map[string]error, len(names))
+	for _, name := range names {
+		state, _ := cbs.State(name)
+		if state.FailureThreshold == 0 {
+			continue
+		}
+		var err error
+		if state.State != CircuitClosed {
+			err = fmt.Errorf("circuit %s after %d consecutive failures: %s", state.State, state.ConsecutiveFailures, state.LastError)
+		}
+		report["BridgeCircuitBreaker."+name.String()] = err
+	}
+	return report
+}
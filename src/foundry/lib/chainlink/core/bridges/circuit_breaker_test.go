@@ -0,0 +1,111 @@
+package bridges_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	bt := bridges.BridgeType{
+		Name:                           bridges.MustParseBridgeName("flaky"),
+		CircuitBreakerFailureThreshold: 2,
+		CircuitBreakerCoolDown:         *models.NewInterval(50 * time.Millisecond),
+	}
+	failure := errors.New("connection refused")
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		cbs := bridges.NewCircuitBreakers()
+		cb := cbs.Get(bt)
+
+		require.NoError(t, cb.Allow())
+		cb.Record(failure)
+		require.NoError(t, cb.Allow())
+		cb.Record(nil)
+		require.NoError(t, cb.Allow())
+		cb.Record(failure)
+		assert.Equal(t, bridges.CircuitClosed, cb.State().State, "a success resets the failure count")
+
+		require.NoError(t, cb.Allow())
+		cb.Record(failure)
+		state := cb.State()
+		assert.Equal(t, bridges.CircuitOpen, state.State)
+		assert.Equal(t, uint32(2), state.ConsecutiveFailures)
+		assert.Equal(t, "connection refused", state.LastError)
+		require.NotNil(t, state.OpenedAt)
+
+		require.ErrorIs(t, cb.Allow(), bridges.ErrCircuitOpen)
+		assert.Error(t, cbs.HealthReport()["BridgeCircuitBreaker.flaky"])
+	})
+
+	t.Run("half-open probe closes the circuit on success", func(t *testing.T) {
+		cbs := bridges.NewCircuitBreakers()
+		cb := cbs.Get(bt)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow())
+			cb.Record(failure)
+		}
+
+		require.Eventually(t, func() bool { return cb.Allow() == nil }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, bridges.CircuitHalfOpen, cb.State().State)
+		require.ErrorIs(t, cb.Allow(), bridges.ErrCircuitOpen, "only a single probe is let through")
+
+		cb.Record(nil)
+		assert.Equal(t, bridges.CircuitClosed, cb.State().State)
+		require.NoError(t, cb.Allow())
+		assert.NoError(t, cbs.HealthReport()["BridgeCircuitBreaker.flaky"])
+	})
+
+	t.Run("half-open probe re-opens the circuit on failure", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers().Get(bt)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow())
+			cb.Record(failure)
+		}
+
+		require.Eventually(t, func() bool { return cb.Allow() == nil }, time.Second, 10*time.Millisecond)
+		cb.Record(failure)
+		assert.Equal(t, bridges.CircuitOpen, cb.State().State)
+		require.ErrorIs(t, cb.Allow(), bridges.ErrCircuitOpen)
+	})
+
+	t.Run("released probe lets the next request probe", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers().Get(bt)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow())
+			cb.Record(failure)
+		}
+
+		require.Eventually(t, func() bool { return cb.Allow() == nil }, time.Second, 10*time.Millisecond)
+		cb.Release()
+		state := cb.State()
+		assert.Equal(t, bridges.CircuitHalfOpen, state.State)
+		assert.Equal(t, uint32(2), state.ConsecutiveFailures)
+
+		require.NoError(t, cb.Allow())
+		require.ErrorIs(t, cb.Allow(), bridges.ErrCircuitOpen)
+		cb.Record(nil)
+		assert.Equal(t, bridges.CircuitClosed, cb.State().State)
+	})
+
+	t.Run("disabled without a threshold", func(t *testing.T) {
+		cbs := bridges.NewCircuitBreakers()
+		disabled := bt
+		disabled.CircuitBreakerFailureThreshold = 0
+		cb := cbs.Get(disabled)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, cb.Allow())
+			cb.Record(failure)
+		}
+		assert.Equal(t, bridges.CircuitClosed, cb.State().State)
+		assert.Empty(t, cbs.HealthReport())
+	})
+}
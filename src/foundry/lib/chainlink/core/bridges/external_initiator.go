@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -29,10 +30,49 @@ type ExternalInitiator struct {
 	OutgoingSecret string
 	OutgoingToken  string
 
+	// LastSuccessAt is the time of the last notification the external
+	// initiator accepted.
+	LastSuccessAt null.Time
+	// LastFailureAt is the time of the last notification the external
+	// initiator failed to accept.
+	LastFailureAt null.Time
+	// ConsecutiveFailures is the number of failed notifications since the
+	// last accepted one.
+	ConsecutiveFailures int64
+	LastError           null.String
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// ExternalInitiatorHealth is the health of the notifications to an external
+// initiator.
+type ExternalInitiatorHealth string
+
+const (
+	// ExternalInitiatorHealthUnknown is the health of external initiators
+	// which have not been notified yet.
+	ExternalInitiatorHealthUnknown ExternalInitiatorHealth = "unknown"
+	// ExternalInitiatorHealthHealthy is the health of external initiators
+	// which accepted their last notification.
+	ExternalInitiatorHealthHealthy ExternalInitiatorHealth = "healthy"
+	// ExternalInitiatorHealthUnhealthy is the health of external initiators
+	// which failed to accept their last notification.
+	ExternalInitiatorHealthUnhealthy ExternalInitiatorHealth = "unhealthy"
+)
+
+// Health returns the health of the notifications to the external initiator.
+func (ei ExternalInitiator) Health() ExternalInitiatorHealth {
+	switch {
+	case ei.ConsecutiveFailures > 0:
+		return ExternalInitiatorHealthUnhealthy
+	case ei.LastSuccessAt.Valid:
+		return ExternalInitiatorHealthHealthy
+	default:
+		return ExternalInitiatorHealthUnknown
+	}
+}
+
 // NewExternalInitiator generates an ExternalInitiator from an
 // auth.Token, hashing the password for storage
 func NewExternalInitiator(
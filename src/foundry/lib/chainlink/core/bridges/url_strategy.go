@@ -0,0 +1,136 @@
+package bridges
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// URLStrategy defines how a bridge with fallback URLs picks the URL(s) a
+// request is sent to.
+type URLStrategy string
+
+const (
+	// URLStrategyFallback sends the request to the primary URL and moves on
+	// to the next URL, in order, if the request fails with a retryable error.
+	URLStrategyFallback URLStrategy = "fallback"
+	// URLStrategyRoundRobin rotates the URL the first attempt is sent to,
+	// then falls back like URLStrategyFallback.
+	URLStrategyRoundRobin URLStrategy = "round-robin"
+	// URLStrategyHedged sends the request to the primary URL, and to the next
+	// URL every time HedgeDelay passes without a successful response. The
+	// first successful response wins.
+	URLStrategyHedged URLStrategy = "hedged"
+	// URLStrategyFastest sends the request to all URLs at once, the first
+	// successful response wins.
+	URLStrategyFastest URLStrategy = "fastest"
+)
+
+// ParseURLStrategy returns the URLStrategy, an empty string is the default
+// fallback strategy.
+func ParseURLStrategy(val string) (URLStrategy, error) {
+	switch s := URLStrategy(val); s {
+	case "":
+		return URLStrategyFallback, nil
+	case URLStrategyFallback, URLStrategyRoundRobin, URLStrategyHedged, URLStrategyFastest:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown URL strategy %q, must be one of %s, %s, %s or %s", val,
+			URLStrategyFallback, URLStrategyRoundRobin, URLStrategyHedged, URLStrategyFastest)
+	}
+}
+
+// UnmarshalJSON parses the URLStrategy.
+func (s *URLStrategy) UnmarshalJSON(input []byte) error {
+	var aux string
+	if err := json.Unmarshal(input, &aux); err != nil {
+		return err
+	}
+	strategy, err := ParseURLStrategy(aux)
+	*s = strategy
+	return err
+}
+
+// String returns this URLStrategy as a string.
+func (s URLStrategy) String() string {
+	return string(s)
+}
+
+// Value returns this instance serialized for database storage.
+func (s URLStrategy) Value() (driver.Value, error) {
+	if s == "" {
+		return string(URLStrategyFallback), nil
+	}
+	return string(s), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (s *URLStrategy) Scan(value interface{}) error {
+	temp, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("unable to convert %v of %T to URLStrategy", value, value)
+	}
+	*s = URLStrategy(temp)
+	return nil
+}
+
+// WebURLs is a list of URLs stored as a postgres text array.
+type WebURLs []models.WebURL
+
+// Value returns this instance serialized for database storage.
+func (u WebURLs) Value() (driver.Value, error) {
+	arr := make(pq.StringArray, len(u))
+	for i, url := range u {
+		arr[i] = url.String()
+	}
+	return arr.Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (u *WebURLs) Scan(value interface{}) error {
+	var arr pq.StringArray
+	if err := arr.Scan(value); err != nil {
+		return err
+	}
+	urls := make(WebURLs, len(arr))
+	for i, s := range arr {
+		if err := urls[i].Scan(s); err != nil {
+			return err
+		}
+	}
+	*u = urls
+	return nil
+}
+
+// URLs returns the primary URL of the bridge followed by its fallback URLs.
+func (bt BridgeType) URLs() []models.WebURL {
+	return append([]models.WebURL{bt.URL}, bt.FallbackURLs...)
+}
+
+// RoundRobin hands out the index of the URL the next request to a bridge
+// using URLStrategyRoundRobin starts with.
+type RoundRobin struct {
+	mu   sync.Mutex
+	next map[BridgeName]int
+}
+
+func NewRoundRobin() *RoundRobin {
+	return &RoundRobin{next: make(map[BridgeName]int)}
+}
+
+// Next returns the index of the URL to start with out of n URLs.
+func (rr *RoundRobin) Next(name BridgeName, n int) int {
+	if n <= 1 {
+		return 0
+	}
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	i := rr.next[name] % n
+	rr.next[name] = (i + 1) % n
+	return i
+}
@@ -74,6 +74,16 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Action: s.Status,
 			Flags:  []cli.Flag{},
 		},
+		{
+			Name:        "roles",
+			Usage:       "Create, edit, assign or delete custom roles with fine-grained permissions",
+			Subcommands: initRolesSubCmds(s),
+		},
+		{
+			Name:        "tokens",
+			Usage:       "Create, list or revoke named API tokens",
+			Subcommands: initAPITokensSubCmds(s),
+		},
 		{
 			Name:  "users",
 			Usage: "Create, edit permissions, or delete API users",
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initAPITokensSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "Lists the named API tokens of the current user, or of every user with --all",
+			Action: s.ListAPITokens,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "all",
+					Usage: "list the tokens of every user, requires the users.manage permission",
+				},
+				cli.StringFlag{
+					Name:  "email",
+					Usage: "with --all, only list the tokens of the user with email",
+				},
+			},
+		},
+		{
+			Name:   "create",
+			Usage:  "Create a named API token for the current user",
+			Action: s.CreateAPIToken,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "name of the token, unique per user",
+					Required: true,
+				},
+				cli.StringSliceFlag{
+					Name:  "permission, p",
+					Usage: "permission the token is scoped to, e.g. 'runs.create' or 'jobs.create:type=webhook'. May be repeated. Defaults to every permission of the user",
+				},
+				cli.DurationFlag{
+					Name:  "expires-in",
+					Usage: "duration after which the token expires, e.g. 720h. Defaults to never",
+				},
+			},
+		},
+		{
+			Name:   "revoke",
+			Usage:  "Revoke a named API token of the current user, or of another user with --email",
+			Action: s.RevokeAPIToken,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "name of the token to revoke",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "email",
+					Usage: "email of the user of the token, requires the users.manage permission",
+				},
+			},
+		},
+	}
+}
+
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"User", "Name", "Access key", "Permissions", "Expires at", "Last used at", "Last used IP", "Created at"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	permissions := "(all of user)"
+	if p.Permissions != nil {
+		permissions = strings.Join(p.Permissions, "\n")
+	}
+	expiresAt := "never"
+	if p.ExpiresAt != nil {
+		expiresAt = p.ExpiresAt.String()
+		if p.Expired {
+			expiresAt += " (expired)"
+		}
+	}
+	lastUsedAt := ""
+	if p.LastUsedAt != nil {
+		lastUsedAt = p.LastUsedAt.String()
+	}
+	return []string{
+		p.UserEmail,
+		p.Name,
+		p.AccessKey,
+		permissions,
+		expiresAt,
+		lastUsedAt,
+		p.LastUsedIP,
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	renderList(apiTokensTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	if p.Secret != "" {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nSecret: %s\nThe secret is only shown once, store it now.\n", p.Secret))); err != nil {
+			return err
+		}
+	}
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the named API tokens of the current user, or of every user
+func (s *Shell) ListAPITokens(c *cli.Context) (err error) {
+	path := "/v2/user/tokens"
+	if c.Bool("all") {
+		path = "/v2/api_tokens"
+		if email := c.String("email"); email != "" {
+			path += "?email=" + email
+		}
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the current user, after prompting for its password
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	request := struct {
+		Name        string     `json:"name"`
+		Password    string     `json:"password"`
+		Permissions []string   `json:"permissions"`
+		ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	}{
+		Name:        c.String("name"),
+		Permissions: c.StringSlice("permission"),
+	}
+	if !c.IsSet("permission") {
+		request.Permissions = nil
+	}
+	if c.IsSet("expires-in") {
+		if c.Duration("expires-in") <= 0 {
+			return s.errorOut(errors.New("--expires-in must be positive"))
+		}
+		expiresAt := time.Now().Add(c.Duration("expires-in"))
+		request.ExpiresAt = &expiresAt
+	}
+
+	fmt.Println("Password of current user:")
+	request.Password = s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &APITokenPresenter{}, "Successfully created API token")
+}
+
+// RevokeAPIToken revokes a named API token by name
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	path := "/v2/user/tokens/" + c.String("name")
+	if email := c.String("email"); email != "" {
+		path = "/v2/api_tokens/" + email + "/" + c.String("name")
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Printf("Revoked API token %s\n", c.String("name"))
+	return nil
+}
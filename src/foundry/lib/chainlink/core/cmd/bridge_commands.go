@@ -2,11 +2,14 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -50,12 +53,27 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyFallbackURLs converts the fallback URLs to a string
+func (p *BridgePresenter) FriendlyFallbackURLs() string {
+	return strings.Join(p.FallbackURLs, ", ")
+}
+
+// FriendlyURLStrategy converts the URL strategy and hedge delay to a string
+func (p *BridgePresenter) FriendlyURLStrategy() string {
+	if p.URLStrategy == bridges.URLStrategyHedged {
+		return fmt.Sprintf("%s after %s", p.URLStrategy, p.HedgeDelay.Duration())
+	}
+	return p.URLStrategy.String()
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Fallback URLs", "URL Strategy", "Default Confirmations", "Outgoing Token"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
+		p.FriendlyFallbackURLs(),
+		p.FriendlyURLStrategy(),
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
 	})
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -24,6 +25,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	var (
 		name          = "Bridge 1"
 		url           = "http://example.com"
+		fallbackURL   = "http://backup.example.com"
 		createdAt     = time.Now()
 		outgoingToken = "anoutgoingtoken"
 		buffer        = bytes.NewBufferString("")
@@ -38,6 +40,9 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
 			CreatedAt:     createdAt,
+			FallbackURLs:  []string{fallbackURL},
+			URLStrategy:   bridges.URLStrategyHedged,
+			HedgeDelay:    *models.NewInterval(200 * time.Millisecond),
 		},
 	}
 
@@ -47,6 +52,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	output := buffer.String()
 	assert.Contains(t, output, name)
 	assert.Contains(t, output, url)
+	assert.Contains(t, output, fallbackURL)
+	assert.Contains(t, output, "hedged after 200ms")
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
 
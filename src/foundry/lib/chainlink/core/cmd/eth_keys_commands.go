@@ -41,6 +41,27 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 					},
 				},
 			},
+			{
+				Name:   "add-remote",
+				Usage:  "Add a key held by a remote signer configured in [[RemoteSigners]]",
+				Action: s.AddRemoteETHKey,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "backend",
+						Usage:    "name of the remote signer holding the key",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "key-id, keyID",
+						Usage:    "ID of the key in the remote signer",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "evm-chain-id, evmChainID",
+						Usage: "Chain ID for the key. If left blank, default chain will be used.",
+					},
+				},
+			},
 			{
 				Name:   "list",
 				Usage:  "List available Ethereum accounts with their ETH & LINK balances and other metadata",
@@ -220,6 +241,33 @@ func (s *Shell) CreateETHKey(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key created.\n\n🔑 New key")
 }
 
+// AddRemoteETHKey adds an ethereum key held by a remote signer, the private
+// key never enters the node's keystore.
+func (s *Shell) AddRemoteETHKey(c *cli.Context) (err error) {
+	addURL := url.URL{
+		Path: "/v2/keys/evm/remote",
+	}
+	query := addURL.Query()
+	query.Set("backend", c.String("backend"))
+	query.Set("keyID", c.String("key-id"))
+	if c.IsSet("evm-chain-id") {
+		query.Set("evmChainID", c.String("evm-chain-id"))
+	}
+
+	addURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), addURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key added.\n\n🔑 New key")
+}
+
 // DeleteETHKey hard deletes an Ethereum key,
 // address of key must be passed
 func (s *Shell) DeleteETHKey(c *cli.Context) (err error) {
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"strconv"
+
 	"github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -32,7 +34,7 @@ type ExternalInitiatorPresenter struct {
 }
 
 func (eip *ExternalInitiatorPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "Health", "ConsecutiveFailures", "CreatedAt", "UpdatedAt"})
 	table.Append(eip.ToRow())
 	render("External Initiator:", table)
 	return nil
@@ -49,6 +51,8 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 		urlS,
 		eip.AccessKey,
 		eip.OutgoingToken,
+		string(eip.Health.Status),
+		strconv.FormatInt(eip.Health.ConsecutiveFailures, 10),
 		eip.CreatedAt.String(),
 		eip.UpdatedAt.String(),
 	}
@@ -57,7 +61,7 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 type ExternalInitiatorPresenters []ExternalInitiatorPresenter
 
 func (eips *ExternalInitiatorPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "Health", "ConsecutiveFailures", "CreatedAt", "UpdatedAt"})
 	for _, eip := range *eips {
 		table.Append(eip.ToRow())
 	}
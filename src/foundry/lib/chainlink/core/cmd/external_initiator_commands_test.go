@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -34,8 +35,12 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 			URL:           url,
 			AccessKey:     accessKey,
 			OutgoingToken: outgoingToken,
-			CreatedAt:     createdAt,
-			UpdatedAt:     updatedAt,
+			Health: presenters.ExternalInitiatorHealth{
+				Status:              bridges.ExternalInitiatorHealthUnhealthy,
+				ConsecutiveFailures: 3,
+			},
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
 		},
 	}
 
@@ -47,6 +52,7 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url.String())
 	assert.Contains(t, output, accessKey)
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "unhealthy")
 
 	// Render many resources
 	buffer.Reset()
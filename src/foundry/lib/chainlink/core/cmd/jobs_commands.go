@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,7 +16,10 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -40,16 +47,144 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Create a job",
 			Action: s.CreateJob,
 		},
+		{
+			Name:   "dry-run",
+			Usage:  "Execute the pipeline of a job spec without saving the job, sending transactions or persisting results",
+			Action: s.DryRunJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object or path to a JSON file with the variables to run the pipeline with",
+				},
+				cli.StringFlag{
+					Name:  "mocks",
+					Usage: `JSON object or path to a JSON file mapping task names to mocked results, e.g. {"ds1": {"value": {"price": 123}}, "ds2": {"error": "timeout"}}`,
+				},
+			},
+		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Stop the services of a job until it is resumed",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Start the services of a paused job",
+			Action: s.ResumeJob,
+		},
+		{
+			Name:   "export",
+			Usage:  "Export the jobs of the node, with the bridges, external initiators and forwarders they depend on, to an archive",
+			Action: s.ExportJobs,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "path where the archive will be saved",
+				},
+			},
+		},
+		{
+			Name:   "import",
+			Usage:  "Import the jobs of an archive, with the bridges and forwarders they depend on, in one transaction",
+			Action: s.ImportJobs,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "versions",
+			Usage:  "List the versions of the spec of a job",
+			Action: s.ListJobVersions,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the difference between a version of the spec of a job and an earlier one",
+			Action: s.DiffJobVersions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "from",
+					Usage: "version to compare with, defaults to the previous version",
+				},
+			},
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Re-apply an earlier version of the spec of a job",
+			Action: s.RollbackJob,
+		},
+		{
+			Name:  "runs",
+			Usage: "Commands for inspecting job runs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List job runs, latest first, optionally filtered",
+					Action: s.ListPipelineRuns,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "job",
+							Usage: "only list the runs of the job with this ID",
+						},
+						cli.BoolFlag{
+							Name:  "failed",
+							Usage: "only list errored runs",
+						},
+						cli.StringFlag{
+							Name:  "state",
+							Usage: "only list runs in these comma separated states (running, suspended, errored, completed)",
+						},
+						cli.StringFlag{
+							Name:  "since",
+							Usage: "only list runs created after this RFC3339 time or duration ago, e.g. 2h",
+						},
+						cli.StringFlag{
+							Name:  "until",
+							Usage: "only list runs created before this RFC3339 time or duration ago",
+						},
+						cli.StringFlag{
+							Name:  "error",
+							Usage: "only list runs with an error containing this text",
+						},
+						cli.StringFlag{
+							Name:  "failed-task-type",
+							Usage: "only list runs in which a task of this type errored, e.g. http",
+						},
+						cli.StringFlag{
+							Name:  "bridge",
+							Usage: "only list runs of pipelines calling the bridge with this name",
+						},
+						cli.StringFlag{
+							Name:  "min-output",
+							Usage: "only list runs with a numeric output of at least this value",
+						},
+						cli.StringFlag{
+							Name:  "max-output",
+							Usage: "only list runs with a numeric output of at most this value",
+						},
+						cli.BoolFlag{
+							Name:  "errors",
+							Usage: "display the number of runs failed with each error instead of the runs",
+						},
+					},
+				},
+				{
+					Name:   "replay",
+					Usage:  "Re-execute a finished run from its recorded task results and show where the results differ",
+					Action: s.ReplayPipelineRun,
+				},
+			},
+		},
 	}
 }
 
@@ -280,6 +415,211 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// JobDryRunPresenter wraps the JSONAPI dry run resource and adds rendering functionality
+type JobDryRunPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobDryRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobDryRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Mocked", "Result", "Attempts", "Duration"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			fmt.Sprintf("%t", tr.Mocked),
+			resultString(tr.Output, tr.Error),
+			fmt.Sprintf("%d", tr.Attempts),
+			tr.Duration,
+		})
+	}
+	render("Dry Run Task Runs", table)
+
+	if len(p.Transactions) > 0 {
+		txs := rt.newTable([]string{"Task", "EVM Chain ID", "From", "To", "Data", "Gas Limit"})
+		for _, tx := range p.Transactions {
+			gasLimit := ""
+			if tx.GasLimit != nil {
+				gasLimit = fmt.Sprintf("%d", *tx.GasLimit)
+			}
+			txs.Append([]string{tx.DotID, tx.EVMChainID, strings.Join(tx.From, ", "), tx.To, tx.Data, gasLimit})
+		}
+		render("Recorded Transactions", txs)
+	}
+
+	outputs := rt.newTable([]string{"Output", "Fatal Error"})
+	for i := range p.Outputs {
+		var fatalErr *string
+		if i < len(p.FatalErrors) {
+			fatalErr = p.FatalErrors[i]
+		}
+		outputs.Append([]string{resultString(p.Outputs[i], nil), resultString(nil, fatalErr)})
+	}
+	render("Dry Run Outputs", outputs)
+	return nil
+}
+
+// DryRunJob executes a job spec without side effects
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) DryRunJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.DryRunJobRequest{TOML: tomlString}
+	if c.IsSet("vars") {
+		buf, err2 := getBufferFromJSON(c.String("vars"))
+		if err2 != nil {
+			return s.errorOut(err2)
+		}
+		if err2 = json.Unmarshal(buf.Bytes(), &request.Vars); err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "invalid vars"))
+		}
+	}
+	if c.IsSet("mocks") {
+		buf, err2 := getBufferFromJSON(c.String("mocks"))
+		if err2 != nil {
+			return s.errorOut(err2)
+		}
+		if err2 = json.Unmarshal(buf.Bytes(), &request.MockOutputs); err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "invalid mocks"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/dry_run", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobDryRunPresenter{})
+}
+
+// JobVersionPresenter wraps the JSONAPI job version resource and adds rendering functionality
+type JobVersionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobVersionResource
+}
+
+// ToRow presents the job version as a row
+func (p JobVersionPresenter) ToRow() []string {
+	return []string{
+		strconv.Itoa(int(p.Version)),
+		p.Author,
+		strconv.Itoa(int(p.PipelineSpecID)),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *JobVersionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Author", "Pipeline Spec ID", "Created At"})
+	table.Append(p.ToRow())
+	render(fmt.Sprintf("Job %d", p.JobID), table)
+	return nil
+}
+
+type JobVersionPresenters []JobVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Author", "Pipeline Spec ID", "Created At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Job Versions", table)
+	return nil
+}
+
+// JobVersionDiffPresenter wraps the JSONAPI job version diff resource and adds rendering functionality
+type JobVersionDiffPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobVersionDiffResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobVersionDiffPresenter) RenderTable(rt RendererTable) error {
+	if p.Diff == "" {
+		_, err := fmt.Fprintf(rt, "Versions %d and %d of job %d are identical\n", p.From, p.To, p.JobID)
+		return err
+	}
+	_, err := io.WriteString(rt, p.Diff)
+	return err
+}
+
+// ListJobVersions lists the versions of a job
+func (s *Shell) ListJobVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/versions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobVersionPresenters{})
+}
+
+// DiffJobVersions shows the unified diff between two versions of a job
+func (s *Shell) DiffJobVersions(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must provide the id of the job and a version"))
+	}
+	path := "/v2/jobs/" + c.Args().Get(0) + "/versions/" + c.Args().Get(1) + "/diff"
+	if c.IsSet("from") {
+		path += "?from=" + strconv.Itoa(c.Int("from"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobVersionDiffPresenter{})
+}
+
+// RollbackJob re-applies an earlier version of a job
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must provide the id of the job and the version to roll back to"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/versions/"+c.Args().Get(1)+"/rollback", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobVersionPresenter{}, fmt.Sprintf("Job %s rolled back to version %s", c.Args().Get(0), c.Args().Get(1)))
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -298,6 +638,114 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job until it is resumed
+func (s *Shell) PauseJob(c *cli.Context) (err error) {
+	return s.setJobPaused(c, "pause")
+}
+
+// ResumeJob starts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) (err error) {
+	return s.setJobPaused(c, "resume")
+}
+
+func (s *Shell) setJobPaused(c *cli.Context, action string) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.Errorf("must pass the id of the job to %s", action))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{})
+}
+
+// ExportJobs saves the archive of the jobs of the node to a file
+func (s *Shell) ExportJobs(c *cli.Context) (err error) {
+	path := c.String("output")
+	if path == "" {
+		return s.errorOut(errors.New("must specify --output/-o flag"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/export")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting: %w", httpError(resp)))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "could not read response body"))
+	}
+	var archive jobarchive.Archive
+	if err = json.Unmarshal(b, &archive); err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid archive"))
+	}
+	if err = utils.WriteFileWithMaxPerms(path, b, 0o600); err != nil {
+		return s.errorOut(errors.Wrapf(err, "could not write %v", path))
+	}
+
+	fmt.Printf("Exported %d jobs, %d bridges, %d external initiators and %d forwarders to %s\n",
+		len(archive.Jobs), len(archive.Bridges), len(archive.ExternalInitiators), len(archive.Forwarders), path)
+	for _, skipped := range archive.Skipped {
+		fmt.Printf("Skipped %s %s: %s\n", skipped.Kind, skipped.Name, skipped.Reason)
+	}
+	return nil
+}
+
+// ImportJobs imports the jobs of an archive file
+func (s *Shell) ImportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path of the archive to import"))
+	}
+	b, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "could not read archive"))
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/import", bytes.NewReader(b))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobImportPresenter{})
+}
+
+// JobImportPresenter wraps the JSONAPI job import resource and adds rendering functionality
+type JobImportPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobImportResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobImportPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Kind", "Name", "Status", "Reason"})
+	for _, i := range p.Imported {
+		table.Append([]string{i.Kind, i.Name, "imported", ""})
+	}
+	for _, skipped := range p.Skipped {
+		table.Append([]string{skipped.Kind, skipped.Name, "skipped", skipped.Reason})
+	}
+	render("Job Import", table)
+	return nil
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -317,3 +765,174 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI run replay resource and adds rendering functionality
+type PipelineRunReplayPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Recorded", "Recorded Result", "Replayed Result", "Match"})
+	for _, tr := range p.TaskRuns {
+		match := "n/a"
+		if tr.RecordedOutput != nil || tr.RecordedError != nil {
+			match = fmt.Sprintf("%t", tr.Matches)
+		}
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			fmt.Sprintf("%t", tr.Recorded),
+			resultString(tr.RecordedOutput, tr.RecordedError),
+			resultString(tr.ReplayedOutput, tr.ReplayedError),
+			match,
+		})
+	}
+	render(fmt.Sprintf("Replay of run %s (job %d)", p.ID, p.JobID), table)
+
+	summary := rt.newTable([]string{"Recorded Outputs", "Replayed Outputs", "Match"})
+	summary.Append([]string{
+		jsonString(p.RecordedOutputs),
+		jsonString(p.ReplayedOutputs),
+		fmt.Sprintf("%t", p.Matches),
+	})
+	render("Outputs", summary)
+	return nil
+}
+
+func jsonString(v jsonserializable.JSONSerializable) string {
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+func resultString(output, errString *string) string {
+	if errString != nil {
+		return "error: " + *errString
+	}
+	if output != nil {
+		return *output
+	}
+	return ""
+}
+
+// PipelineRunPresenter wraps the JSONAPI pipeline run resource and adds rendering functionality
+type PipelineRunPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunResource
+}
+
+// ToRow presents the PipelineRunPresenter as a slice of strings.
+func (p *PipelineRunPresenter) ToRow() []string {
+	var finishedAt string
+	if p.FinishedAt.Valid {
+		finishedAt = p.FinishedAt.Time.String()
+	}
+	var errs []string
+	for _, e := range p.AllErrors {
+		if e != nil {
+			errs = append(errs, *e)
+		}
+	}
+	return []string{
+		p.ID,
+		strconv.Itoa(int(p.PipelineSpec.JobID)),
+		string(p.State),
+		p.CreatedAt.String(),
+		finishedAt,
+		strings.Join(errs, "\n"),
+	}
+}
+
+type PipelineRunPresenters []PipelineRunPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineRunPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Job", "State", "Created At", "Finished At", "Errors"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Job Runs", table)
+	return nil
+}
+
+// PipelineRunErrorCountPresenter wraps the JSONAPI error count resource and adds rendering functionality
+type PipelineRunErrorCountPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunErrorCountResource
+}
+
+type PipelineRunErrorCountPresenters []PipelineRunErrorCountPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineRunErrorCountPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Runs", "Error"})
+	for _, p := range ps {
+		table.Append([]string{strconv.FormatInt(p.Count, 10), p.Error})
+	}
+
+	render("Job Run Errors", table)
+	return nil
+}
+
+// ListPipelineRuns lists the pipeline runs selected by the filter flags, or
+// the number of them failed with each error
+func (s *Shell) ListPipelineRuns(c *cli.Context) (err error) {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"job":              "jobID",
+		"state":            "state",
+		"since":            "since",
+		"until":            "until",
+		"error":            "error",
+		"failed-task-type": "failedTaskType",
+		"bridge":           "bridge",
+		"min-output":       "minOutput",
+		"max-output":       "maxOutput",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	if c.Bool("failed") {
+		q.Set("failed", "true")
+	}
+
+	if c.Bool("errors") {
+		resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline/runs/errors?"+q.Encode())
+		if err != nil {
+			return s.errorOut(err)
+		}
+		defer func() {
+			if cerr := resp.Body.Close(); cerr != nil {
+				err = multierr.Append(err, cerr)
+			}
+		}()
+		return s.renderAPIResponse(resp, &PipelineRunErrorCountPresenters{})
+	}
+
+	return s.getPage("/v2/pipeline/runs?"+q.Encode(), c.Int("page"), &PipelineRunPresenters{})
+}
+
+// ReplayPipelineRun re-executes a finished pipeline run and displays the
+// difference between the recorded and recomputed results
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the run to replay"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+}
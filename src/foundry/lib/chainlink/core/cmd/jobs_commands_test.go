@@ -3,8 +3,12 @@ package cmd_test
 import (
 	"bytes"
 	_ "embed"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -21,7 +25,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -453,6 +459,169 @@ func TestShell_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestShell_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+	})
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{getDirectRequestSpec()}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+	jobs, _, err := app.JobORM().FindJobs(testutils.Context(t), 0, 1000)
+	require.NoError(t, err)
+	jobID := jobs[0].ID
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	require.Equal(t, "must pass the id of the job to pause", client.PauseJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.PauseJob(cli.NewContext(nil, set, nil)))
+	paused := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.NotNil(t, paused.PausedAt)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+	requireJobsCount(t, app.JobORM(), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ResumeJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.ResumeJob(cli.NewContext(nil, set, nil)))
+	resumed := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.Nil(t, resumed.PausedAt)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+}
+
+func TestShell_RollbackJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+	})
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{getDirectRequestSpec()}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply the job id and the version
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.Equal(t, "must provide the id of the job and the version to roll back to", client.RollbackJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID, "1"}))
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+	rolledBack := *r.Renders[len(r.Renders)-1].(*cmd.JobVersionPresenter)
+	assert.Equal(t, int32(2), rolledBack.Version)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListJobVersions, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.ListJobVersions(cli.NewContext(nil, set, nil)))
+	versions := *r.Renders[len(r.Renders)-1].(*cmd.JobVersionPresenters)
+	require.Len(t, versions, 2)
+	assert.Equal(t, versions[0].Spec, versions[1].Spec)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DiffJobVersions, set, "")
+	require.NoError(t, set.Parse([]string{"--from", "1", output.ID, "2"}))
+	require.NoError(t, client.DiffJobVersions(cli.NewContext(nil, set, nil)))
+	diff := *r.Renders[len(r.Renders)-1].(*cmd.JobVersionDiffPresenter)
+	assert.Equal(t, int32(1), diff.From)
+	assert.Empty(t, diff.Diff)
+
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
+func TestShell_ExportImportJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	externalJobID := uuid.New()
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String())}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ExportJobs, set, "")
+	require.Equal(t, "must specify --output/-o flag", client.ExportJobs(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ExportJobs, set, "")
+	require.NoError(t, set.Parse([]string{"--output", path}))
+	require.NoError(t, client.ExportJobs(cli.NewContext(nil, set, nil)))
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var archive jobarchive.Archive
+	require.NoError(t, json.Unmarshal(b, &archive))
+	require.Len(t, archive.Jobs, 1)
+	assert.Equal(t, externalJobID, archive.Jobs[0].ExternalJobID)
+	assert.Len(t, archive.Bridges, 2)
+
+	// move the job to a node without it and one of its bridges
+	jobID, err := strconv.ParseInt(created.ID, 10, 32)
+	require.NoError(t, err)
+	require.NoError(t, app.DeleteJob(ctx, int32(jobID)))
+	require.NoError(t, app.BridgeORM().DeleteBridgeType(ctx, fetchBridge))
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ImportJobs, set, "")
+	require.NoError(t, set.Parse([]string{path}))
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	report := *r.Renders[len(r.Renders)-1].(*cmd.JobImportPresenter)
+	assert.ElementsMatch(t, []jobarchive.Imported{
+		{Kind: jobarchive.KindBridge, Name: fetchBridge.Name.String()},
+		{Kind: jobarchive.KindJob, Name: externalJobID.String()},
+	}, report.Imported)
+	assert.Equal(t, []jobarchive.Skipped{
+		{Kind: jobarchive.KindBridge, Name: submitBridge.Name.String(), Reason: "already exists"},
+	}, report.Skipped)
+
+	jb, err := app.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+	require.NoError(t, err)
+	versions, err := app.JobORM().FindJobVersions(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, jobarchive.VersionAuthor, versions[0].Author)
+	assert.Equal(t, archive.Jobs[0].Spec, versions[0].Spec)
+
+	// importing again changes nothing
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	report = *r.Renders[len(r.Renders)-1].(*cmd.JobImportPresenter)
+	assert.Empty(t, report.Imported)
+	assert.Len(t, report.Skipped, 3)
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	ctx := testutils.Context(t)
 	jobs, _, err := orm.FindJobs(ctx, 0, 1000)
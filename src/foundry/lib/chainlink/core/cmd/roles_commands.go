@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initRolesSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "Lists the built-in and custom roles and their permissions",
+			Action: s.ListRoles,
+		},
+		{
+			Name:   "show",
+			Usage:  "Show a role's permissions",
+			Action: s.ShowRole,
+		},
+		{
+			Name:   "permissions",
+			Usage:  "Lists the permissions which can be granted to custom roles",
+			Action: s.ListPermissions,
+		},
+		{
+			Name:   "create",
+			Usage:  "Create a custom role",
+			Action: s.CreateRole,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "name of the new role",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "description",
+					Usage: "description of the new role",
+				},
+				cli.StringSliceFlag{
+					Name:  "permission, p",
+					Usage: "permission granted to the role, e.g. 'keys.export', 'jobs.create:type=webhook' or 'bridges.*'. May be repeated",
+				},
+			},
+		},
+		{
+			Name:   "update",
+			Usage:  "Update the description or permissions of a custom role",
+			Action: s.UpdateRole,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "name of the role to update",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "description",
+					Usage: "new description of the role",
+				},
+				cli.StringSliceFlag{
+					Name:  "permission, p",
+					Usage: "permission granted to the role, replacing its permissions. May be repeated",
+				},
+			},
+		},
+		{
+			Name:   "delete",
+			Usage:  "Delete a custom role which isn't assigned",
+			Action: s.DeleteRole,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "name of the role to delete",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "assign",
+			Usage:  "Assign a custom role to an API user, replacing the permissions of its built-in role",
+			Action: s.AssignRole,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "email",
+					Usage:    "email of the user",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "role",
+					Usage:    "name of the custom role",
+					Required: true,
+				},
+				cli.BoolFlag{
+					Name:  "api-token",
+					Usage: "assign the role to the API token of the user instead",
+				},
+			},
+		},
+		{
+			Name:   "unassign",
+			Usage:  "Unassign the custom role of an API user, restoring the permissions of its built-in role",
+			Action: s.UnassignRole,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "email",
+					Usage:    "email of the user",
+					Required: true,
+				},
+				cli.BoolFlag{
+					Name:  "api-token",
+					Usage: "unassign the role of the API token of the user instead",
+				},
+			},
+		},
+	}
+}
+
+type RolePresenter struct {
+	JAID
+	presenters.RoleResource
+}
+
+var rolesTableHeaders = []string{"Name", "Built in", "Description", "Permissions"}
+
+func (p *RolePresenter) ToRow() []string {
+	builtIn := "false"
+	if p.BuiltIn {
+		builtIn = "true"
+	}
+	return []string{
+		p.Name,
+		builtIn,
+		p.Description,
+		strings.Join(p.Permissions, "\n"),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *RolePresenter) RenderTable(rt RendererTable) error {
+	renderList(rolesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type RolePresenters []RolePresenter
+
+// RenderTable implements TableRenderer
+func (ps RolePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Roles\n")); err != nil {
+		return err
+	}
+	renderList(rolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type PermissionPresenter struct {
+	JAID
+	presenters.PermissionResource
+}
+
+var permissionsTableHeaders = []string{"Permission", "Description", "Attributes"}
+
+func (p *PermissionPresenter) ToRow() []string {
+	return []string{p.ID, p.Description, strings.Join(p.Attributes, ", ")}
+}
+
+type PermissionPresenters []PermissionPresenter
+
+// RenderTable implements TableRenderer
+func (ps PermissionPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Permissions\n")); err != nil {
+		return err
+	}
+	renderList(permissionsTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRoles renders the built-in and custom roles
+func (s *Shell) ListRoles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RolePresenters{})
+}
+
+// ShowRole renders a role by name
+func (s *Shell) ShowRole(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the role to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles/"+c.Args().First(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RolePresenter{})
+}
+
+// ListPermissions renders the permissions which can be granted to custom roles
+func (s *Shell) ListPermissions(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/permissions", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PermissionPresenters{})
+}
+
+// CreateRole creates a custom role from its permissions
+func (s *Shell) CreateRole(c *cli.Context) (err error) {
+	request := struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Permissions []string `json:"permissions"`
+	}{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Permissions: c.StringSlice("permission"),
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/roles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RolePresenter{}, "Successfully created role")
+}
+
+// UpdateRole changes the description or replaces the permissions of a custom role
+func (s *Shell) UpdateRole(c *cli.Context) (err error) {
+	request := struct {
+		Description *string  `json:"description,omitempty"`
+		Permissions []string `json:"permissions,omitempty"`
+	}{}
+	if c.IsSet("description") {
+		description := c.String("description")
+		request.Description = &description
+	}
+	if c.IsSet("permission") {
+		request.Permissions = c.StringSlice("permission")
+	}
+	if request.Description == nil && request.Permissions == nil {
+		return s.errorOut(errors.New("must pass a new description or permissions"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/roles/"+c.String("name"), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RolePresenter{}, "Successfully updated role")
+}
+
+// DeleteRole deletes a custom role by name
+func (s *Shell) DeleteRole(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+c.String("name"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RolePresenter{}, "Successfully deleted role")
+}
+
+// AssignRole assigns a custom role to an API user or its API token
+func (s *Shell) AssignRole(c *cli.Context) (err error) {
+	return s.setCustomRole(c, c.String("role"), "Successfully assigned role")
+}
+
+// UnassignRole unassigns the custom role of an API user or its API token
+func (s *Shell) UnassignRole(c *cli.Context) (err error) {
+	return s.setCustomRole(c, "", "Successfully unassigned role")
+}
+
+func (s *Shell) setCustomRole(c *cli.Context, role string, header string) (err error) {
+	request := struct {
+		Email    string `json:"email"`
+		Role     string `json:"role"`
+		APIToken bool   `json:"apiToken"`
+	}{
+		Email:    c.String("email"),
+		Role:     role,
+		APIToken: c.Bool("api-token"),
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/users/custom_role", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminUsersPresenter{}, header)
+}
@@ -246,7 +246,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		Logger:                   appLggr,
 		Registerer:               appRegisterer,
 		AuditLogger:              auditLogger,
-		ExternalInitiatorManager: webhook.NewExternalInitiatorManager(ds, unrestrictedClient),
+		ExternalInitiatorManager: webhook.NewExternalInitiatorManager(ds, unrestrictedClient, appLggr),
 		Version:                  static.Version,
 		RestrictedHTTPClient:     clhttp.NewRestrictedHTTPClient(cfg.Database(), appLggr),
 		UnrestrictedHTTPClient:   unrestrictedClient,
@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -251,6 +252,36 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "keys",
+			Usage: "Commands for managing the keystore locally.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypts the keystore with a new password. The node must not be running",
+					Action: s.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "old-password",
+							Usage: "text file holding the current password of the keystore. Defaults to the keystore password of the secrets",
+						},
+						cli.StringFlag{
+							Name:     "new-password",
+							Usage:    "text file holding the new password of the keystore",
+							Required: true,
+						},
+						cli.IntFlag{
+							Name:  "scrypt-n",
+							Usage: "scrypt N parameter to encrypt the keystore with, a power of 2. Defaults to the parameter the node uses",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-p",
+							Usage: "scrypt P parameter to encrypt the keystore with. Defaults to the parameter the node uses",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "remove-blocks",
 			Usage:  "Deletes block range and all associated data",
@@ -1059,3 +1090,81 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// RotateKeystorePassword re-encrypts the keystore with a new password and
+// scrypt parameters. It holds the database lock, so that a running node does
+// not save the keystore with the old password meanwhile.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error reading new password from file"))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "new password is too weak"))
+	}
+	if c.IsSet("old-password") {
+		oldPassword, err2 := utils.PasswordFromFile(c.String("old-password"))
+		if err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "error reading old password from file"))
+		}
+		s.Config.SetPasswords(&oldPassword, nil)
+	}
+
+	scryptParams := utils.GetScryptParams(s.Config)
+	if c.IsSet("scrypt-n") {
+		scryptParams.N = c.Int("scrypt-n")
+	}
+	if c.IsSet("scrypt-p") {
+		scryptParams.P = c.Int("scrypt-p")
+	}
+	if scryptParams.N <= 1 || scryptParams.N&(scryptParams.N-1) != 0 {
+		return s.errorOut(errors.New("--scrypt-n must be a power of 2 greater than 1"))
+	}
+	if scryptParams.P < 1 {
+		return s.errorOut(errors.New("--scrypt-p must be positive"))
+	}
+
+	cfg := s.Config
+	if err = cfg.Validate(); err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %w", err))
+	}
+	oldPassword := cfg.Password().Keystore()
+	if oldPassword == "" {
+		return s.errorOut(errors.New("no old password provided, pass --old-password or set the keystore password in the secrets"))
+	}
+	if oldPassword == newPassword {
+		return s.errorOut(errors.New("new password must differ from the old password"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ctx := s.ctx()
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	if err = ldb.Open(ctx); err != nil {
+		return s.errorOut(errors.Wrap(err, "opening db, make sure the node is not running"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	if err = keystore.RotatePassword(ctx, ldb.DB(), oldPassword, newPassword, scryptParams); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to rotate keystore password"))
+	}
+	lggr.Infow("Rotated keystore password", "scryptN", scryptParams.N, "scryptP", scryptParams.P)
+
+	auditLogger, err := audit.NewAuditLogger(lggr, cfg.AuditLogger())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "keystore password was rotated, but the audit logger failed"))
+	}
+	// the audit logger is only ready when it is enabled
+	if auditLogger.Ready() == nil {
+		if err = auditLogger.Start(ctx); err != nil {
+			return s.errorOut(errors.Wrap(err, "keystore password was rotated, but the audit logger failed"))
+		}
+		auditLogger.Audit(audit.KeystorePasswordRotated, map[string]interface{}{
+			"scryptN": scryptParams.N,
+			"scryptP": scryptParams.P,
+		})
+		lggr.ErrorIfFn(auditLogger.Close, "Error closing audit logger")
+	}
+
+	fmt.Println("Keystore password rotated. Update the keystore password of the secrets before starting the node.")
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -563,3 +564,57 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	config, db := heavyweight.FullTestDBV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.DriverName = pgcommon.DriverPostgres
+		s.Password.Keystore = models.NewSecret(cltest.Password)
+	})
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+
+	keyStore := keystore.New(db, utils.FastScryptParams, lggr)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, err := keyStore.Eth().Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+
+	shell := cmd.Shell{
+		Config: config,
+		Logger: lggr,
+	}
+	writePassword := func(password string) string {
+		path := filepath.Join(t.TempDir(), "password.txt")
+		require.NoError(t, os.WriteFile(path, []byte(password), 0600))
+		return path
+	}
+	const newPassword = "n3wP4ssw0rd-for-the-k3yst0re"
+	rotate := func(flags map[string]string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.RotateKeystorePassword, set, "")
+		for name, value := range flags {
+			require.NoError(t, set.Set(name, value))
+		}
+		return shell.RotateKeystorePassword(cli.NewContext(nil, set, nil))
+	}
+
+	t.Run("rejects a weak new password", func(t *testing.T) {
+		require.ErrorContains(t, rotate(map[string]string{"new-password": writePassword("short")}), "new password is too weak")
+	})
+	t.Run("rejects invalid scrypt parameters", func(t *testing.T) {
+		require.ErrorContains(t, rotate(map[string]string{"new-password": writePassword(newPassword), "scrypt-n": "3"}), "--scrypt-n must be a power of 2")
+	})
+	t.Run("rejects a wrong old password", func(t *testing.T) {
+		err := rotate(map[string]string{"new-password": writePassword(newPassword), "old-password": writePassword("wrong-0ld-P4ssw0rd-wrong")})
+		require.ErrorContains(t, err, "unable to decrypt encrypted key ring with the old password")
+	})
+
+	require.NoError(t, rotate(map[string]string{"new-password": writePassword(newPassword), "old-password": "../internal/fixtures/correct_password.txt"}))
+
+	rotated := keystore.New(db, utils.FastScryptParams, lggr)
+	require.Error(t, rotated.Unlock(ctx, cltest.Password))
+	rotated = keystore.New(db, utils.FastScryptParams, lggr)
+	require.NoError(t, rotated.Unlock(ctx, newPassword))
+	rotatedKey, err := rotated.Eth().Get(ctx, key.ID())
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, rotatedKey.Address)
+}
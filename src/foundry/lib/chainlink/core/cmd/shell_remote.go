@@ -274,6 +274,9 @@ func (s *Shell) parseResponse(resp *http.Response) ([]byte, error) {
 		return nil, s.errorOut(multierr.Append(err, errors.New("your credentials may be missing, invalid or you may need to login first using the CLI via 'chainlink admin login'")))
 	}
 
+	if errors.Is(err, errForbidden) && resp.Header.Get("forbidden-required-permission") != "" {
+		return nil, s.errorOut(multierr.Append(err, fmt.Errorf("this action requires the %s permission. The current user %s has '%s' role and cannot perform this action, login with a user whose role grants it via 'chainlink admin login'", resp.Header.Get("forbidden-required-permission"), resp.Header.Get("forbidden-provided-email"), resp.Header.Get("forbidden-provided-role"))))
+	}
 	if errors.Is(err, errForbidden) {
 		return nil, s.errorOut(multierr.Append(err, fmt.Errorf("this action requires %s privileges. The current user %s has '%s' role and cannot perform this action, login with a user that has '%s' role via 'chainlink admin login'", resp.Header.Get("forbidden-required-role"), resp.Header.Get("forbidden-provided-email"), resp.Header.Get("forbidden-provided-role"), resp.Header.Get("forbidden-required-role"))))
 	}
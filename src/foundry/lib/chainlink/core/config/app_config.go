@@ -43,6 +43,7 @@ type AppConfig interface {
 	FluxMonitor() FluxMonitor
 	Insecure() Insecure
 	JobPipeline() JobPipeline
+	JobReconciler() JobReconciler
 	Keeper() Keeper
 	Log() Log
 	Mercury() Mercury
@@ -52,6 +53,7 @@ type AppConfig interface {
 	Password() Password
 	Prometheus() Prometheus
 	Pyroscope() Pyroscope
+	RemoteSigners() []RemoteSigner
 	Sentry() Sentry
 	TelemetryIngress() TelemetryIngress
 	Threshold() Threshold
@@ -0,0 +1,61 @@
+package config
+
+import "time"
+
+// HMAC algorithms supported by HTTPCredentialsHMAC.
+const (
+	HMACAlgorithmSHA256 = "sha256"
+	HMACAlgorithmSHA512 = "sha512"
+)
+
+// JWT signing algorithms supported by HTTPCredentialsJWT.
+const (
+	JWTAlgorithmHS256 = "HS256"
+	JWTAlgorithmRS256 = "RS256"
+	JWTAlgorithmES256 = "ES256"
+)
+
+// HTTPCredentials is a named credential profile used by http and bridge
+// tasks to authenticate their requests. At least one of HMAC, JWT and TLS is
+// set.
+type HTTPCredentials struct {
+	// AllowedHosts are the hosts the profile may authenticate requests to.
+	// A host without a port matches any port.
+	AllowedHosts []string
+
+	HMAC *HTTPCredentialsHMAC
+	JWT  *HTTPCredentialsJWT
+	TLS  *HTTPCredentialsTLS
+}
+
+// HTTPCredentialsHMAC signs every request with a shared key.
+type HTTPCredentialsHMAC struct {
+	Key             string
+	KeyID           string
+	Algorithm       string
+	SignatureHeader string
+	TimestampHeader string
+	KeyIDHeader     string
+}
+
+// HTTPCredentialsJWT issues a short-lived bearer token for every request.
+type HTTPCredentialsJWT struct {
+	// Key is the shared secret for HS256, or a PEM encoded private key for
+	// RS256 and ES256.
+	Key       string
+	Algorithm string
+	KeyID     string
+	Issuer    string
+	Subject   string
+	Audience  string
+	TTL       time.Duration
+}
+
+// HTTPCredentialsTLS presents a client certificate.
+type HTTPCredentialsTLS struct {
+	// CertPEM and KeyPEM are the PEM encoded client certificate and key.
+	CertPEM string
+	KeyPEM  string
+	// CAPEM optionally replaces the system roots used to verify the server.
+	CAPEM string
+}
@@ -6,6 +6,46 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 )
 
+// Behaviors of runs exceeding the run limits of their job.
+const (
+	// RunLimitModeQueue delays runs until they are within the limits.
+	RunLimitModeQueue = "queue"
+	// RunLimitModeDrop rejects runs exceeding the limits.
+	RunLimitModeDrop = "drop"
+)
+
+// RunRetention is a retention policy of the runs of a job. The runs a field
+// doesn't apply to are pruned by JobPipeline.ReaperThreshold.
+type RunRetention struct {
+	// MaxSuccessfulRuns is the number of latest successful runs kept. It can't
+	// keep more than JobPipeline.MaxSuccessfulRuns, which the reaper also
+	// applies to jobs without a policy.
+	MaxSuccessfulRuns *uint64
+	// KeepFailedFor is how long failed runs are kept.
+	KeepFailedFor *time.Duration
+	// KeepTags are the tags of the runs which are never pruned.
+	KeepTags []string
+}
+
+// IsZero returns whether r sets no field.
+func (r RunRetention) IsZero() bool {
+	return r.MaxSuccessfulRuns == nil && r.KeepFailedFor == nil && len(r.KeepTags) == 0
+}
+
+// Merge returns r with the fields set by o overriding its own.
+func (r RunRetention) Merge(o RunRetention) RunRetention {
+	if o.MaxSuccessfulRuns != nil {
+		r.MaxSuccessfulRuns = o.MaxSuccessfulRuns
+	}
+	if o.KeepFailedFor != nil {
+		r.KeepFailedFor = o.KeepFailedFor
+	}
+	if len(o.KeepTags) > 0 {
+		r.KeepTags = o.KeepTags
+	}
+	return r
+}
+
 type JobPipeline interface {
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
@@ -16,4 +56,17 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	HTTPCredentials(name string) *HTTPCredentials
+	// MaxConcurrentRuns is the default limit of concurrent runs of a job, 0 for no limit.
+	MaxConcurrentRuns() uint32
+	// MaxRunsPerMinute is the default limit of runs of a job per minute, 0 for no limit.
+	MaxRunsPerMinute() uint32
+	// RunLimitMode is the default behavior of runs exceeding the limits of their job.
+	RunLimitMode() string
+	// RunRetention is the retention policy of the runs of jobType, zero if it
+	// has none.
+	RunRetention(jobType string) RunRetention
+	// RunArchivePath is the directory the runs pruned by the run reaper are
+	// archived to, empty to not archive them.
+	RunArchivePath() string
 }
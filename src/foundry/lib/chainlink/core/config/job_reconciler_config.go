@@ -0,0 +1,13 @@
+package config
+
+import (
+	"time"
+)
+
+type JobReconciler interface {
+	Enabled() bool
+	Path() string
+	PollInterval() time.Duration
+	DeleteUnlisted() bool
+	DryRun() bool
+}
@@ -0,0 +1,8 @@
+package config
+
+type RemoteSigner interface {
+	Name() string
+	Endpoint() string
+	CACertFile() string
+	InsecureConnection() bool
+}
@@ -1,6 +1,9 @@
 package toml
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"maps"
@@ -47,6 +50,7 @@ type Core struct {
 	Log              Log              `toml:",omitempty"`
 	WebServer        WebServer        `toml:",omitempty"`
 	JobPipeline      JobPipeline      `toml:",omitempty"`
+	JobReconciler    JobReconciler    `toml:",omitempty"`
 	FluxMonitor      FluxMonitor      `toml:",omitempty"`
 	OCR2             OCR2             `toml:",omitempty"`
 	OCR              OCR              `toml:",omitempty"`
@@ -61,6 +65,7 @@ type Core struct {
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
 	Workflows        Workflows        `toml:",omitempty"`
+	RemoteSigners    RemoteSigners    `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -86,6 +91,7 @@ func (c *Core) SetFrom(f *Core) {
 
 	c.WebServer.setFrom(&f.WebServer)
 	c.JobPipeline.setFrom(&f.JobPipeline)
+	c.JobReconciler.setFrom(&f.JobReconciler)
 
 	c.FluxMonitor.setFrom(&f.FluxMonitor)
 	c.OCR2.setFrom(&f.OCR2)
@@ -102,6 +108,9 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	if v := f.RemoteSigners; v != nil {
+		c.RemoteSigners = v
+	}
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -124,15 +133,16 @@ func (c *Core) ValidateConfig() (err error) {
 }
 
 type Secrets struct {
-	Database   DatabaseSecrets          `toml:",omitempty"`
-	Password   Passwords                `toml:",omitempty"`
-	WebServer  WebServerSecrets         `toml:",omitempty"`
-	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
-	Prometheus PrometheusSecrets        `toml:",omitempty"`
-	Mercury    MercurySecrets           `toml:",omitempty"`
-	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
-	EVM        EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
-	P2PKey     P2PKey                   `toml:",omitempty"`
+	Database    DatabaseSecrets          `toml:",omitempty"`
+	Password    Passwords                `toml:",omitempty"`
+	WebServer   WebServerSecrets         `toml:",omitempty"`
+	Pyroscope   PyroscopeSecrets         `toml:",omitempty"`
+	Prometheus  PrometheusSecrets        `toml:",omitempty"`
+	Mercury     MercurySecrets           `toml:",omitempty"`
+	Threshold   ThresholdKeyShareSecrets `toml:",omitempty"`
+	JobPipeline JobPipelineSecrets       `toml:",omitempty"`
+	EVM         EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
+	P2PKey      P2PKey                   `toml:",omitempty"`
 }
 
 type EthKeys struct {
@@ -744,6 +754,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
@@ -788,12 +799,17 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	// Validate OIDC fields when authentication method is OIDCAuth
+	if *w.AuthenticationMethod == string(sessions.OIDCAuth) {
+		return w.OIDC.validate()
+	}
 	// Validate LDAP fields when authentication method is LDAPAuth
 	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
 		return
@@ -970,6 +986,89 @@ func (w *WebServerLDAP) setFrom(f *WebServerLDAP) {
 	}
 }
 
+type WebServerOIDC struct {
+	IssuerURL      *commonconfig.URL
+	ClientID       *string
+	RedirectURL    *commonconfig.URL
+	Scopes         []string
+	GroupsClaim    *string
+	AdminUserGroup *string
+	EditUserGroup  *string
+	RunUserGroup   *string
+	ReadUserGroup  *string
+	SessionTimeout *commonconfig.Duration
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.Scopes; v != nil {
+		w.Scopes = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminUserGroup; v != nil {
+		w.AdminUserGroup = v
+	}
+	if v := f.EditUserGroup; v != nil {
+		w.EditUserGroup = v
+	}
+	if v := f.RunUserGroup; v != nil {
+		w.RunUserGroup = v
+	}
+	if v := f.ReadUserGroup; v != nil {
+		w.ReadUserGroup = v
+	}
+	if v := f.SessionTimeout; v != nil {
+		w.SessionTimeout = v
+	}
+}
+
+func (w *WebServerOIDC) validate() (err error) {
+	if w.IssuerURL == nil || w.IssuerURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.IssuerURL", Msg: "required for OIDC authentication"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ClientID", Msg: "required for OIDC authentication"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.RedirectURL", Msg: "required for OIDC authentication"})
+	}
+	// all RBAC roles must be mapped from a group, like for LDAP
+	for _, group := range []struct {
+		name  string
+		value *string
+	}{
+		{"OIDC.AdminUserGroup", w.AdminUserGroup},
+		{"OIDC.EditUserGroup", w.EditUserGroup},
+		{"OIDC.RunUserGroup", w.RunUserGroup},
+		{"OIDC.ReadUserGroup", w.ReadUserGroup},
+	} {
+		if group.value == nil || *group.value == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: group.name, Msg: "required for OIDC authentication"})
+		}
+	}
+	return err
+}
+
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (w *WebServerOIDCSecrets) setFrom(f *WebServerOIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		w.ClientSecret = v
+	}
+}
+
 type WebServerLDAPSecrets struct {
 	ServerAddress     *models.SecretURL
 	ReadOnlyUserLogin *models.Secret
@@ -990,10 +1089,12 @@ func (w *WebServerLDAPSecrets) setFrom(f *WebServerLDAPSecrets) {
 
 type WebServerSecrets struct {
 	LDAP WebServerLDAPSecrets `toml:",omitempty"`
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
 }
 
 func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	return nil
 }
 
@@ -1005,8 +1106,12 @@ type JobPipeline struct {
 	ReaperThreshold           *commonconfig.Duration
 	ResultWriteQueueDepth     *uint32
 	VerboseLogging            *bool
+	MaxConcurrentRuns         *uint32
+	MaxRunsPerMinute          *uint32
+	RunLimitMode              *string
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest  JobPipelineHTTPRequest  `toml:",omitempty"`
+	RunRetention JobPipelineRunRetention `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -1031,7 +1136,74 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 	if v := f.VerboseLogging; v != nil {
 		j.VerboseLogging = v
 	}
+	if v := f.MaxConcurrentRuns; v != nil {
+		j.MaxConcurrentRuns = v
+	}
+	if v := f.MaxRunsPerMinute; v != nil {
+		j.MaxRunsPerMinute = v
+	}
+	if v := f.RunLimitMode; v != nil {
+		j.RunLimitMode = v
+	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.RunRetention.setFrom(&f.RunRetention)
+}
+
+func (j *JobPipeline) ValidateConfig() (err error) {
+	if j.RunLimitMode != nil {
+		switch *j.RunLimitMode {
+		case config.RunLimitModeQueue, config.RunLimitModeDrop:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "RunLimitMode", Value: *j.RunLimitMode,
+				Msg: fmt.Sprintf("must be %s or %s", config.RunLimitModeQueue, config.RunLimitModeDrop)})
+		}
+	}
+	return err
+}
+
+// JobPipelineRunRetention configures the retention policies of runs applied
+// by the run reaper.
+type JobPipelineRunRetention struct {
+	ArchivePath *string
+	// JobTypes are the retention policies of the runs of each job type.
+	JobTypes map[string]RunRetentionPolicy `toml:",omitempty"`
+}
+
+func (r *JobPipelineRunRetention) setFrom(f *JobPipelineRunRetention) {
+	if v := f.ArchivePath; v != nil {
+		r.ArchivePath = v
+	}
+	if r.JobTypes != nil && f.JobTypes != nil {
+		for k, v := range f.JobTypes {
+			r.JobTypes[k] = v
+		}
+	} else if v := f.JobTypes; v != nil {
+		r.JobTypes = v
+	}
+}
+
+func (r *JobPipelineRunRetention) ValidateConfig() (err error) {
+	for jobType, p := range r.JobTypes {
+		if jobType == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "JobTypes", Msg: "job type must be provided and non-empty"})
+		}
+		if p.KeepFailedFor != nil && p.KeepFailedFor.Duration() <= 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("JobTypes[\"%s\"].KeepFailedFor", jobType), Value: p.KeepFailedFor.Duration(), Msg: "must be greater than zero"})
+		}
+		for _, tag := range p.KeepTags {
+			if tag == "" {
+				err = multierr.Append(err, configutils.ErrEmpty{Name: fmt.Sprintf("JobTypes[\"%s\"].KeepTags", jobType), Msg: "tags must be non-empty"})
+			}
+		}
+	}
+	return err
+}
+
+// RunRetentionPolicy is the retention policy of the runs of a job type.
+type RunRetentionPolicy struct {
+	MaxSuccessfulRuns *uint64
+	KeepFailedFor     *commonconfig.Duration
+	KeepTags          []string
 }
 
 type JobPipelineHTTPRequest struct {
@@ -1048,6 +1220,201 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobReconciler struct {
+	Enabled        *bool
+	Path           *string
+	PollInterval   *commonconfig.Duration
+	DeleteUnlisted *bool
+	DryRun         *bool
+}
+
+func (j *JobReconciler) setFrom(f *JobReconciler) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.Path; v != nil {
+		j.Path = v
+	}
+	if v := f.PollInterval; v != nil {
+		j.PollInterval = v
+	}
+	if v := f.DeleteUnlisted; v != nil {
+		j.DeleteUnlisted = v
+	}
+	if v := f.DryRun; v != nil {
+		j.DryRun = v
+	}
+}
+
+func (j *JobReconciler) ValidateConfig() (err error) {
+	if j.Enabled == nil || !*j.Enabled {
+		return
+	}
+	if j.Path == nil || *j.Path == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Path", Msg: "required when the job reconciler is enabled"})
+	}
+	if j.PollInterval != nil && j.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PollInterval", Value: j.PollInterval.Duration(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type JobPipelineSecrets struct {
+	HTTPCredentials map[string]HTTPCredentials
+}
+
+func (j *JobPipelineSecrets) SetFrom(f *JobPipelineSecrets) (err error) {
+	err = j.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if j.HTTPCredentials != nil && f.HTTPCredentials != nil {
+		for k, v := range f.HTTPCredentials {
+			j.HTTPCredentials[k] = v
+		}
+	} else if v := f.HTTPCredentials; v != nil {
+		j.HTTPCredentials = v
+	}
+
+	return nil
+}
+
+func (j *JobPipelineSecrets) validateMerge(f *JobPipelineSecrets) (err error) {
+	if j.HTTPCredentials != nil && f.HTTPCredentials != nil {
+		for k := range f.HTTPCredentials {
+			if _, exists := j.HTTPCredentials[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("HTTPCredentials[\"%s\"]", k)})
+			}
+		}
+	}
+
+	return err
+}
+
+func (j *JobPipelineSecrets) ValidateConfig() (err error) {
+	for name, creds := range j.HTTPCredentials {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "HTTPCredentials", Msg: "name must be provided and non-empty"})
+		}
+		if len(creds.AllowedHosts) == 0 {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("HTTPCredentials[\"%s\"].AllowedHosts", name), Msg: "must list at least one host"})
+		}
+		for i, host := range creds.AllowedHosts {
+			if host == "" || strings.ContainsAny(host, "/?#@ ") {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("HTTPCredentials[\"%s\"].AllowedHosts[%d]", name, i), Value: host, Msg: "must be a host name, optionally with a port"})
+			}
+		}
+		if creds.HMAC == nil && creds.JWT == nil && creds.TLS == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("HTTPCredentials[\"%s\"]", name), Msg: "must set at least one of HMAC, JWT or TLS"})
+		}
+		if creds.HMAC != nil {
+			err = multierr.Append(err, commonconfig.NamedMultiErrorList(creds.HMAC.validate(), fmt.Sprintf("HTTPCredentials[\"%s\"].HMAC", name)))
+		}
+		if creds.JWT != nil {
+			err = multierr.Append(err, commonconfig.NamedMultiErrorList(creds.JWT.validate(), fmt.Sprintf("HTTPCredentials[\"%s\"].JWT", name)))
+		}
+		if creds.TLS != nil {
+			err = multierr.Append(err, commonconfig.NamedMultiErrorList(creds.TLS.validate(), fmt.Sprintf("HTTPCredentials[\"%s\"].TLS", name)))
+		}
+	}
+	return err
+}
+
+// HTTPCredentials is a credential profile http and bridge tasks reference by
+// name to authenticate their requests.
+type HTTPCredentials struct {
+	AllowedHosts []string             `toml:",omitempty"`
+	HMAC         *HTTPCredentialsHMAC `toml:",omitempty"`
+	JWT          *HTTPCredentialsJWT  `toml:",omitempty"`
+	TLS          *HTTPCredentialsTLS  `toml:",omitempty"`
+}
+
+type HTTPCredentialsHMAC struct {
+	Key             *models.Secret
+	KeyID           *string
+	Algorithm       *string
+	SignatureHeader *string
+	TimestampHeader *string
+	KeyIDHeader     *string
+}
+
+func (h *HTTPCredentialsHMAC) validate() (err error) {
+	if h.Key == nil || *h.Key == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Key", Msg: "must be provided and non-empty"})
+	}
+	if h.Algorithm != nil {
+		switch *h.Algorithm {
+		case config.HMACAlgorithmSHA256, config.HMACAlgorithmSHA512:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Algorithm", Value: *h.Algorithm,
+				Msg: fmt.Sprintf("must be %s or %s", config.HMACAlgorithmSHA256, config.HMACAlgorithmSHA512)})
+		}
+	}
+	return err
+}
+
+type HTTPCredentialsJWT struct {
+	Key       *models.Secret
+	Algorithm *string
+	KeyID     *string
+	Issuer    *string
+	Subject   *string
+	Audience  *string
+	TTL       *commonconfig.Duration
+}
+
+func (j *HTTPCredentialsJWT) validate() (err error) {
+	if j.Key == nil || *j.Key == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Key", Msg: "must be provided and non-empty"})
+	}
+	algorithm := config.JWTAlgorithmHS256
+	if j.Algorithm != nil {
+		algorithm = *j.Algorithm
+	}
+	switch algorithm {
+	case config.JWTAlgorithmHS256:
+	case config.JWTAlgorithmRS256, config.JWTAlgorithmES256:
+		if j.Key != nil && *j.Key != "" {
+			if block, _ := pem.Decode([]byte(*j.Key)); block == nil {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: "Key", Value: "*****", Msg: "must be a PEM encoded private key for " + algorithm})
+			}
+		}
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Algorithm", Value: algorithm,
+			Msg: fmt.Sprintf("must be one of %s, %s or %s", config.JWTAlgorithmHS256, config.JWTAlgorithmRS256, config.JWTAlgorithmES256)})
+	}
+	if j.TTL != nil && j.TTL.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "TTL", Value: j.TTL.String(), Msg: "must be positive"})
+	}
+	return err
+}
+
+type HTTPCredentialsTLS struct {
+	CertPEM *string
+	KeyPEM  *models.Secret
+	CAPEM   *string
+}
+
+func (t *HTTPCredentialsTLS) validate() (err error) {
+	if t.CertPEM == nil || *t.CertPEM == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "CertPEM", Msg: "must be provided and non-empty"})
+	}
+	if t.KeyPEM == nil || *t.KeyPEM == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "KeyPEM", Msg: "must be provided and non-empty"})
+	}
+	if err != nil {
+		return err
+	}
+	if _, err2 := tls.X509KeyPair([]byte(*t.CertPEM), []byte(*t.KeyPEM)); err2 != nil {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "KeyPEM", Value: "*****", Msg: "must match CertPEM: " + err2.Error()})
+	}
+	if t.CAPEM != nil && *t.CAPEM != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(*t.CAPEM)) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CAPEM", Value: "*****", Msg: "must contain at least one PEM encoded certificate"})
+	}
+	return err
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -2006,3 +2373,37 @@ func isValidHostname(hostname string) bool {
 func isValidFilePath(path string) bool {
 	return len(path) > 0 && len(path) < 4096
 }
+
+// RemoteSigners are the external signers holding EVM keys of the node.
+type RemoteSigners []RemoteSigner
+
+// RemoteSigner is an external signer, like a KMS, serving the RemoteSigner
+// gRPC API.
+type RemoteSigner struct {
+	Name               *string
+	Endpoint           *string
+	CACertFile         *string
+	InsecureConnection *bool
+}
+
+func (rs *RemoteSigners) ValidateConfig() (err error) {
+	names := make(map[string]struct{})
+	for i, r := range *rs {
+		if r.Name == nil || *r.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("RemoteSigners[%d].Name", i), Msg: "required for remote signers"})
+		} else if _, ok := names[*r.Name]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("RemoteSigners[%d].Name", i), Value: *r.Name, Msg: "duplicate remote signer name"})
+		} else {
+			names[*r.Name] = struct{}{}
+		}
+		if r.Endpoint == nil || *r.Endpoint == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("RemoteSigners[%d].Endpoint", i), Msg: "required for remote signers"})
+		}
+		if r.InsecureConnection == nil || !*r.InsecureConnection {
+			if r.CACertFile == nil || *r.CACertFile == "" {
+				err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("RemoteSigners[%d].CACertFile", i), Msg: "must be set, unless InsecureConnection is used"})
+			}
+		}
+	}
+	return
+}
@@ -55,6 +55,20 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+type OIDC interface {
+	IssuerURL() *url.URL
+	ClientID() string
+	ClientSecret() string
+	RedirectURL() *url.URL
+	Scopes() []string
+	GroupsClaim() string
+	AdminUserGroup() string
+	EditUserGroup() string
+	RunUserGroup() string
+	ReadUserGroup() string
+	SessionTimeout() commonconfig.Duration
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +88,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	OIDC() OIDC
 }
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	apitokens "github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+
 	big "math/big"
 
 	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -17,6 +19,8 @@ import (
 
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
+	jobarchive "github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
+
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -31,6 +35,8 @@ import (
 
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
+	rbac "github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -61,6 +67,53 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// APITokenORM provides a mock function with no fields
+func (_m *Application) APITokenORM() apitokens.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokenORM")
+	}
+
+	var r0 apitokens.ORM
+	if rf, ok := ret.Get(0).(func() apitokens.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apitokens.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_APITokenORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APITokenORM'
+type Application_APITokenORM_Call struct {
+	*mock.Call
+}
+
+// APITokenORM is a helper method to define mock.On call
+func (_e *Application_Expecter) APITokenORM() *Application_APITokenORM_Call {
+	return &Application_APITokenORM_Call{Call: _e.mock.On("APITokenORM")}
+}
+
+func (_c *Application_APITokenORM_Call) Run(run func()) *Application_APITokenORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_APITokenORM_Call) Return(_a0 apitokens.ORM) *Application_APITokenORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_APITokenORM_Call) RunAndReturn(run func() apitokens.ORM) *Application_APITokenORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -202,6 +255,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeCircuitBreakers provides a mock function with no fields
+func (_m *Application) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeCircuitBreakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakers'
+type Application_BridgeCircuitBreakers_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakers is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeCircuitBreakers() *Application_BridgeCircuitBreakers_Call {
+	return &Application_BridgeCircuitBreakers_Call{Call: _e.mock.On("BridgeCircuitBreakers")}
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) Run(run func()) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) Return(_a0 *bridges.CircuitBreakers) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -344,6 +444,67 @@ func (_c *Application_DeleteLogPollerDataAfter_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DryRunJobV2 provides a mock function with given fields: ctx, spec, vars, mockOutputs
+func (_m *Application) DryRunJobV2(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result) (*pipeline.DryRunResult, error) {
+	ret := _m.Called(ctx, spec, vars, mockOutputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunJobV2")
+	}
+
+	var r0 *pipeline.DryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) (*pipeline.DryRunResult, error)); ok {
+		return rf(ctx, spec, vars, mockOutputs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) *pipeline.DryRunResult); ok {
+		r0 = rf(ctx, spec, vars, mockOutputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.DryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) error); ok {
+		r1 = rf(ctx, spec, vars, mockOutputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_DryRunJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunJobV2'
+type Application_DryRunJobV2_Call struct {
+	*mock.Call
+}
+
+// DryRunJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - mockOutputs map[string]pipeline.Result
+func (_e *Application_Expecter) DryRunJobV2(ctx interface{}, spec interface{}, vars interface{}, mockOutputs interface{}) *Application_DryRunJobV2_Call {
+	return &Application_DryRunJobV2_Call{Call: _e.mock.On("DryRunJobV2", ctx, spec, vars, mockOutputs)}
+}
+
+func (_c *Application_DryRunJobV2_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result)) *Application_DryRunJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(map[string]pipeline.Result))
+	})
+	return _c
+}
+
+func (_c *Application_DryRunJobV2_Call) Return(_a0 *pipeline.DryRunResult, _a1 error) *Application_DryRunJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_DryRunJobV2_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) (*pipeline.DryRunResult, error)) *Application_DryRunJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EVMORM provides a mock function with no fields
 func (_m *Application) EVMORM() types.Configs {
 	ret := _m.Called()
@@ -391,6 +552,64 @@ func (_c *Application_EVMORM_Call) RunAndReturn(run func() types.Configs) *Appli
 	return _c
 }
 
+// ExportJobs provides a mock function with given fields: ctx
+func (_m *Application) ExportJobs(ctx context.Context) (*jobarchive.Archive, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportJobs")
+	}
+
+	var r0 *jobarchive.Archive
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*jobarchive.Archive, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *jobarchive.Archive); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobarchive.Archive)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJobs'
+type Application_ExportJobs_Call struct {
+	*mock.Call
+}
+
+// ExportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Application_Expecter) ExportJobs(ctx interface{}) *Application_ExportJobs_Call {
+	return &Application_ExportJobs_Call{Call: _e.mock.On("ExportJobs", ctx)}
+}
+
+func (_c *Application_ExportJobs_Call) Run(run func(ctx context.Context)) *Application_ExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Application_ExportJobs_Call) Return(_a0 *jobarchive.Archive, _a1 error) *Application_ExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ExportJobs_Call) RunAndReturn(run func(context.Context) (*jobarchive.Archive, error)) *Application_ExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLCA provides a mock function with given fields: ctx, chainID
 func (_m *Application) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error) {
 	ret := _m.Called(ctx, chainID)
@@ -1059,6 +1278,65 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// ImportJobs provides a mock function with given fields: ctx, archive
+func (_m *Application) ImportJobs(ctx context.Context, archive jobarchive.Archive) (*jobarchive.Report, error) {
+	ret := _m.Called(ctx, archive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportJobs")
+	}
+
+	var r0 *jobarchive.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, jobarchive.Archive) (*jobarchive.Report, error)); ok {
+		return rf(ctx, archive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, jobarchive.Archive) *jobarchive.Report); ok {
+		r0 = rf(ctx, archive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobarchive.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, jobarchive.Archive) error); ok {
+		r1 = rf(ctx, archive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ImportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportJobs'
+type Application_ImportJobs_Call struct {
+	*mock.Call
+}
+
+// ImportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - archive jobarchive.Archive
+func (_e *Application_Expecter) ImportJobs(ctx interface{}, archive interface{}) *Application_ImportJobs_Call {
+	return &Application_ImportJobs_Call{Call: _e.mock.On("ImportJobs", ctx, archive)}
+}
+
+func (_c *Application_ImportJobs_Call) Run(run func(ctx context.Context, archive jobarchive.Archive)) *Application_ImportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(jobarchive.Archive))
+	})
+	return _c
+}
+
+func (_c *Application_ImportJobs_Call) Return(_a0 *jobarchive.Report, _a1 error) *Application_ImportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ImportJobs_Call) RunAndReturn(run func(context.Context, jobarchive.Archive) (*jobarchive.Report, error)) *Application_ImportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with no fields
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1153,6 +1431,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Application_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Application_PauseJob_Call {
+	return &Application_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Application_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) Return(_a0 error) *Application_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1250,6 +1575,112 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ReplayPipelineRun provides a mock function with given fields: ctx, runID
+func (_m *Application) ReplayPipelineRun(ctx context.Context, runID int64) (*pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayPipelineRun")
+	}
+
+	var r0 *pipeline.ReplayResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*pipeline.ReplayResult, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *pipeline.ReplayResult); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.ReplayResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReplayPipelineRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayPipelineRun'
+type Application_ReplayPipelineRun_Call struct {
+	*mock.Call
+}
+
+// ReplayPipelineRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Application_Expecter) ReplayPipelineRun(ctx interface{}, runID interface{}) *Application_ReplayPipelineRun_Call {
+	return &Application_ReplayPipelineRun_Call{Call: _e.mock.On("ReplayPipelineRun", ctx, runID)}
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Run(run func(ctx context.Context, runID int64)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Return(_a0 *pipeline.ReplayResult, _a1 error) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) RunAndReturn(run func(context.Context, int64) (*pipeline.ReplayResult, error)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Application_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Application_ResumeJob_Call {
+	return &Application_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Application_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) Return(_a0 error) *Application_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1298,6 +1729,53 @@ func (_c *Application_ResumeJobV2_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// RoleORM provides a mock function with no fields
+func (_m *Application) RoleORM() rbac.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleORM")
+	}
+
+	var r0 rbac.ORM
+	if rf, ok := ret.Get(0).(func() rbac.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(rbac.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_RoleORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleORM'
+type Application_RoleORM_Call struct {
+	*mock.Call
+}
+
+// RoleORM is a helper method to define mock.On call
+func (_e *Application_Expecter) RoleORM() *Application_RoleORM_Call {
+	return &Application_RoleORM_Call{Call: _e.mock.On("RoleORM")}
+}
+
+func (_c *Application_RoleORM_Call) Run(run func()) *Application_RoleORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_RoleORM_Call) Return(_a0 rbac.ORM) *Application_RoleORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_RoleORM_Call) RunAndReturn(run func() rbac.ORM) *Application_RoleORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -1646,6 +2124,54 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// UpdateJobV2 provides a mock function with given fields: ctx, _a1, version
+func (_m *Application) UpdateJobV2(ctx context.Context, _a1 *job.Job, version *job.JobVersion) error {
+	ret := _m.Called(ctx, _a1, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobV2")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, *job.JobVersion) error); ok {
+		r0 = rf(ctx, _a1, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_UpdateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobV2'
+type Application_UpdateJobV2_Call struct {
+	*mock.Call
+}
+
+// UpdateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *job.Job
+//   - version *job.JobVersion
+func (_e *Application_Expecter) UpdateJobV2(ctx interface{}, _a1 interface{}, version interface{}) *Application_UpdateJobV2_Call {
+	return &Application_UpdateJobV2_Call{Call: _e.mock.On("UpdateJobV2", ctx, _a1, version)}
+}
+
+func (_c *Application_UpdateJobV2_Call) Run(run func(ctx context.Context, _a1 *job.Job, version *job.JobVersion)) *Application_UpdateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job), args[2].(*job.JobVersion))
+	})
+	return _c
+}
+
+func (_c *Application_UpdateJobV2_Call) Return(_a0 error) *Application_UpdateJobV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_UpdateJobV2_Call) RunAndReturn(run func(context.Context, *job.Job, *job.JobVersion) error) *Application_UpdateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
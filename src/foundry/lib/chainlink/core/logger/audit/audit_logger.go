@@ -189,7 +189,15 @@ func (l *AuditLoggerService) runLoop() {
 		select {
 		case <-l.chStop:
 			l.logger.Warn("The audit logger is shutting down")
-			return
+			// flush the buffered logs, short-lived commands close the logger right after auditing
+			for {
+				select {
+				case event := <-l.loggingChannel:
+					l.postLogToLogService(event.eventID, event.data)
+				default:
+					return
+				}
+			}
 		case event := <-l.loggingChannel:
 			l.postLogToLogService(event.eventID, event.data)
 		}
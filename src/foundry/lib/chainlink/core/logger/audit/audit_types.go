@@ -20,6 +20,10 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	NamedAPITokenCreated    EventID = "NAMED_API_TOKEN_CREATED"
+	NamedAPITokenRevoked    EventID = "NAMED_API_TOKEN_REVOKED"
+	NamedAPITokenAuthFailed EventID = "NAMED_API_TOKEN_AUTH_FAILED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -51,12 +55,18 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordRotated EventID = "KEYSTORE_PASSWORD_ROTATED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
-	JobCreated EventID = "JOB_CREATED"
-	JobDeleted EventID = "JOB_DELETED"
+	JobCreated   EventID = "JOB_CREATED"
+	JobDeleted   EventID = "JOB_DELETED"
+	JobPaused    EventID = "JOB_PAUSED"
+	JobResumed   EventID = "JOB_RESUMED"
+	JobsExported EventID = "JOBS_EXPORTED"
+	JobsImported EventID = "JOBS_IMPORTED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -75,6 +85,12 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	RoleCreated    EventID = "ROLE_CREATED"
+	RoleUpdated    EventID = "ROLE_UPDATED"
+	RoleDeleted    EventID = "ROLE_DELETED"
+	RoleAssigned   EventID = "ROLE_ASSIGNED"
+	RoleUnassigned EventID = "ROLE_UNASSIGNED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
@@ -91,4 +107,6 @@ const (
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
+	SignedRunTriggered EventID = "SIGNED_RUN_TRIGGERED"
+	SignedRunRejected  EventID = "SIGNED_RUN_REJECTED"
 )
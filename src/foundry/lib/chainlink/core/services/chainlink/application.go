@@ -62,6 +62,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/headreporter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobreconciler"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
@@ -89,8 +91,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
@@ -122,13 +127,23 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	RoleORM() rbac.ORM
+	APITokenORM() apitokens.ORM
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
+	UpdateJobV2(ctx context.Context, job *job.Job, version *job.JobVersion) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32) error
+	ResumeJob(ctx context.Context, jobID int32) error
+	ExportJobs(ctx context.Context) (*jobarchive.Archive, error)
+	ImportJobs(ctx context.Context, archive jobarchive.Archive) (*jobarchive.Report, error)
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	ReplayPipelineRun(ctx context.Context, runID int64) (*pipeline.ReplayResult, error)
+	DryRunJobV2(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result) (*pipeline.DryRunResult, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -162,6 +177,8 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	roleORM                  rbac.ORM
+	apiTokenORM              apitokens.ORM
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
@@ -282,6 +299,12 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	restrictedHTTPClient := opts.RestrictedHTTPClient
 	unrestrictedHTTPClient := opts.UnrestrictedHTTPClient
 
+	remoteSigners, err := newRemoteSigners(cfg.RemoteSigners(), keyStore.Eth())
+	if err != nil {
+		return nil, err
+	}
+	srvcs = append(srvcs, remoteSigners)
+
 	mailMon := mailbox.NewMonitor(cfg.AppID().String(), globalLogger.Named("Mailbox"))
 
 	if opts.CapabilitiesRegistry == nil {
@@ -430,12 +453,17 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	srvcs = append(srvcs, mailMon)
 	srvcs = append(srvcs, relayChainInterops.Services()...)
 
+	// the outbox of a real external initiator manager retries failed notifications
+	if eim, ok := externalInitiatorManager.(services.ServiceCtx); ok {
+		srvcs = append(srvcs, eim)
+	}
+
 	// Initialize Local Users ORM and Authentication Provider specified in config
 	// BasicAdminUsersORM is initialized and required regardless of separate Authentication Provider
 	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 
 	// Initialize Sessions ORM based on environment configured authenticator
-	// localDB auth or remote LDAP auth
+	// localDB auth, remote LDAP auth or OIDC auth
 	authMethod := cfg.WebServer().AuthenticationMethod()
 	var authenticationProvider sessions.AuthenticationProvider
 	var sessionReaper *utils.SleeperTask
@@ -452,11 +480,20 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), globalLogger)
 		srvcs = append(srvcs, syncer)
 		sessionReaper = utils.NewSleeperTaskCtx(syncer)
+	case sessions.OIDCAuth:
+		var err error
+		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
+			ctx, opts.DS, cfg.WebServer().OIDC(), cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
+		}
+		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer().OIDC(), globalLogger)
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
-		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
+		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth, sessions.OIDCAuth)
 	}
 
 	var (
@@ -520,6 +557,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				cron.NewORM(opts.DS),
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -718,7 +756,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		}
 	}
 
-	return &ChainlinkApplication{
+	app := &ChainlinkApplication{
 		relayers:                 relayChainInterops,
 		jobORM:                   jobORM,
 		jobSpawner:               jobSpawner,
@@ -727,6 +765,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		roleORM:                  rbac.NewORM(opts.DS),
+		apiTokenORM:              apitokens.NewORM(opts.DS),
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
@@ -747,7 +787,19 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 		// NOTE: Can keep things clean by putting more things in srvcs instead of manually start/closing
 		srvcs: srvcs,
-	}, nil
+	}
+
+	// The job reconciler is started last, after the job spawner and the
+	// services its jobs depend on.
+	if cfg.JobReconciler().Enabled() {
+		jobReconciler := jobreconciler.New(cfg.JobReconciler(), app, app.validatedJobSpec, globalLogger)
+		if err := healthChecker.Register(jobReconciler); err != nil {
+			return nil, err
+		}
+		app.srvcs = append(app.srvcs, jobReconciler)
+	}
+
+	return app, nil
 }
 
 // creKeystore is the minimal interface needed from keystore for CRE
@@ -1141,6 +1193,11 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+// BridgeCircuitBreakers returns the circuit breakers guarding requests to bridges.
+func (app *ChainlinkApplication) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return app.pipelineRunner.BridgeCircuitBreakers()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -1149,6 +1206,14 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) RoleORM() rbac.ORM {
+	return app.roleORM
+}
+
+func (app *ChainlinkApplication) APITokenORM() apitokens.ORM {
+	return app.apiTokenORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -1179,6 +1244,59 @@ func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error
 	return app.jobSpawner.CreateJob(ctx, nil, j)
 }
 
+// UpdateJobV2 replaces the job with the ID of j by j, and records version as
+// its next version if given. The pipeline specs of the replaced job are kept,
+// so its runs remain attributable to the version which produced them.
+func (app *ChainlinkApplication) UpdateJobV2(ctx context.Context, j *job.Job, version *job.JobVersion) error {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(j.ID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	err = sqlutil.Transact(ctx, app.jobORM.WithDataSource, app.ds, nil, func(tx job.ORM) error {
+		// The job is deleted and created again with the same ID, its runs and
+		// versions refer to it again by the end of the transaction.
+		if _, err := tx.DataSource().ExecContext(ctx, `SET CONSTRAINTS fk_pipeline_runs_pruning_key, fk_job_versions_job DEFERRED`); err != nil {
+			return err
+		}
+		// A paused job stays paused with its new spec.
+		if err := tx.DataSource().GetContext(ctx, &j.PausedAt, `SELECT paused_at FROM jobs WHERE id = $1`, j.ID); err != nil {
+			return errors.Wrapf(err, "job %d not found", j.ID)
+		}
+		specIDs, err := tx.DetachPipelineSpecs(ctx, j.ID)
+		if err != nil {
+			return err
+		}
+		if err = app.jobSpawner.DeleteJobInTx(ctx, tx.DataSource(), j.ID); err != nil {
+			return err
+		}
+		if err = app.jobSpawner.CreateJobInTx(ctx, tx.DataSource(), j); err != nil {
+			return err
+		}
+		if err = tx.AttachPipelineSpecs(ctx, j.ID, specIDs); err != nil {
+			return err
+		}
+		if version == nil {
+			return nil
+		}
+		version.JobID = j.ID
+		return tx.InsertJobVersion(ctx, version)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The services of the previous spec keep running until the update is committed.
+	app.jobSpawner.StopJob(j.ID)
+	app.pipelineRunner.ForgetJob(j.ID)
+	return app.jobSpawner.StartJob(ctx, *j)
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	// Do not allow the job to be deleted if it is managed by the Feeds Manager
 	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
@@ -1190,11 +1308,32 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 		return errors.New("job must be deleted in the feeds manager")
 	}
 
-	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
+	if err = app.jobSpawner.DeleteJob(ctx, nil, jobID); err != nil {
+		return err
+	}
+	app.pipelineRunner.ForgetJob(jobID)
+	return nil
+}
+
+// PauseJob stops the services of the job until it is resumed.
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(ctx, jobID)
+}
+
+// ResumeJob starts the services of a paused job.
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(ctx, jobID)
 }
 
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
-	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	runID, err := app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		// paused jobs are not registered with the runner
+		if jb, ferr := app.jobORM.FindJobByExternalJobID(ctx, jobUUID); ferr == nil && jb.Paused() {
+			return 0, errors.Wrapf(job.ErrJobPaused, "job %s", jobUUID)
+		}
+	}
+	return runID, err
 }
 
 // Only used for local testing, not supported by the UI.
@@ -1210,6 +1349,9 @@ func (app *ChainlinkApplication) RunJobV2(
 	if err != nil {
 		return 0, errors.Wrapf(err, "job ID %v", jobID)
 	}
+	if jb.Paused() {
+		return 0, errors.Wrapf(job.ErrJobPaused, "job ID %v", jobID)
+	}
 	var runID int64
 
 	// Some jobs are special in that they do not have a task graph.
@@ -1270,6 +1412,18 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// ReplayPipelineRun re-executes a finished run against its recorded task
+// results without persisting anything.
+func (app *ChainlinkApplication) ReplayPipelineRun(ctx context.Context, runID int64) (*pipeline.ReplayResult, error) {
+	return app.pipelineRunner.ReplayRun(ctx, runID)
+}
+
+// DryRunJobV2 executes the pipeline spec of an unsaved job in memory, without
+// sending transactions or persisting anything.
+func (app *ChainlinkApplication) DryRunJobV2(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result) (*pipeline.DryRunResult, error) {
+	return app.pipelineRunner.DryRun(ctx, spec, vars, mockOutputs)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
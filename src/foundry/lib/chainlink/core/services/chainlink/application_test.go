@@ -0,0 +1,52 @@
+package chainlink_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+// DeleteJob is shared by the REST and GraphQL APIs and the job reconciler.
+func TestChainlinkApplication_DeleteJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	externalJobID := uuid.New()
+	jb, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String()), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+	cltest.MustInsertPipelineRunWithStatus(t, app.GetDB(), jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+
+	updated, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GetWebhookSpecNoBody(externalJobID, submitBridge.Name.String(), fetchBridge.Name.String()), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	updated.ID = jb.ID
+	require.NoError(t, app.UpdateJobV2(ctx, &updated, &job.JobVersion{Spec: "updated"}))
+	cltest.MustInsertPipelineRunWithStatus(t, app.GetDB(), updated.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+
+	require.NoError(t, app.DeleteJob(ctx, jb.ID))
+
+	// check the constraints deferred by the update now, as the test
+	// transaction is never committed
+	_, err = app.GetDB().ExecContext(ctx, `SET CONSTRAINTS ALL IMMEDIATE`)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, app.GetDB().GetContext(ctx, &count, `SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1`, jb.ID))
+	assert.Zero(t, count, "the runs of all versions are deleted")
+	require.NoError(t, app.GetDB().GetContext(ctx, &count, `SELECT count(*) FROM job_versions WHERE job_id = $1`, jb.ID))
+	assert.Zero(t, count)
+}
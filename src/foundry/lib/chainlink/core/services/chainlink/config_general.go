@@ -445,7 +445,19 @@ func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 }
 
 func (g *generalConfig) JobPipeline() coreconfig.JobPipeline {
-	return &jobPipelineConfig{c: g.c.JobPipeline}
+	return &jobPipelineConfig{c: g.c.JobPipeline, s: g.secrets.JobPipeline}
+}
+
+func (g *generalConfig) JobReconciler() coreconfig.JobReconciler {
+	return &jobReconcilerConfig{c: g.c.JobReconciler}
+}
+
+func (g *generalConfig) RemoteSigners() []coreconfig.RemoteSigner {
+	var signers []coreconfig.RemoteSigner
+	for _, s := range g.c.RemoteSigners {
+		signers = append(signers, &remoteSignerConfig{c: s})
+	}
+	return signers
 }
 
 func (g *generalConfig) Keeper() config.Keeper {
@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"slices"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -12,6 +13,7 @@ var _ config.JobPipeline = (*jobPipelineConfig)(nil)
 
 type jobPipelineConfig struct {
 	c toml.JobPipeline
+	s toml.JobPipelineSecrets
 }
 
 func (j *jobPipelineConfig) DefaultHTTPLimit() int64 {
@@ -49,3 +51,87 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) MaxConcurrentRuns() uint32 {
+	if j.c.MaxConcurrentRuns == nil {
+		return 0
+	}
+	return *j.c.MaxConcurrentRuns
+}
+
+func (j *jobPipelineConfig) MaxRunsPerMinute() uint32 {
+	if j.c.MaxRunsPerMinute == nil {
+		return 0
+	}
+	return *j.c.MaxRunsPerMinute
+}
+
+func (j *jobPipelineConfig) RunLimitMode() string {
+	return stringOrDefault(j.c.RunLimitMode, config.RunLimitModeQueue)
+}
+
+func (j *jobPipelineConfig) RunRetention(jobType string) config.RunRetention {
+	p, ok := j.c.RunRetention.JobTypes[jobType]
+	if !ok {
+		return config.RunRetention{}
+	}
+	r := config.RunRetention{MaxSuccessfulRuns: p.MaxSuccessfulRuns, KeepTags: p.KeepTags}
+	if p.KeepFailedFor != nil {
+		d := p.KeepFailedFor.Duration()
+		r.KeepFailedFor = &d
+	}
+	return r
+}
+
+func (j *jobPipelineConfig) RunArchivePath() string {
+	return stringOrDefault(j.c.RunRetention.ArchivePath, "")
+}
+
+// HTTPCredentials returns the credential profile with the given name, with
+// defaults applied, or nil if there is none.
+func (j *jobPipelineConfig) HTTPCredentials(name string) *config.HTTPCredentials {
+	creds, ok := j.s.HTTPCredentials[name]
+	if !ok {
+		return nil
+	}
+	c := &config.HTTPCredentials{AllowedHosts: slices.Clone(creds.AllowedHosts)}
+	if h := creds.HMAC; h != nil {
+		c.HMAC = &config.HTTPCredentialsHMAC{
+			Key:             string(*h.Key),
+			KeyID:           stringOrDefault(h.KeyID, ""),
+			Algorithm:       stringOrDefault(h.Algorithm, config.HMACAlgorithmSHA256),
+			SignatureHeader: stringOrDefault(h.SignatureHeader, "X-Signature"),
+			TimestampHeader: stringOrDefault(h.TimestampHeader, "X-Timestamp"),
+			KeyIDHeader:     stringOrDefault(h.KeyIDHeader, "X-Key-ID"),
+		}
+	}
+	if jwt := creds.JWT; jwt != nil {
+		c.JWT = &config.HTTPCredentialsJWT{
+			Key:       string(*jwt.Key),
+			Algorithm: stringOrDefault(jwt.Algorithm, config.JWTAlgorithmHS256),
+			KeyID:     stringOrDefault(jwt.KeyID, ""),
+			Issuer:    stringOrDefault(jwt.Issuer, ""),
+			Subject:   stringOrDefault(jwt.Subject, ""),
+			Audience:  stringOrDefault(jwt.Audience, ""),
+			TTL:       time.Minute,
+		}
+		if jwt.TTL != nil {
+			c.JWT.TTL = jwt.TTL.Duration()
+		}
+	}
+	if t := creds.TLS; t != nil {
+		c.TLS = &config.HTTPCredentialsTLS{
+			CertPEM: *t.CertPEM,
+			KeyPEM:  string(*t.KeyPEM),
+			CAPEM:   stringOrDefault(t.CAPEM, ""),
+		}
+	}
+	return c
+}
+
+func stringOrDefault(s *string, def string) string {
+	if s == nil {
+		return def
+	}
+	return *s
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -30,4 +31,39 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+	assert.Equal(t, "runs/archive", jp.RunArchivePath())
+	keepFailedFor := 720 * time.Hour
+	assert.Equal(t, config.RunRetention{
+		MaxSuccessfulRuns: ptr[uint64](100),
+		KeepFailedFor:     &keepFailedFor,
+		KeepTags:          []string{"audit"},
+	}, jp.RunRetention("webhook"))
+	assert.True(t, jp.RunRetention("cron").IsZero())
+}
+
+func TestJobPipelineConfig_HTTPCredentials(t *testing.T) {
+	opts := GeneralConfigOpts{
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	jp := cfg.JobPipeline()
+
+	assert.Nil(t, jp.HTTPCredentials("unknown"))
+	assert.Equal(t, &config.HTTPCredentials{AllowedHosts: []string{"prices.example.com"}, HMAC: &config.HTTPCredentialsHMAC{
+		Key:             "hmac-key",
+		KeyID:           "key-1",
+		Algorithm:       config.HMACAlgorithmSHA256,
+		SignatureHeader: "X-Signature",
+		TimestampHeader: "X-Timestamp",
+		KeyIDHeader:     "X-Key-ID",
+	}}, jp.HTTPCredentials("provider1"))
+	assert.Equal(t, &config.HTTPCredentials{AllowedHosts: []string{"data.example.com:8443"}, JWT: &config.HTTPCredentialsJWT{
+		Key:       "jwt-key",
+		Algorithm: config.JWTAlgorithmHS256,
+		Issuer:    "chainlink-node",
+		Audience:  "https://data.example.com",
+		TTL:       30 * time.Second,
+	}}, jp.HTTPCredentials("provider2"))
 }
@@ -0,0 +1,49 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.JobReconciler = (*jobReconcilerConfig)(nil)
+
+type jobReconcilerConfig struct {
+	c toml.JobReconciler
+}
+
+func (j *jobReconcilerConfig) Enabled() bool {
+	if j.c.Enabled == nil {
+		return false
+	}
+	return *j.c.Enabled
+}
+
+func (j *jobReconcilerConfig) Path() string {
+	if j.c.Path == nil {
+		return ""
+	}
+	return *j.c.Path
+}
+
+func (j *jobReconcilerConfig) PollInterval() time.Duration {
+	if j.c.PollInterval == nil {
+		return time.Minute
+	}
+	return j.c.PollInterval.Duration()
+}
+
+func (j *jobReconcilerConfig) DeleteUnlisted() bool {
+	if j.c.DeleteUnlisted == nil {
+		return false
+	}
+	return *j.c.DeleteUnlisted
+}
+
+func (j *jobReconcilerConfig) DryRun() bool {
+	if j.c.DryRun == nil {
+		return false
+	}
+	return *j.c.DryRun
+}
@@ -0,0 +1,40 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Name() string {
+	if r.c.Name == nil {
+		return ""
+	}
+	return *r.c.Name
+}
+
+func (r *remoteSignerConfig) Endpoint() string {
+	if r.c.Endpoint == nil {
+		return ""
+	}
+	return *r.c.Endpoint
+}
+
+func (r *remoteSignerConfig) CACertFile() string {
+	if r.c.CACertFile == nil {
+		return ""
+	}
+	return *r.c.CACertFile
+}
+
+func (r *remoteSignerConfig) InsecureConnection() bool {
+	if r.c.InsecureConnection == nil {
+		return false
+	}
+	return *r.c.InsecureConnection
+}
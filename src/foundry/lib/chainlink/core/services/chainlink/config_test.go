@@ -373,6 +373,18 @@ func TestConfig_Marshal(t *testing.T) {
 			UpstreamSyncInterval:        commoncfg.MustNewDuration(0 * time.Second),
 			UpstreamSyncRateLimit:       commoncfg.MustNewDuration(2 * time.Minute),
 		},
+		OIDC: toml.WebServerOIDC{
+			IssuerURL:      mustURL("https://issuer.example.com"),
+			ClientID:       ptr("chainlink-node"),
+			RedirectURL:    mustURL("https://node.example.com/sessions/oidc/callback"),
+			Scopes:         []string{"openid", "email", "groups"},
+			GroupsClaim:    ptr("roles"),
+			AdminUserGroup: ptr("NodeAdmins"),
+			EditUserGroup:  ptr("NodeEditors"),
+			RunUserGroup:   ptr("NodeRunners"),
+			ReadUserGroup:  ptr("NodeReadOnly"),
+			SessionTimeout: commoncfg.MustNewDuration(30 * time.Minute),
+		},
 		RateLimit: toml.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   commoncfg.MustNewDuration(time.Second),
@@ -396,10 +408,30 @@ func TestConfig_Marshal(t *testing.T) {
 		ReaperThreshold:           commoncfg.MustNewDuration(7 * 24 * time.Hour),
 		ResultWriteQueueDepth:     ptr[uint32](10),
 		VerboseLogging:            ptr(false),
+		MaxConcurrentRuns:         ptr[uint32](8),
+		MaxRunsPerMinute:          ptr[uint32](120),
+		RunLimitMode:              ptr("drop"),
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		RunRetention: toml.JobPipelineRunRetention{
+			ArchivePath: ptr("runs/archive"),
+			JobTypes: map[string]toml.RunRetentionPolicy{
+				"webhook": {
+					MaxSuccessfulRuns: ptr[uint64](100),
+					KeepFailedFor:     commoncfg.MustNewDuration(30 * 24 * time.Hour),
+					KeepTags:          []string{"audit"},
+				},
+			},
+		},
+	}
+	full.JobReconciler = toml.JobReconciler{
+		Enabled:        ptr(true),
+		Path:           ptr("job/specs/dir"),
+		PollInterval:   commoncfg.MustNewDuration(30 * time.Second),
+		DeleteUnlisted: ptr(true),
+		DryRun:         ptr(true),
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -568,6 +600,14 @@ func TestConfig_Marshal(t *testing.T) {
 		EmitterExportTimeout:  commoncfg.MustNewDuration(1 * time.Second),
 		ChipIngressEndpoint:   ptr("example.com/chip-ingress"),
 	}
+	full.RemoteSigners = toml.RemoteSigners{
+		{
+			Name:               ptr("kms"),
+			Endpoint:           ptr("kms.example.com:443"),
+			CACertFile:         ptr("kms-ca.pem"),
+			InsecureConnection: ptr(false),
+		},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -947,6 +987,18 @@ UserAPITokenDuration = '240h0m0s'
 UpstreamSyncInterval = '0s'
 UpstreamSyncRateLimit = '2m0s'
 
+[WebServer.OIDC]
+IssuerURL = 'https://issuer.example.com'
+ClientID = 'chainlink-node'
+RedirectURL = 'https://node.example.com/sessions/oidc/callback'
+Scopes = ['openid', 'email', 'groups']
+GroupsClaim = 'roles'
+AdminUserGroup = 'NodeAdmins'
+EditUserGroup = 'NodeEditors'
+RunUserGroup = 'NodeRunners'
+ReadUserGroup = 'NodeReadOnly'
+SessionTimeout = '30m0s'
+
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
@@ -977,10 +1029,29 @@ ReaperInterval = '4h0m0s'
 ReaperThreshold = '168h0m0s'
 ResultWriteQueueDepth = 10
 VerboseLogging = false
+MaxConcurrentRuns = 8
+MaxRunsPerMinute = 120
+RunLimitMode = 'drop'
 
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.RunRetention]
+ArchivePath = 'runs/archive'
+
+[JobPipeline.RunRetention.JobTypes]
+[JobPipeline.RunRetention.JobTypes.webhook]
+MaxSuccessfulRuns = 100
+KeepFailedFor = '720h0m0s'
+KeepTags = ['audit']
+`},
+		{"JobReconciler", Config{Core: toml.Core{JobReconciler: full.JobReconciler}}, `[JobReconciler]
+Enabled = true
+Path = 'job/specs/dir'
+PollInterval = '30s'
+DeleteUnlisted = true
+DryRun = true
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1063,6 +1134,12 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"RemoteSigners", Config{Core: toml.Core{RemoteSigners: full.RemoteSigners}}, `[[RemoteSigners]]
+Name = 'kms'
+Endpoint = 'kms.example.com:443'
+CACertFile = 'kms-ca.pem'
+InsecureConnection = false
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
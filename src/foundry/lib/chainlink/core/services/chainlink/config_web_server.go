@@ -118,6 +118,10 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) OIDC() config.OIDC {
+	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC, sessionTimeout: *w.c.SessionTimeout}
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -313,3 +317,89 @@ func (l *ldapConfig) UpstreamSyncRateLimit() commonconfig.Duration {
 	}
 	return *l.c.UpstreamSyncRateLimit
 }
+
+type oidcConfig struct {
+	c toml.WebServerOIDC
+	s toml.WebServerOIDCSecrets
+	// sessionTimeout is WebServer.SessionTimeout
+	sessionTimeout commonconfig.Duration
+}
+
+func (o *oidcConfig) IssuerURL() *url.URL {
+	if o.c.IssuerURL == nil {
+		return nil
+	}
+	return o.c.IssuerURL.URL()
+}
+
+func (o *oidcConfig) ClientID() string {
+	if o.c.ClientID == nil {
+		return ""
+	}
+	return *o.c.ClientID
+}
+
+func (o *oidcConfig) ClientSecret() string {
+	if o.s.ClientSecret == nil {
+		return ""
+	}
+	return string(*o.s.ClientSecret)
+}
+
+func (o *oidcConfig) RedirectURL() *url.URL {
+	if o.c.RedirectURL == nil {
+		return nil
+	}
+	return o.c.RedirectURL.URL()
+}
+
+// Scopes defaults to the scopes of the standard claims of the user identity.
+func (o *oidcConfig) Scopes() []string {
+	if len(o.c.Scopes) == 0 {
+		return []string{"openid", "email", "profile"}
+	}
+	return o.c.Scopes
+}
+
+func (o *oidcConfig) GroupsClaim() string {
+	if o.c.GroupsClaim == nil || *o.c.GroupsClaim == "" {
+		return "groups"
+	}
+	return *o.c.GroupsClaim
+}
+
+func (o *oidcConfig) AdminUserGroup() string {
+	if o.c.AdminUserGroup == nil {
+		return ""
+	}
+	return *o.c.AdminUserGroup
+}
+
+func (o *oidcConfig) EditUserGroup() string {
+	if o.c.EditUserGroup == nil {
+		return ""
+	}
+	return *o.c.EditUserGroup
+}
+
+func (o *oidcConfig) RunUserGroup() string {
+	if o.c.RunUserGroup == nil {
+		return ""
+	}
+	return *o.c.RunUserGroup
+}
+
+func (o *oidcConfig) ReadUserGroup() string {
+	if o.c.ReadUserGroup == nil {
+		return ""
+	}
+	return *o.c.ReadUserGroup
+}
+
+// SessionTimeout defaults to WebServer.SessionTimeout.
+func (o *oidcConfig) SessionTimeout() commonconfig.Duration {
+	if o.c.SessionTimeout == nil {
+		return o.sessionTimeout
+	}
+	return *o.c.SessionTimeout
+}
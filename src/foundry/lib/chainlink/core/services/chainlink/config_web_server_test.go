@@ -45,4 +45,32 @@ func TestWebServerConfig(t *testing.T) {
 	mf := ws.MFA()
 	assert.Equal(t, "test-rpid", mf.RPID())
 	assert.Equal(t, "test-rp-origin", mf.RPOrigin())
+
+	oidc := ws.OIDC()
+	assert.Equal(t, "https://issuer.example.com", oidc.IssuerURL().String())
+	assert.Equal(t, "chainlink-node", oidc.ClientID())
+	assert.Equal(t, "", oidc.ClientSecret())
+	assert.Equal(t, "https://node.example.com/sessions/oidc/callback", oidc.RedirectURL().String())
+	assert.Equal(t, []string{"openid", "email", "groups"}, oidc.Scopes())
+	assert.Equal(t, "roles", oidc.GroupsClaim())
+	assert.Equal(t, "NodeAdmins", oidc.AdminUserGroup())
+	assert.Equal(t, "NodeEditors", oidc.EditUserGroup())
+	assert.Equal(t, "NodeRunners", oidc.RunUserGroup())
+	assert.Equal(t, "NodeReadOnly", oidc.ReadUserGroup())
+	assert.Equal(t, *commonconfig.MustNewDuration(30 * time.Minute), oidc.SessionTimeout())
+}
+
+func TestWebServerConfig_OIDCDefaults(t *testing.T) {
+	opts := GeneralConfigOpts{
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	oidc := cfg.WebServer().OIDC()
+	assert.Nil(t, oidc.IssuerURL())
+	assert.Equal(t, "client-secret", oidc.ClientSecret())
+	assert.Equal(t, []string{"openid", "email", "profile"}, oidc.Scopes())
+	assert.Equal(t, "groups", oidc.GroupsClaim())
+	assert.Equal(t, cfg.WebServer().SessionTimeout(), oidc.SessionTimeout())
 }
@@ -0,0 +1,429 @@
+package chainlink
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-evm/pkg/forwarders"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// ExportJobs returns the archive of the jobs of the node, with the bridges and
+// external initiators they reference and the forwarders of the node. Jobs
+// managed by the feeds manager are skipped. The spec of a job without a
+// recorded version is reconstructed if its type allows it, the job is skipped
+// otherwise.
+func (app *ChainlinkApplication) ExportJobs(ctx context.Context) (*jobarchive.Archive, error) {
+	jobs, _, err := app.jobORM.FindJobs(ctx, 0, math.MaxUint32)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs")
+	}
+
+	archive := &jobarchive.Archive{Version: jobarchive.Version}
+	bridgeNames := make(map[bridges.BridgeName]struct{})
+	eiNames := make(map[string]struct{})
+	for _, jb := range jobs {
+		name := jb.ExternalJobID.String()
+		managed, err := app.FeedsService.IsJobManaged(ctx, int64(jb.ID))
+		if err != nil {
+			return nil, err
+		}
+		if managed {
+			archive.Skipped = append(archive.Skipped, jobarchive.Skipped{Kind: jobarchive.KindJob, Name: name, Reason: "managed by the feeds manager"})
+			continue
+		}
+		versions, err := app.jobORM.FindJobVersions(ctx, jb.ID)
+		if err != nil {
+			return nil, err
+		}
+		var spec string
+		if len(versions) > 0 {
+			spec = versions[0].Spec
+		} else {
+			spec, err = app.reconstructedJobSpec(ctx, jb)
+			if errors.Is(err, errSpecNotReconstructable) {
+				archive.Skipped = append(archive.Skipped, jobarchive.Skipped{Kind: jobarchive.KindJob, Name: name, Reason: err.Error()})
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		spec = job.RedactSpec(spec)
+		refs, err := jobarchive.ParseReferences(spec)
+		if err != nil {
+			archive.Skipped = append(archive.Skipped, jobarchive.Skipped{Kind: jobarchive.KindJob, Name: name, Reason: err.Error()})
+			continue
+		}
+		for _, b := range refs.Bridges {
+			bridgeNames[b] = struct{}{}
+		}
+		for _, ei := range refs.ExternalInitiators {
+			eiNames[ei] = struct{}{}
+		}
+		archive.Jobs = append(archive.Jobs, jobarchive.Job{
+			ExternalJobID: jb.ExternalJobID,
+			Name:          jb.Name.ValueOrZero(),
+			Type:          jb.Type,
+			Spec:          spec,
+		})
+	}
+
+	for name := range bridgeNames {
+		bt, err := app.bridgeORM.FindBridge(ctx, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load bridge %s", name)
+		}
+		archive.Bridges = append(archive.Bridges, bridges.BridgeTypeRequest{
+			Name:                           bt.Name,
+			URL:                            bt.URL,
+			Confirmations:                  bt.Confirmations,
+			MinimumContractPayment:         bt.MinimumContractPayment,
+			CircuitBreakerFailureThreshold: bt.CircuitBreakerFailureThreshold,
+			CircuitBreakerCoolDown:         bt.CircuitBreakerCoolDown,
+			FallbackURLs:                   bt.FallbackURLs,
+			URLStrategy:                    bt.URLStrategy,
+			HedgeDelay:                     bt.HedgeDelay,
+		})
+	}
+	for name := range eiNames {
+		ei, err := app.bridgeORM.FindExternalInitiatorByName(ctx, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load external initiator %s", name)
+		}
+		archive.ExternalInitiators = append(archive.ExternalInitiators, jobarchive.ExternalInitiator{Name: ei.Name, URL: ei.URL})
+	}
+
+	fwds, _, err := forwarders.NewORM(app.ds).FindForwarders(ctx, 0, math.MaxUint32)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load forwarders")
+	}
+	for _, fwd := range fwds {
+		archive.Forwarders = append(archive.Forwarders, jobarchive.Forwarder{Address: fwd.Address, EVMChainID: fwd.EVMChainID})
+	}
+	return archive, nil
+}
+
+var errSpecNotReconstructable = errors.New("no recorded spec")
+
+// reconstructedSpec is the TOML spec of a job created before the versions of
+// jobs were recorded, with the fields of the job types which can be
+// reconstructed from the database.
+type reconstructedSpec struct {
+	Type                       string   `toml:"type"`
+	SchemaVersion              uint32   `toml:"schemaVersion"`
+	ExternalJobID              string   `toml:"externalJobID"`
+	Name                       string   `toml:"name,omitempty"`
+	GasLimit                   *uint32  `toml:"gasLimit,omitempty"`
+	ForwardingAllowed          bool     `toml:"forwardingAllowed,omitempty"`
+	MaxTaskDuration            string   `toml:"maxTaskDuration,omitempty"`
+	MaxConcurrentRuns          *uint32  `toml:"maxConcurrentRuns,omitempty"`
+	MaxRunsPerMinute           *uint32  `toml:"maxRunsPerMinute,omitempty"`
+	RunLimitMode               string   `toml:"runLimitMode,omitempty"`
+	RetentionMaxSuccessfulRuns *uint32  `toml:"retentionMaxSuccessfulRuns,omitempty"`
+	RetentionKeepFailedFor     string   `toml:"retentionKeepFailedFor,omitempty"`
+	RetentionKeepTags          []string `toml:"retentionKeepTags,omitempty"`
+
+	// cron
+	Schedule          string `toml:"schedule,omitempty"`
+	EVMChainID        string `toml:"evmChainID,omitempty"`
+	ConcurrencyPolicy string `toml:"concurrencyPolicy,omitempty"`
+	CatchUp           uint32 `toml:"catchUp,omitempty"`
+	Timezone          string `toml:"timezone,omitempty"`
+	Jitter            string `toml:"jitter,omitempty"`
+
+	// webhook
+	SignatureAlgorithm string `toml:"signatureAlgorithm,omitempty"`
+	SignatureKey       string `toml:"signatureKey,omitempty"`
+	SignatureTolerance string `toml:"signatureTolerance,omitempty"`
+
+	ObservationSource  string                               `toml:"observationSource"`
+	ExternalInitiators []reconstructedSpecExternalInitiator `toml:"externalInitiators,omitempty"`
+}
+
+type reconstructedSpecExternalInitiator struct {
+	Name string `toml:"name"`
+	Spec string `toml:"spec,omitempty"`
+}
+
+// reconstructedJobSpec returns the spec of a cron or webhook job without a
+// recorded version, built from the job and its specs. The spec of other job
+// types is not reconstructed, as part of it is not stored.
+func (app *ChainlinkApplication) reconstructedJobSpec(ctx context.Context, jb job.Job) (string, error) {
+	if jb.PipelineSpec == nil || (jb.CronSpec == nil && jb.WebhookSpec == nil) {
+		return "", errors.Wrapf(errSpecNotReconstructable, "the spec of %s jobs cannot be reconstructed", jb.Type)
+	}
+	spec := reconstructedSpec{
+		Type:                   jb.Type.String(),
+		SchemaVersion:          jb.SchemaVersion,
+		ExternalJobID:          jb.ExternalJobID.String(),
+		Name:                   jb.Name.ValueOrZero(),
+		ForwardingAllowed:      jb.ForwardingAllowed,
+		MaxTaskDuration:        intervalString(jb.MaxTaskDuration),
+		RunLimitMode:           jb.RunLimitMode.ValueOrZero(),
+		RetentionKeepFailedFor: intervalString(jb.RetentionKeepFailedFor),
+		RetentionKeepTags:      jb.RetentionKeepTags,
+		ObservationSource:      jb.PipelineSpec.DotDagSource,
+	}
+	for _, f := range []struct {
+		from clnull.Uint32
+		to   **uint32
+	}{
+		{jb.GasLimit, &spec.GasLimit},
+		{jb.MaxConcurrentRuns, &spec.MaxConcurrentRuns},
+		{jb.MaxRunsPerMinute, &spec.MaxRunsPerMinute},
+		{jb.RetentionMaxSuccessfulRuns, &spec.RetentionMaxSuccessfulRuns},
+	} {
+		if f.from.Valid {
+			v := f.from.Uint32
+			*f.to = &v
+		}
+	}
+	if s := jb.CronSpec; s != nil {
+		spec.Schedule = s.CronSchedule
+		if s.EVMChainID != nil {
+			spec.EVMChainID = s.EVMChainID.String()
+		}
+		spec.ConcurrencyPolicy = string(s.ConcurrencyPolicy)
+		spec.CatchUp = s.CatchUp
+		spec.Timezone = s.Timezone
+		spec.Jitter = intervalString(s.Jitter)
+	}
+	if s := jb.WebhookSpec; s != nil {
+		spec.SignatureAlgorithm = string(s.SignatureAlgorithm)
+		spec.SignatureKey = string(s.SignatureKey)
+		spec.SignatureTolerance = intervalString(s.SignatureTolerance)
+
+		var eis []struct {
+			Name string      `db:"name"`
+			Spec models.JSON `db:"spec"`
+		}
+		err := app.ds.SelectContext(ctx, &eis, `SELECT external_initiators.name, external_initiator_webhook_specs.spec
+			FROM external_initiator_webhook_specs
+			    JOIN external_initiators ON external_initiators.id = external_initiator_webhook_specs.external_initiator_id
+			WHERE external_initiator_webhook_specs.webhook_spec_id = $1
+			ORDER BY external_initiators.name`, s.ID)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to load the external initiators of job %d", jb.ID)
+		}
+		for _, ei := range eis {
+			spec.ExternalInitiators = append(spec.ExternalInitiators, reconstructedSpecExternalInitiator{Name: ei.Name, Spec: ei.Spec.String()})
+		}
+	}
+
+	var b strings.Builder
+	if err := toml.NewEncoder(&b).Order(toml.OrderPreserve).Encode(spec); err != nil {
+		return "", errors.Wrapf(err, "failed to encode the spec of job %d", jb.ID)
+	}
+	return b.String(), nil
+}
+
+func intervalString(i models.Interval) string {
+	if i.Duration() == 0 {
+		return ""
+	}
+	return i.Duration().String()
+}
+
+// ImportJobs creates the bridges, forwarders and jobs of the archive which
+// don't exist yet, in one transaction. External initiators are not created, as
+// their credentials are not exported, and are all reported as skipped. Jobs
+// whose dependencies are missing or which fail validation are skipped, as
+// reported. The services of the jobs are
+// started once the transaction is committed.
+func (app *ChainlinkApplication) ImportJobs(ctx context.Context, archive jobarchive.Archive) (*jobarchive.Report, error) {
+	if archive.Version != jobarchive.Version {
+		return nil, jobarchive.ErrUnsupportedVersion
+	}
+
+	report := &jobarchive.Report{}
+	var created []job.Job
+	err := sqlutil.Transact(ctx, app.jobORM.WithDataSource, app.ds, nil, func(tx job.ORM) error {
+		bridgeORM := app.bridgeORM.WithDataSource(tx.DataSource())
+		if err := importBridges(ctx, bridgeORM, archive.Bridges, report); err != nil {
+			return err
+		}
+		if err := skipExternalInitiators(ctx, bridgeORM, archive.ExternalInitiators, report); err != nil {
+			return err
+		}
+		if err := importForwarders(ctx, forwarders.NewORM(tx.DataSource()), archive.Forwarders, report); err != nil {
+			return err
+		}
+
+		for _, aj := range archive.Jobs {
+			name := aj.ExternalJobID.String()
+			_, err := tx.FindJobByExternalJobID(ctx, aj.ExternalJobID)
+			if err == nil {
+				report.Skip(jobarchive.KindJob, name, "already exists")
+				continue
+			} else if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+
+			jb, err := app.validatedImportedJob(ctx, bridgeORM, aj)
+			if err != nil {
+				report.Skip(jobarchive.KindJob, name, err.Error())
+				continue
+			}
+			if err = app.jobSpawner.CreateJobInTx(ctx, tx.DataSource(), &jb); err != nil {
+				return errors.Wrapf(err, "failed to create job %s", name)
+			}
+			if err = tx.InsertJobVersion(ctx, &job.JobVersion{JobID: jb.ID, Spec: aj.Spec, Author: jobarchive.VersionAuthor}); err != nil {
+				return err
+			}
+			created = append(created, jb)
+			report.Import(jobarchive.KindJob, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jb := range created {
+		// the job is kept, like jobs whose services fail to start on boot
+		if err = app.jobSpawner.StartJob(ctx, jb); err != nil {
+			app.logger.Errorw("Failed to start imported job", "jobID", jb.ID, "err", err)
+		}
+	}
+	return report, nil
+}
+
+func importBridges(ctx context.Context, orm bridges.ORM, btrs []bridges.BridgeTypeRequest, report *jobarchive.Report) error {
+	for i := range btrs {
+		btr := &btrs[i]
+		name := btr.Name.String()
+		if _, err := bridges.ParseBridgeName(name); err != nil {
+			report.Skip(jobarchive.KindBridge, name, err.Error())
+			continue
+		}
+		if strings.TrimSpace(btr.URL.String()) == "" {
+			report.Skip(jobarchive.KindBridge, name, "URL must be present")
+			continue
+		}
+		_, err := orm.FindBridge(ctx, btr.Name)
+		if err == nil {
+			report.Skip(jobarchive.KindBridge, name, "already exists")
+			continue
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		_, bt, err := bridges.NewBridgeType(btr)
+		if err != nil {
+			return err
+		}
+		if err = orm.CreateBridgeType(ctx, bt); err != nil {
+			return errors.Wrapf(err, "failed to create bridge %s", name)
+		}
+		report.Import(jobarchive.KindBridge, name)
+	}
+	return nil
+}
+
+// skipExternalInitiators reports the external initiators of the archive as
+// skipped, with whether they must be created before importing their jobs.
+func skipExternalInitiators(ctx context.Context, orm bridges.ORM, eis []jobarchive.ExternalInitiator, report *jobarchive.Report) error {
+	for _, ei := range eis {
+		_, err := orm.FindExternalInitiatorByName(ctx, ei.Name)
+		if err == nil {
+			report.Skip(jobarchive.KindExternalInitiator, ei.Name, "already exists")
+			continue
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		report.Skip(jobarchive.KindExternalInitiator, ei.Name, "credentials are not exported, it must be created before importing its jobs")
+	}
+	return nil
+}
+
+func importForwarders(ctx context.Context, orm forwarders.ORM, fwds []jobarchive.Forwarder, report *jobarchive.Report) error {
+	existing, _, err := orm.FindForwarders(ctx, 0, math.MaxUint32)
+	if err != nil {
+		return errors.Wrap(err, "failed to load forwarders")
+	}
+	for _, fwd := range fwds {
+		name := fwd.Address.Hex() + "@" + fwd.EVMChainID.String()
+		exists := false
+		for _, e := range existing {
+			if e.Address == fwd.Address && e.EVMChainID.Cmp(&fwd.EVMChainID) == 0 {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			report.Skip(jobarchive.KindForwarder, name, "already exists")
+			continue
+		}
+		if _, err = orm.CreateForwarder(ctx, fwd.Address, fwd.EVMChainID); err != nil {
+			return errors.Wrapf(err, "failed to create forwarder %s", name)
+		}
+		report.Import(jobarchive.KindForwarder, name)
+	}
+	return nil
+}
+
+// validatedImportedJob validates the spec of an imported job, and that the
+// bridges and keys it references exist.
+func (app *ChainlinkApplication) validatedImportedJob(ctx context.Context, bridgeORM bridges.ORM, aj jobarchive.Job) (jb job.Job, err error) {
+	if job.HasRedactedSecrets(aj.Spec) {
+		return jb, errors.New("spec has redacted secrets, they must be set before importing it")
+	}
+	refs, err := jobarchive.ParseReferences(aj.Spec)
+	if err != nil {
+		return jb, err
+	}
+	for _, name := range refs.Bridges {
+		if _, err = bridgeORM.FindBridge(ctx, name); err != nil {
+			return jb, errors.Wrapf(err, "bridge %s not found", name)
+		}
+	}
+	jb, err = app.validatedJobSpec(ctx, aj.Spec)
+	if err != nil {
+		return jb, err
+	}
+	if jb.ExternalJobID != aj.ExternalJobID {
+		return jb, errors.Errorf("spec has externalJobID %s", jb.ExternalJobID)
+	}
+	return jb, app.checkJobKeys(ctx, jb)
+}
+
+// checkJobKeys returns an error if a key referenced by the spec of jb is not in
+// the keystore.
+func (app *ChainlinkApplication) checkJobKeys(ctx context.Context, jb job.Job) error {
+	if s := jb.OCROracleSpec; s != nil {
+		if s.TransmitterAddress != nil {
+			if _, err := app.KeyStore.Eth().Get(ctx, s.TransmitterAddress.String()); err != nil {
+				return errors.Wrapf(err, "transmitterAddress %s not found", s.TransmitterAddress)
+			}
+		}
+		if s.EncryptedOCRKeyBundleID != nil {
+			if _, err := app.KeyStore.OCR().Get(s.EncryptedOCRKeyBundleID.String()); err != nil {
+				return errors.Wrapf(err, "keyBundleID %s not found", s.EncryptedOCRKeyBundleID)
+			}
+		}
+	}
+	if s := jb.OCR2OracleSpec; s != nil {
+		if s.OCRKeyBundleID.Valid {
+			if _, err := app.KeyStore.OCR2().Get(s.OCRKeyBundleID.String); err != nil {
+				return errors.Wrapf(err, "ocrKeyBundleID %s not found", s.OCRKeyBundleID.String)
+			}
+		}
+		if s.TransmitterID.Valid && s.Relay == relay.NetworkEVM {
+			if _, err := app.KeyStore.Eth().Get(ctx, s.TransmitterID.String); err != nil {
+				return errors.Wrapf(err, "transmitterID %s not found", s.TransmitterID.String)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package chainlink_test
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+const signedWebhookSpecTemplate = `
+type               = "webhook"
+schemaVersion      = 1
+externalJobID      = "%s"
+name               = "%s"
+signatureAlgorithm = "hmac-sha256"
+signatureKey       = "%s"
+externalInitiators = [
+	{ name = "%s", spec = '{"foo": "bar"}' },
+]
+observationSource  = """
+    parse_request  [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+    send_to_bridge [type=bridge name="%s" includeInputAtKey="result"];
+
+    parse_request -> send_to_bridge;
+"""
+`
+
+func TestChainlinkApplication_ExportJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	ei := cltest.MustInsertExternalInitiator(t, app.BridgeORM())
+	eim := app.GetExternalInitiatorManager()
+
+	// a job with a recorded version is exported with its spec
+	versionedSpec := fmt.Sprintf(testspecs.WebhookSpecWithBodyTemplate, uuid.New(), bridge.Name.String())
+	versioned, err := webhook.ValidatedWebhookSpec(ctx, versionedSpec, eim)
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &versioned))
+	require.NoError(t, app.JobORM().InsertJobVersion(ctx, &job.JobVersion{JobID: versioned.ID, Spec: versionedSpec}))
+
+	// jobs created before their versions were recorded have their spec reconstructed
+	key := strings.Repeat("k", 32)
+	webhookSpec := fmt.Sprintf(signedWebhookSpecTemplate, uuid.New(), "signed webhook", key, ei.Name, bridge.Name.String())
+	unversionedWebhook, err := webhook.ValidatedWebhookSpec(ctx, webhookSpec, eim)
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &unversionedWebhook))
+
+	unversionedCron, err := cron.ValidatedCronSpec(fmt.Sprintf(testspecs.CronSpecTemplate, uuid.New()))
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &unversionedCron))
+
+	archive, err := app.ExportJobs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, archive.Skipped)
+	require.Len(t, archive.Jobs, 3)
+	specs := make(map[uuid.UUID]string)
+	for _, aj := range archive.Jobs {
+		specs[aj.ExternalJobID] = aj.Spec
+	}
+
+	assert.Equal(t, versionedSpec, specs[versioned.ExternalJobID])
+
+	spec := specs[unversionedWebhook.ExternalJobID]
+	assert.NotContains(t, spec, key)
+	require.True(t, job.HasRedactedSecrets(spec))
+	reconstructed, err := webhook.ValidatedWebhookSpec(ctx, strings.Replace(spec, "'xxxxx'", fmt.Sprintf("%q", key), 1), eim)
+	require.NoError(t, err)
+	assert.Equal(t, unversionedWebhook.ExternalJobID, reconstructed.ExternalJobID)
+	assert.Equal(t, unversionedWebhook.Name, reconstructed.Name)
+	assert.Equal(t, unversionedWebhook.WebhookSpec.SignatureAlgorithm, reconstructed.WebhookSpec.SignatureAlgorithm)
+	assert.Equal(t, key, string(reconstructed.WebhookSpec.SignatureKey))
+	require.Len(t, reconstructed.WebhookSpec.ExternalInitiatorWebhookSpecs, 1)
+	assert.Equal(t, ei.ID, reconstructed.WebhookSpec.ExternalInitiatorWebhookSpecs[0].ExternalInitiatorID)
+	assert.JSONEq(t, `{"foo": "bar"}`, reconstructed.WebhookSpec.ExternalInitiatorWebhookSpecs[0].Spec.String())
+	assert.Equal(t, unversionedWebhook.Pipeline.Source, reconstructed.Pipeline.Source)
+
+	reconstructed, err = cron.ValidatedCronSpec(specs[unversionedCron.ExternalJobID])
+	require.NoError(t, err)
+	assert.Equal(t, unversionedCron.ExternalJobID, reconstructed.ExternalJobID)
+	assert.Equal(t, unversionedCron.CronSpec.CronSchedule, reconstructed.CronSpec.CronSchedule)
+	assert.Equal(t, unversionedCron.Pipeline.Source, reconstructed.Pipeline.Source)
+
+	require.Len(t, archive.Bridges, 1)
+	assert.Equal(t, bridge.Name, archive.Bridges[0].Name)
+	assert.Equal(t, []jobarchive.ExternalInitiator{{Name: ei.Name, URL: ei.URL}}, archive.ExternalInitiators)
+}
+
+func TestChainlinkApplication_ImportJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	ei := cltest.MustInsertExternalInitiator(t, app.BridgeORM())
+
+	t.Run("creates the jobs and starts their services", func(t *testing.T) {
+		externalJobID := uuid.New()
+		spec := fmt.Sprintf(testspecs.WebhookSpecWithBodyTemplate, externalJobID, bridge.Name.String())
+		redactedJobID := uuid.New()
+		redacted := job.RedactSpec(fmt.Sprintf(signedWebhookSpecTemplate, redactedJobID, "redacted webhook", strings.Repeat("k", 32), ei.Name, bridge.Name.String()))
+
+		report, err := app.ImportJobs(ctx, jobarchive.Archive{
+			Version: jobarchive.Version,
+			Jobs: []jobarchive.Job{
+				{ExternalJobID: externalJobID, Type: job.Webhook, Spec: spec},
+				{ExternalJobID: redactedJobID, Type: job.Webhook, Spec: redacted},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []jobarchive.Imported{{Kind: jobarchive.KindJob, Name: externalJobID.String()}}, report.Imported)
+		assert.Equal(t, []jobarchive.Skipped{
+			{Kind: jobarchive.KindJob, Name: redactedJobID.String(), Reason: "spec has redacted secrets, they must be set before importing it"},
+		}, report.Skipped)
+
+		jb, err := app.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+		require.NoError(t, err)
+		assert.Contains(t, app.JobSpawner().ActiveJobs(), jb.ID)
+		versions, err := app.JobORM().FindJobVersions(ctx, jb.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, spec, versions[0].Spec)
+		assert.Equal(t, jobarchive.VersionAuthor, versions[0].Author)
+	})
+
+	t.Run("creates nothing if the import fails", func(t *testing.T) {
+		jobs, _, err := app.JobORM().FindJobs(ctx, 0, 1000)
+		require.NoError(t, err)
+		active := len(app.JobSpawner().ActiveJobs())
+		countRows := func(table string) (count int) {
+			require.NoError(t, app.GetDB().GetContext(ctx, &count, `SELECT count(*) FROM `+table))
+			return
+		}
+		specs, versions := countRows("pipeline_specs"), countRows("job_versions")
+
+		// job names are unique
+		first, second := uuid.New(), uuid.New()
+		_, err = app.ImportJobs(ctx, jobarchive.Archive{
+			Version: jobarchive.Version,
+			Jobs: []jobarchive.Job{
+				{ExternalJobID: first, Type: job.Webhook, Spec: "name = \"duplicate\"\n" + fmt.Sprintf(testspecs.WebhookSpecWithBodyTemplate, first, bridge.Name.String())},
+				{ExternalJobID: second, Type: job.Webhook, Spec: "name = \"duplicate\"\n" + fmt.Sprintf(testspecs.WebhookSpecWithBodyTemplate, second, bridge.Name.String())},
+			},
+		})
+		require.Error(t, err)
+
+		after, _, err := app.JobORM().FindJobs(ctx, 0, 1000)
+		require.NoError(t, err)
+		assert.Len(t, after, len(jobs))
+		assert.Len(t, app.JobSpawner().ActiveJobs(), active)
+		assert.Equal(t, specs, countRows("pipeline_specs"))
+		assert.Equal(t, versions, countRows("job_versions"))
+	})
+
+	t.Run("reports the external initiators as skipped", func(t *testing.T) {
+		report, err := app.ImportJobs(ctx, jobarchive.Archive{
+			Version: jobarchive.Version,
+			ExternalInitiators: []jobarchive.ExternalInitiator{
+				{Name: ei.Name, URL: ei.URL},
+				{Name: "missing", URL: ei.URL},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, report.Imported)
+		assert.Equal(t, []jobarchive.Skipped{
+			{Kind: jobarchive.KindExternalInitiator, Name: ei.Name, Reason: "already exists"},
+			{Kind: jobarchive.KindExternalInitiator, Name: "missing", Reason: "credentials are not exported, it must be created before importing its jobs"},
+		}, report.Skipped)
+
+		_, err = app.BridgeORM().FindExternalInitiatorByName(ctx, "missing")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := app.ImportJobs(ctx, jobarchive.Archive{Version: jobarchive.Version + 1})
+		require.ErrorIs(t, err, jobarchive.ErrUnsupportedVersion)
+	})
+}
@@ -0,0 +1,89 @@
+package chainlink
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+)
+
+// ErrUnknownJobType is the error of job specs of an unknown job type.
+var ErrUnknownJobType = errors.New("unknown job type")
+
+// validatedJobSpec parses the job spec and validates it with the validator of
+// its job type, for the job reconciler and job imports.
+func (app *ChainlinkApplication) validatedJobSpec(ctx context.Context, spec string) (jb job.Job, err error) {
+	jobType, err := job.ValidateSpec(spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to parse TOML")
+	}
+	return ValidatedJobSpec(ctx, app, jobType, spec)
+}
+
+// ValidatedJobSpec validates the job spec with the validator of jobType. It
+// returns feeds.ErrOCRDisabled or feeds.ErrOCR2Disabled for specs of OCR jobs
+// disabled by configuration, and ErrUnknownJobType for unknown job types.
+func ValidatedJobSpec(ctx context.Context, app Application, jobType job.Type, spec string) (jb job.Job, err error) {
+	cfg := app.GetConfig()
+	switch jobType {
+	case job.OffchainReporting:
+		if !cfg.OCR().Enabled() {
+			return jb, feeds.ErrOCRDisabled
+		}
+		jb, err = ocr.ValidatedOracleSpecToml(cfg, app.GetRelayers().LegacyEVMChains(), spec)
+	case job.OffchainReporting2:
+		if !cfg.OCR2().Enabled() {
+			return jb, feeds.ErrOCR2Disabled
+		}
+		jb, err = validate.ValidatedOracleSpecToml(ctx, cfg.OCR2(), cfg.Insecure(), spec, app.GetLoopRegistrarConfig())
+	case job.DirectRequest:
+		jb, err = directrequest.ValidatedDirectRequestSpec(spec)
+	case job.FluxMonitor:
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(cfg.JobPipeline(), spec)
+	case job.Keeper:
+		jb, err = keeper.ValidatedKeeperSpec(spec)
+	case job.Cron:
+		jb, err = cron.ValidatedCronSpec(spec)
+	case job.VRF:
+		jb, err = vrfcommon.ValidatedVRFSpec(spec)
+	case job.Webhook:
+		jb, err = webhook.ValidatedWebhookSpec(ctx, spec, app.GetExternalInitiatorManager())
+	case job.BlockhashStore:
+		jb, err = blockhashstore.ValidatedSpec(spec)
+	case job.BlockHeaderFeeder:
+		jb, err = blockheaderfeeder.ValidatedSpec(spec)
+	case job.Bootstrap:
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(spec)
+	case job.Gateway:
+		jb, err = gateway.ValidatedGatewaySpec(spec)
+	case job.Stream:
+		jb, err = streams.ValidatedStreamSpec(spec)
+	case job.Workflow:
+		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, spec)
+	case job.StandardCapabilities:
+		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(spec)
+	case job.CCIP:
+		jb, err = ccip.ValidatedCCIPSpec(spec)
+	default:
+		return jb, errors.Wrapf(ErrUnknownJobType, "%s", jobType)
+	}
+	return jb, err
+}
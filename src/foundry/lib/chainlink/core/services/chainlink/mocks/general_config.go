@@ -970,6 +970,53 @@ func (_c *GeneralConfig_JobPipeline_Call) RunAndReturn(run func() config.JobPipe
 	return _c
 }
 
+// JobReconciler provides a mock function with no fields
+func (_m *GeneralConfig) JobReconciler() config.JobReconciler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobReconciler")
+	}
+
+	var r0 config.JobReconciler
+	if rf, ok := ret.Get(0).(func() config.JobReconciler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.JobReconciler)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_JobReconciler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobReconciler'
+type GeneralConfig_JobReconciler_Call struct {
+	*mock.Call
+}
+
+// JobReconciler is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) JobReconciler() *GeneralConfig_JobReconciler_Call {
+	return &GeneralConfig_JobReconciler_Call{Call: _e.mock.On("JobReconciler")}
+}
+
+func (_c *GeneralConfig_JobReconciler_Call) Run(run func()) *GeneralConfig_JobReconciler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_JobReconciler_Call) Return(_a0 config.JobReconciler) *GeneralConfig_JobReconciler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_JobReconciler_Call) RunAndReturn(run func() config.JobReconciler) *GeneralConfig_JobReconciler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Keeper provides a mock function with no fields
 func (_m *GeneralConfig) Keeper() config.Keeper {
 	ret := _m.Called()
@@ -1427,6 +1474,53 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// RemoteSigners provides a mock function with no fields
+func (_m *GeneralConfig) RemoteSigners() []config.RemoteSigner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteSigners")
+	}
+
+	var r0 []config.RemoteSigner
+	if rf, ok := ret.Get(0).(func() []config.RemoteSigner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.RemoteSigner)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_RemoteSigners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteSigners'
+type GeneralConfig_RemoteSigners_Call struct {
+	*mock.Call
+}
+
+// RemoteSigners is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) RemoteSigners() *GeneralConfig_RemoteSigners_Call {
+	return &GeneralConfig_RemoteSigners_Call{Call: _e.mock.On("RemoteSigners")}
+}
+
+func (_c *GeneralConfig_RemoteSigners_Call) Run(run func()) *GeneralConfig_RemoteSigners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigners_Call) Return(_a0 []config.RemoteSigner) *GeneralConfig_RemoteSigners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigners_Call) RunAndReturn(run func() []config.RemoteSigner) *GeneralConfig_RemoteSigners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with no fields
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -0,0 +1,64 @@
+package chainlink
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+var _ services.ServiceCtx = (*remoteSigners)(nil)
+
+// remoteSigners are the configured external signers of EVM keys. They are
+// registered with the keystore when the application is created, so that the
+// remote keys of the keyring can sign after a restart.
+type remoteSigners struct {
+	signers []*remotesigner.GRPC
+}
+
+func newRemoteSigners(cfgs []config.RemoteSigner, ks keystore.Eth) (*remoteSigners, error) {
+	rs := &remoteSigners{}
+	for _, cfg := range cfgs {
+		creds := insecure.NewCredentials()
+		if !cfg.InsecureConnection() {
+			var err error
+			creds, err = credentials.NewClientTLSFromFile(cfg.CACertFile(), "")
+			if err != nil {
+				return nil, multierr.Append(errors.Wrapf(err, "failed to load the CA certificate of remote signer %s", cfg.Name()), rs.Close())
+			}
+		}
+		signer, err := remotesigner.NewGRPC(cfg.Endpoint(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, multierr.Append(err, rs.Close())
+		}
+		rs.signers = append(rs.signers, signer)
+		ks.RegisterRemoteSigner(cfg.Name(), signer)
+	}
+	return rs, nil
+}
+
+func (rs *remoteSigners) Name() string { return "RemoteSigners" }
+
+func (rs *remoteSigners) Start(context.Context) error { return nil }
+
+func (rs *remoteSigners) Close() (err error) {
+	for _, s := range rs.signers {
+		err = multierr.Append(err, s.Close())
+	}
+	return
+}
+
+func (rs *remoteSigners) Ready() error { return nil }
+
+func (rs *remoteSigners) HealthReport() map[string]error {
+	return map[string]error{rs.Name(): nil}
+}
@@ -3,6 +3,9 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -13,35 +16,59 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+var scheduleParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
-	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         services.StopChan
+	wgDone         sync.WaitGroup
+
+	schedule          cron.Schedule
+	concurrencyPolicy job.CronConcurrencyPolicy
+
+	runsMu sync.Mutex
+	// runs holds the cancel functions of the runs in progress
+	runs   map[int64]context.CancelFunc
+	nextID int64
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
-		"schedule", jobSpec.CronSpec.CronSchedule,
+		"schedule", jobSpec.CronSpec.Schedule(),
 	)
 	if id := jobSpec.CronSpec.EVMChainID; id != nil {
 		cronLogger = logger.With("evmChainID", id)
 	}
 
+	schedule, err := scheduleParser.Parse(jobSpec.CronSpec.Schedule())
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %w", jobSpec.CronSpec.Schedule(), err)
+	}
+	policy, err := job.ParseCronConcurrencyPolicy(string(jobSpec.CronSpec.ConcurrencyPolicy))
+	if err != nil {
+		return nil, err
+	}
+
 	return &Cron{
-		cronRunner:     cronRunner(),
-		logger:         cronLogger,
-		jobSpec:        jobSpec,
-		pipelineRunner: pipelineRunner,
-		chStop:         make(chan struct{}),
+		logger:            cronLogger,
+		jobSpec:           jobSpec,
+		pipelineRunner:    pipelineRunner,
+		orm:               orm,
+		chStop:            make(chan struct{}),
+		schedule:          schedule,
+		concurrencyPolicy: policy,
+		runs:              make(map[int64]context.CancelFunc),
 	}, nil
 }
 
@@ -49,12 +76,8 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
-		return err
-	}
-	cr.cronRunner.Start()
+	cr.wgDone.Add(1)
+	go cr.run()
 	return nil
 }
 
@@ -62,14 +85,136 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	cr.wgDone.Wait()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+func (cr *Cron) run() {
+	defer cr.wgDone.Done()
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
+	cr.catchUp(ctx)
+
+	next := cr.schedule.Next(time.Now())
+	for {
+		if next.IsZero() {
+			cr.logger.Warn("Cron schedule has no future fire times")
+			<-cr.chStop
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-cr.chStop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		cr.fire(ctx, next)
+
+		// skip the schedules missed while firing, e.g. after a system sleep
+		next = cr.schedule.Next(next)
+		if now := time.Now(); next.Before(now) {
+			next = cr.schedule.Next(now)
+		}
+	}
+}
+
+// missedSchedules returns up to CatchUp of the most recent schedules since
+// the last fire time.
+func (cr *Cron) missedSchedules(now time.Time) []time.Time {
+	spec := cr.jobSpec.CronSpec
+	if spec.CatchUp == 0 || !spec.LastFiredAt.Valid {
+		return nil
+	}
+	var missed []time.Time
+	for t := cr.schedule.Next(spec.LastFiredAt.Time); !t.IsZero() && !t.After(now); t = cr.schedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > int(spec.CatchUp) {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
+// catchUp runs the schedules missed while the node was down, one after the
+// other.
+func (cr *Cron) catchUp(ctx context.Context) {
+	missed := cr.missedSchedules(time.Now())
+	if len(missed) == 0 {
+		return
+	}
+	cr.logger.Infow("Catching up on missed schedules", "missed", len(missed), "lastFiredAt", cr.jobSpec.CronSpec.LastFiredAt.Time)
+	for _, scheduled := range missed {
+		if ctx.Err() != nil {
+			return
+		}
+		cr.recordFire(ctx, scheduled)
+		cr.runPipeline(ctx, scheduled, true)
+	}
+}
+
+// fire starts the run scheduled at the given time, according to the
+// concurrency policy.
+func (cr *Cron) fire(ctx context.Context, scheduled time.Time) {
+	cr.recordFire(ctx, scheduled)
+
+	cr.runsMu.Lock()
+	defer cr.runsMu.Unlock()
+	if len(cr.runs) > 0 {
+		switch cr.concurrencyPolicy {
+		case job.CronConcurrencyForbid:
+			cr.logger.Warnw("Skipping scheduled run, the previous run has not finished yet", "scheduledTime", scheduled)
+			return
+		case job.CronConcurrencyReplace:
+			cr.logger.Warnw("Cancelling the previous run, which has not finished yet", "scheduledTime", scheduled)
+			for _, cancel := range cr.runs {
+				cancel()
+			}
+		case job.CronConcurrencyAllow:
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	id := cr.nextID
+	cr.nextID++
+	cr.runs[id] = cancel
+
+	cr.wgDone.Add(1)
+	go func() {
+		defer cr.wgDone.Done()
+		defer func() {
+			cr.runsMu.Lock()
+			delete(cr.runs, id)
+			cr.runsMu.Unlock()
+			cancel()
+		}()
+
+		if jitter := cr.jobSpec.CronSpec.Jitter.Duration(); jitter > 0 {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-time.After(rand.N(jitter)):
+			}
+		}
+		cr.runPipeline(runCtx, scheduled, false)
+	}()
+}
+
+// recordFire persists the scheduled time, so missed schedules can be caught
+// up after a restart.
+func (cr *Cron) recordFire(ctx context.Context, scheduled time.Time) {
+	if cr.orm == nil {
+		return
+	}
+	if err := cr.orm.UpdateLastFiredAt(ctx, cr.jobSpec.CronSpec.ID, scheduled); err != nil {
+		cr.logger.Errorw("Failed to persist cron fire time", "scheduledTime", scheduled, "err", err)
+	}
+}
+
+func (cr *Cron) runPipeline(ctx context.Context, scheduled time.Time, catchUp bool) {
 	jobSpec := map[string]interface{}{
 		"databaseID":    cr.jobSpec.ID,
 		"externalJobID": cr.jobSpec.ExternalJobID,
@@ -82,7 +227,10 @@ func (cr *Cron) runPipeline() {
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": jobSpec,
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": map[string]interface{}{
+				"scheduledTime": scheduled.UTC().Format(time.RFC3339),
+				"catchUp":       catchUp,
+			},
 		},
 	})
 
@@ -93,7 +241,3 @@ func (cr *Cron) runPipeline() {
 		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
 	}
 }
-
-func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
-}
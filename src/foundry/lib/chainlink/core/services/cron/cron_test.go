@@ -1,12 +1,17 @@
 package cron_test
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -38,7 +43,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, cron.NewORM(db), lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -62,16 +67,177 @@ func TestCronV2Schedule(t *testing.T) {
 	}
 	runner := pipelinemocks.NewRunner(t)
 	awaiter := cltest.NewAwaiter()
+	var meta map[string]interface{}
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) { awaiter.ItHappened() }).
+		Run(func(args mock.Arguments) {
+			meta = runMeta(args.Get(1).(*pipeline.Run))
+			awaiter.ItHappened()
+		}).
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, nil, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
 	defer func() { assert.NoError(t, service.Close()) }()
 
 	awaiter.AwaitOrFail(t)
+	scheduled, err := time.Parse(time.RFC3339, meta["scheduledTime"].(string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), scheduled, 2*time.Second)
+	assert.Equal(t, false, meta["catchUp"])
+}
+
+func TestCron_CatchUp(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	lastHour := now.Truncate(time.Hour)
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule: "0 0 * * * *",
+			Timezone:     "UTC",
+			CatchUp:      2,
+			// three schedules were missed
+			LastFiredAt: null.TimeFrom(lastHour.Add(-3 * time.Hour)),
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	var (
+		mu        sync.Mutex
+		scheduled []string
+	)
+	awaiter := cltest.NewAwaiter()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			meta := runMeta(args.Get(1).(*pipeline.Run))
+			assert.Equal(t, true, meta["catchUp"])
+			mu.Lock()
+			defer mu.Unlock()
+			scheduled = append(scheduled, meta["scheduledTime"].(string))
+			if len(scheduled) == 2 {
+				awaiter.ItHappened()
+			}
+		}).
+		Return(false, nil).
+		Twice()
+	orm := &fakeORM{}
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, service.Close()) }()
+
+	awaiter.AwaitOrFail(t)
+	mu.Lock()
+	defer mu.Unlock()
+	// only the most recent missed schedules are run, oldest first
+	assert.Equal(t, []string{
+		lastHour.Add(-time.Hour).Format(time.RFC3339),
+		lastHour.Format(time.RFC3339),
+	}, scheduled)
+	assert.True(t, lastHour.Equal(orm.lastFiredAt()))
+}
+
+func TestCron_ConcurrencyPolicyForbid(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", ConcurrencyPolicy: job.CronConcurrencyForbid},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	release := make(chan struct{})
+	var runs atomic.Int32
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			runs.Add(1)
+			<-release
+		}).
+		Return(false, nil)
+	orm := &fakeORM{}
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	// the first run blocks, the following schedules are skipped
+	require.Eventually(t, func() bool { return orm.fires() >= 3 }, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
+
+	close(release)
+	assert.NoError(t, service.Close())
+}
+
+func TestORM_UpdateLastFiredAt(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), keyStore, lggr)
+
+	jb := &job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1h", ConcurrencyPolicy: job.CronConcurrencyReplace, CatchUp: 5},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	require.NoError(t, jobORM.CreateJob(ctx, jb))
+
+	orm := cron.NewORM(db)
+	firedAt := time.Now().Truncate(time.Second)
+	require.NoError(t, orm.UpdateLastFiredAt(ctx, jb.CronSpec.ID, firedAt))
+	// earlier times don't overwrite later ones
+	require.NoError(t, orm.UpdateLastFiredAt(ctx, jb.CronSpec.ID, firedAt.Add(-time.Hour)))
+
+	found, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.True(t, found.CronSpec.LastFiredAt.Valid)
+	assert.True(t, firedAt.Equal(found.CronSpec.LastFiredAt.Time))
+	assert.Equal(t, job.CronConcurrencyReplace, found.CronSpec.ConcurrencyPolicy)
+	assert.Equal(t, uint32(5), found.CronSpec.CatchUp)
+}
+
+func runMeta(run *pipeline.Run) map[string]interface{} {
+	jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+	return jobRun["meta"].(map[string]interface{})
+}
+
+type fakeORM struct {
+	mu    sync.Mutex
+	last  time.Time
+	count int
+}
+
+func (o *fakeORM) UpdateLastFiredAt(_ context.Context, _ int32, firedAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.count++
+	if firedAt.After(o.last) {
+		o.last = firedAt
+	}
+	return nil
+}
+
+func (o *fakeORM) lastFiredAt() time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.last
+}
+
+func (o *fakeORM) fires() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.count
 }
@@ -0,0 +1,33 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM persists the state of cron jobs, so it survives restarts.
+type ORM interface {
+	// UpdateLastFiredAt records the scheduled time of the last run of the cron
+	// spec, unless a later time is already recorded.
+	UpdateLastFiredAt(ctx context.Context, cronSpecID int32, firedAt time.Time) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpdateLastFiredAt(ctx context.Context, cronSpecID int32, firedAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE cron_specs SET last_fired_at = $2, updated_at = NOW()
+		WHERE id = $1 AND (last_fired_at IS NULL OR last_fired_at < $2)`, cronSpecID, firedAt)
+	return errors.Wrap(err, "UpdateLastFiredAt failed")
+}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -34,9 +37,23 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("cron schedule cannot specify a CRON_TZ time zone when timezone is set")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(spec.Schedule()); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if _, err := job.ParseCronConcurrencyPolicy(string(spec.ConcurrencyPolicy)); err != nil {
+		return jb, err
+	}
+	if spec.Jitter.Duration() < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %v", spec.Jitter.Duration())
+	}
 
 	return jb, nil
 }
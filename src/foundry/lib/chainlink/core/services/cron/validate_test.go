@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -76,6 +77,77 @@ ds -> ds_parse -> ds_multiply;
 				assert.Contains(t, err.Error(), "invalid cron schedule")
 			},
 		},
+		{
+			name: "timezone, policies and jitter",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "0 0 9 * * MON-FRI"
+timezone          = "America/New_York"
+concurrencyPolicy = "forbid"
+catchUp           = 3
+jitter            = "30s"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 9 * * MON-FRI", s.CronSpec.Schedule())
+				assert.Equal(t, job.CronConcurrencyForbid, s.CronSpec.ConcurrencyPolicy)
+				assert.Equal(t, uint32(3), s.CronSpec.CatchUp)
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter.Duration())
+			},
+		},
+		{
+			name: "timezone and CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+timezone        = "Europe/Berlin"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "cannot specify a CRON_TZ time zone when timezone is set")
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid timezone")
+			},
+		},
+		{
+			name: "invalid concurrency policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 1 * *"
+concurrencyPolicy = "queue"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `unknown concurrency policy "queue"`)
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
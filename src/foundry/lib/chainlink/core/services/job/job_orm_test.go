@@ -282,7 +282,7 @@ func TestORM(t *testing.T) {
 			{Name: eiFoo.Name, Spec: cltest.JSONFromString(t, `{}`)},
 			{Name: eiBar.Name, Spec: cltest.JSONFromString(t, `{"bar": 1}`)},
 		}
-		eim := webhook.NewExternalInitiatorManager(db, nil)
+		eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t))
 		jb, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{ExternalInitiators: eiWS}).Toml(), eim)
 		require.NoError(t, err)
 
@@ -2451,3 +2451,88 @@ func Test_FindStandardCapabilityJobID_NoMatch(t *testing.T) {
 	require.Error(t, err, "found standard capabilities with different command")
 	require.Equal(t, int32(0), id, "found non-zero job id")
 }
+
+func TestORM_JobVersions(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+	orm := NewTestORM(t, db, pipelineORM, bridges.NewORM(db), keyStore)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	v1 := job.JobVersion{JobID: jb.ID, Spec: "type = 'webhook'", Author: "alice@chainlink.test"}
+	require.NoError(t, orm.InsertJobVersion(ctx, &v1))
+	assert.Equal(t, int32(1), v1.Version)
+	assert.Equal(t, jb.PipelineSpecID, v1.PipelineSpecID)
+
+	v2 := job.JobVersion{JobID: jb.ID, Spec: "type = 'webhook'\nname = 'v2'", Author: "bob@chainlink.test"}
+	require.NoError(t, orm.InsertJobVersion(ctx, &v2))
+	assert.Equal(t, int32(2), v2.Version)
+
+	versions, err := orm.FindJobVersions(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, v2.Spec, versions[0].Spec)
+	assert.Equal(t, v1.Spec, versions[1].Spec)
+
+	found, err := orm.FindJobVersion(ctx, jb.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, v1.Author, found.Author)
+	_, err = orm.FindJobVersion(ctx, jb.ID, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	diff, err := v2.Diff(v1)
+	require.NoError(t, err)
+	assert.Equal(t, "--- version 1\n+++ version 2\n@@ -1 +1,2 @@\n type = 'webhook'\n+name = 'v2'\n", diff)
+
+	t.Run("versions of missing jobs cannot be recorded", func(t *testing.T) {
+		err := orm.InsertJobVersion(ctx, &job.JobVersion{JobID: jb.ID + 1000, Spec: "type = 'webhook'"})
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("deleting the job deletes its versions and runs", func(t *testing.T) {
+		deleted, _ := cltest.MustInsertWebhookSpec(t, db)
+		require.NoError(t, orm.InsertJobVersion(ctx, &job.JobVersion{JobID: deleted.ID, Spec: "type = 'webhook'"}))
+		cltest.MustInsertPipelineRunWithStatus(t, db, deleted.PipelineSpecID, pipeline.RunStatusCompleted, deleted.ID)
+
+		require.NoError(t, orm.DeleteJob(ctx, deleted.ID, deleted.Type))
+		var count int
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM job_versions WHERE job_id = $1`, deleted.ID))
+		assert.Equal(t, 0, count)
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1`, deleted.ID))
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("detached pipeline specs outlive the job", func(t *testing.T) {
+		ids, err := orm.DetachPipelineSpecs(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{jb.PipelineSpecID}, ids)
+
+		// the job is still loaded with a copy of its primary pipeline spec
+		copied, err := orm.FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, jb.PipelineSpecID, copied.PipelineSpecID)
+
+		// as in UpdateJobV2, which creates the job again with the same ID
+		// before the versions of the detached specs are checked
+		_, err = db.ExecContext(ctx, `SET CONSTRAINTS fk_pipeline_runs_pruning_key, fk_job_versions_job DEFERRED`)
+		require.NoError(t, err)
+		require.NoError(t, orm.DeleteJob(ctx, jb.ID, jb.Type))
+		var count int
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM pipeline_specs WHERE id = $1`, jb.PipelineSpecID))
+		assert.Equal(t, 1, count)
+		require.NoError(t, db.GetContext(ctx, &count, `SELECT count(*) FROM pipeline_specs WHERE id = $1`, copied.PipelineSpecID))
+		assert.Equal(t, 0, count)
+
+		other, _ := cltest.MustInsertWebhookSpec(t, db)
+		require.NoError(t, orm.AttachPipelineSpecs(ctx, other.ID, ids))
+		var specs []job.PipelineSpec
+		require.NoError(t, db.SelectContext(ctx, &specs, `SELECT * FROM job_pipeline_specs WHERE job_id = $1 ORDER BY is_primary`, other.ID))
+		require.Len(t, specs, 2)
+		assert.Equal(t, jb.PipelineSpecID, specs[0].PipelineSpecID)
+		assert.False(t, specs[0].IsPrimary)
+		assert.True(t, specs[1].IsPrimary)
+	})
+}
@@ -81,6 +81,54 @@ func (_c *ORM_AssertBridgesExist_Call) RunAndReturn(run func(context.Context, pi
 	return _c
 }
 
+// AttachPipelineSpecs provides a mock function with given fields: ctx, jobID, ids
+func (_m *ORM) AttachPipelineSpecs(ctx context.Context, jobID int32, ids []int32) error {
+	ret := _m.Called(ctx, jobID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachPipelineSpecs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []int32) error); ok {
+		r0 = rf(ctx, jobID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_AttachPipelineSpecs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachPipelineSpecs'
+type ORM_AttachPipelineSpecs_Call struct {
+	*mock.Call
+}
+
+// AttachPipelineSpecs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - ids []int32
+func (_e *ORM_Expecter) AttachPipelineSpecs(ctx interface{}, jobID interface{}, ids interface{}) *ORM_AttachPipelineSpecs_Call {
+	return &ORM_AttachPipelineSpecs_Call{Call: _e.mock.On("AttachPipelineSpecs", ctx, jobID, ids)}
+}
+
+func (_c *ORM_AttachPipelineSpecs_Call) Run(run func(ctx context.Context, jobID int32, ids []int32)) *ORM_AttachPipelineSpecs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].([]int32))
+	})
+	return _c
+}
+
+func (_c *ORM_AttachPipelineSpecs_Call) Return(_a0 error) *ORM_AttachPipelineSpecs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_AttachPipelineSpecs_Call) RunAndReturn(run func(context.Context, int32, []int32) error) *ORM_AttachPipelineSpecs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *ORM) Close() error {
 	ret := _m.Called()
@@ -325,6 +373,65 @@ func (_c *ORM_DeleteJob_Call) RunAndReturn(run func(context.Context, int32, job.
 	return _c
 }
 
+// DetachPipelineSpecs provides a mock function with given fields: ctx, jobID
+func (_m *ORM) DetachPipelineSpecs(ctx context.Context, jobID int32) ([]int32, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachPipelineSpecs")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]int32, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []int32); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DetachPipelineSpecs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachPipelineSpecs'
+type ORM_DetachPipelineSpecs_Call struct {
+	*mock.Call
+}
+
+// DetachPipelineSpecs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) DetachPipelineSpecs(ctx interface{}, jobID interface{}) *ORM_DetachPipelineSpecs_Call {
+	return &ORM_DetachPipelineSpecs_Call{Call: _e.mock.On("DetachPipelineSpecs", ctx, jobID)}
+}
+
+func (_c *ORM_DetachPipelineSpecs_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_DetachPipelineSpecs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_DetachPipelineSpecs_Call) Return(_a0 []int32, _a1 error) *ORM_DetachPipelineSpecs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DetachPipelineSpecs_Call) RunAndReturn(run func(context.Context, int32) ([]int32, error)) *ORM_DetachPipelineSpecs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DismissError provides a mock function with given fields: ctx, errorID
 func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	ret := _m.Called(ctx, errorID)
@@ -831,6 +938,123 @@ func (_c *ORM_FindJobIDsWithBridge_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindJobVersion provides a mock function with given fields: ctx, jobID, version
+func (_m *ORM) FindJobVersion(ctx context.Context, jobID int32, version int32) (job.JobVersion, error) {
+	ret := _m.Called(ctx, jobID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobVersion")
+	}
+
+	var r0 job.JobVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) (job.JobVersion, error)); ok {
+		return rf(ctx, jobID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) job.JobVersion); ok {
+		r0 = rf(ctx, jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.JobVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobVersion'
+type ORM_FindJobVersion_Call struct {
+	*mock.Call
+}
+
+// FindJobVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - version int32
+func (_e *ORM_Expecter) FindJobVersion(ctx interface{}, jobID interface{}, version interface{}) *ORM_FindJobVersion_Call {
+	return &ORM_FindJobVersion_Call{Call: _e.mock.On("FindJobVersion", ctx, jobID, version)}
+}
+
+func (_c *ORM_FindJobVersion_Call) Run(run func(ctx context.Context, jobID int32, version int32)) *ORM_FindJobVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobVersion_Call) Return(_a0 job.JobVersion, _a1 error) *ORM_FindJobVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobVersion_Call) RunAndReturn(run func(context.Context, int32, int32) (job.JobVersion, error)) *ORM_FindJobVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindJobVersions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) FindJobVersions(ctx context.Context, jobID int32) ([]job.JobVersion, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobVersions")
+	}
+
+	var r0 []job.JobVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.JobVersion, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.JobVersion); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.JobVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobVersions'
+type ORM_FindJobVersions_Call struct {
+	*mock.Call
+}
+
+// FindJobVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) FindJobVersions(ctx interface{}, jobID interface{}) *ORM_FindJobVersions_Call {
+	return &ORM_FindJobVersions_Call{Call: _e.mock.On("FindJobVersions", ctx, jobID)}
+}
+
+func (_c *ORM_FindJobVersions_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_FindJobVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobVersions_Call) Return(_a0 []job.JobVersion, _a1 error) *ORM_FindJobVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobVersions_Call) RunAndReturn(run func(context.Context, int32) ([]job.JobVersion, error)) *ORM_FindJobVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobWithoutSpecErrors provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJobWithoutSpecErrors(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -1529,6 +1753,53 @@ func (_c *ORM_InsertJob_Call) RunAndReturn(run func(context.Context, *job.Job) e
 	return _c
 }
 
+// InsertJobVersion provides a mock function with given fields: ctx, v
+func (_m *ORM) InsertJobVersion(ctx context.Context, v *job.JobVersion) error {
+	ret := _m.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertJobVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.JobVersion) error); ok {
+		r0 = rf(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_InsertJobVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertJobVersion'
+type ORM_InsertJobVersion_Call struct {
+	*mock.Call
+}
+
+// InsertJobVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - v *job.JobVersion
+func (_e *ORM_Expecter) InsertJobVersion(ctx interface{}, v interface{}) *ORM_InsertJobVersion_Call {
+	return &ORM_InsertJobVersion_Call{Call: _e.mock.On("InsertJobVersion", ctx, v)}
+}
+
+func (_c *ORM_InsertJobVersion_Call) Run(run func(ctx context.Context, v *job.JobVersion)) *ORM_InsertJobVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.JobVersion))
+	})
+	return _c
+}
+
+func (_c *ORM_InsertJobVersion_Call) Return(_a0 error) *ORM_InsertJobVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_InsertJobVersion_Call) RunAndReturn(run func(context.Context, *job.JobVersion) error) *ORM_InsertJobVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertWebhookSpec provides a mock function with given fields: ctx, webhookSpec
 func (_m *ORM) InsertWebhookSpec(ctx context.Context, webhookSpec *job.WebhookSpec) error {
 	ret := _m.Called(ctx, webhookSpec)
@@ -1576,6 +1847,53 @@ func (_c *ORM_InsertWebhookSpec_Call) RunAndReturn(run func(context.Context, *jo
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, id
+func (_m *ORM) PauseJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type ORM_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *ORM_Expecter) PauseJob(ctx interface{}, id interface{}) *ORM_PauseJob_Call {
+	return &ORM_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, id)}
+}
+
+func (_c *ORM_PauseJob_Call) Run(run func(ctx context.Context, id int32)) *ORM_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_PauseJob_Call) Return(_a0 error) *ORM_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *ORM_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineRuns provides a mock function with given fields: ctx, jobID, offset, size
 func (_m *ORM) PipelineRuns(ctx context.Context, jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(ctx, jobID, offset, size)
@@ -1692,6 +2010,53 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, id
+func (_m *ORM) ResumeJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type ORM_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *ORM_Expecter) ResumeJob(ctx interface{}, id interface{}) *ORM_ResumeJob_Call {
+	return &ORM_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, id)}
+}
+
+func (_c *ORM_ResumeJob_Call) Run(run func(ctx context.Context, id int32)) *ORM_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_ResumeJob_Call) Return(_a0 error) *ORM_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *ORM_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
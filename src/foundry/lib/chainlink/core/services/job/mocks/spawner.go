@@ -164,6 +164,54 @@ func (_c *Spawner_CreateJob_Call) RunAndReturn(run func(context.Context, sqlutil
 	return _c
 }
 
+// CreateJobInTx provides a mock function with given fields: ctx, ds, jb
+func (_m *Spawner) CreateJobInTx(ctx context.Context, ds sqlutil.DataSource, jb *job.Job) error {
+	ret := _m.Called(ctx, ds, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobInTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, *job.Job) error); ok {
+		r0 = rf(ctx, ds, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_CreateJobInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobInTx'
+type Spawner_CreateJobInTx_Call struct {
+	*mock.Call
+}
+
+// CreateJobInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jb *job.Job
+func (_e *Spawner_Expecter) CreateJobInTx(ctx interface{}, ds interface{}, jb interface{}) *Spawner_CreateJobInTx_Call {
+	return &Spawner_CreateJobInTx_Call{Call: _e.mock.On("CreateJobInTx", ctx, ds, jb)}
+}
+
+func (_c *Spawner_CreateJobInTx_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jb *job.Job)) *Spawner_CreateJobInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_CreateJobInTx_Call) Return(_a0 error) *Spawner_CreateJobInTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_CreateJobInTx_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, *job.Job) error) *Spawner_CreateJobInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, ds, jobID
 func (_m *Spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	ret := _m.Called(ctx, ds, jobID)
@@ -212,6 +260,54 @@ func (_c *Spawner_DeleteJob_Call) RunAndReturn(run func(context.Context, sqlutil
 	return _c
 }
 
+// DeleteJobInTx provides a mock function with given fields: ctx, ds, jobID
+func (_m *Spawner) DeleteJobInTx(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	ret := _m.Called(ctx, ds, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJobInTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32) error); ok {
+		r0 = rf(ctx, ds, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_DeleteJobInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJobInTx'
+type Spawner_DeleteJobInTx_Call struct {
+	*mock.Call
+}
+
+// DeleteJobInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jobID int32
+func (_e *Spawner_Expecter) DeleteJobInTx(ctx interface{}, ds interface{}, jobID interface{}) *Spawner_DeleteJobInTx_Call {
+	return &Spawner_DeleteJobInTx_Call{Call: _e.mock.On("DeleteJobInTx", ctx, ds, jobID)}
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jobID int32)) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) Return(_a0 error) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, int32) error) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with no fields
 func (_m *Spawner) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -304,6 +400,53 @@ func (_c *Spawner_Name_Call) RunAndReturn(run func() string) *Spawner_Name_Call
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Spawner_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Spawner_PauseJob_Call {
+	return &Spawner_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Spawner_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) Return(_a0 error) *Spawner_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -349,6 +492,53 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Spawner_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Spawner_ResumeJob_Call {
+	return &Spawner_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Spawner_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) Return(_a0 error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -395,6 +585,53 @@ func (_c *Spawner_Start_Call) RunAndReturn(run func(context.Context) error) *Spa
 	return _c
 }
 
+// StartJob provides a mock function with given fields: ctx, jb
+func (_m *Spawner) StartJob(ctx context.Context, jb job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_StartJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartJob'
+type Spawner_StartJob_Call struct {
+	*mock.Call
+}
+
+// StartJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+func (_e *Spawner_Expecter) StartJob(ctx interface{}, jb interface{}) *Spawner_StartJob_Call {
+	return &Spawner_StartJob_Call{Call: _e.mock.On("StartJob", ctx, jb)}
+}
+
+func (_c *Spawner_StartJob_Call) Run(run func(ctx context.Context, jb job.Job)) *Spawner_StartJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_StartJob_Call) Return(_a0 error) *Spawner_StartJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_StartJob_Call) RunAndReturn(run func(context.Context, job.Job) error) *Spawner_StartJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, spec
 func (_m *Spawner) StartService(ctx context.Context, spec job.Job) error {
 	ret := _m.Called(ctx, spec)
@@ -442,6 +679,39 @@ func (_c *Spawner_StartService_Call) RunAndReturn(run func(context.Context, job.
 	return _c
 }
 
+// StopJob provides a mock function with given fields: jobID
+func (_m *Spawner) StopJob(jobID int32) {
+	_m.Called(jobID)
+}
+
+// Spawner_StopJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopJob'
+type Spawner_StopJob_Call struct {
+	*mock.Call
+}
+
+// StopJob is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Spawner_Expecter) StopJob(jobID interface{}) *Spawner_StopJob_Call {
+	return &Spawner_StopJob_Call{Call: _e.mock.On("StopJob", jobID)}
+}
+
+func (_c *Spawner_StopJob_Call) Run(run func(jobID int32)) *Spawner_StopJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_StopJob_Call) Return() *Spawner_StopJob_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Spawner_StopJob_Call) RunAndReturn(run func(int32)) *Spawner_StopJob_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
@@ -6,6 +6,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	"gopkg.in/guregu/null.v4"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
@@ -26,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
@@ -185,14 +188,43 @@ type Job struct {
 	CCIPSpec                      *CCIPSpec
 	CCIPBootstrapSpecID           *int32
 	JobSpecErrors                 []SpecError
-	Type                          Type          `toml:"type"`
-	SchemaVersion                 uint32        `toml:"schemaVersion"`
-	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
-	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
-	Name                          null.String   `toml:"name"`
+	Type                          Type            `toml:"type"`
+	SchemaVersion                 uint32          `toml:"schemaVersion"`
+	GasLimit                      clnull.Uint32   `toml:"gasLimit"`
+	ForwardingAllowed             bool            `toml:"forwardingAllowed"`
+	MaxConcurrentRuns             clnull.Uint32   `toml:"maxConcurrentRuns"`
+	MaxRunsPerMinute              clnull.Uint32   `toml:"maxRunsPerMinute"`
+	RunLimitMode                  null.String     `toml:"runLimitMode"`
+	RetentionMaxSuccessfulRuns    clnull.Uint32   `toml:"retentionMaxSuccessfulRuns"`
+	RetentionKeepFailedFor        models.Interval `toml:"retentionKeepFailedFor"`
+	RetentionKeepTags             pq.StringArray  `toml:"retentionKeepTags"`
+	Name                          null.String     `toml:"name"`
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	PausedAt                      null.Time `toml:"-"`
+}
+
+// Paused returns whether the job has been paused, in which case its services
+// are not running until it is resumed.
+func (j Job) Paused() bool {
+	return j.PausedAt.Valid
+}
+
+// RunRetention returns the retention policy of the runs of the job set by its
+// spec, which overrides the policy of its job type.
+func (j Job) RunRetention() config.RunRetention {
+	var r config.RunRetention
+	if j.RetentionMaxSuccessfulRuns.Valid {
+		n := uint64(j.RetentionMaxSuccessfulRuns.Uint32)
+		r.MaxSuccessfulRuns = &n
+	}
+	if !j.RetentionKeepFailedFor.IsZero() {
+		d := j.RetentionKeepFailedFor.Duration()
+		r.KeepFailedFor = &d
+	}
+	r.KeepTags = j.RetentionKeepTags
+	return r
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -253,6 +285,134 @@ func (j *SpecError) SetID(value string) error {
 	return nil
 }
 
+// JobVersion is an immutable revision of the TOML spec of a job. A version is
+// recorded each time a job is created or updated from its spec, and refers to
+// the pipeline spec the runs of that version were produced by.
+type JobVersion struct {
+	ID             int64
+	JobID          int32
+	Version        int32
+	Spec           string
+	Author         string
+	PipelineSpecID int32
+	CreatedAt      time.Time
+}
+
+// Diff returns the unified diff from the spec of an earlier version to the
+// spec of v.
+func (v JobVersion) Diff(from JobVersion) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Spec),
+		B:        difflib.SplitLines(v.Spec),
+		FromFile: fmt.Sprintf("version %d", from.Version),
+		ToFile:   fmt.Sprintf("version %d", v.Version),
+		Context:  3,
+	})
+}
+
+// secretSpecKeys are the TOML keys of the secret fields of the job spec
+// structs, which are of type models.Secret.
+var secretSpecKeys = secretTOMLKeys(reflect.TypeOf(Job{}), map[string]struct{}{}, map[reflect.Type]bool{})
+
+func secretTOMLKeys(t reflect.Type, keys map[string]struct{}, seen map[reflect.Type]bool) map[string]struct{} {
+	seen[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("toml"), ",")
+		switch {
+		case name == "-":
+		case ft == reflect.TypeOf(models.Secret("")):
+			keys[name] = struct{}{}
+		case ft.Kind() == reflect.Struct && ft.PkgPath() == t.PkgPath() && !seen[ft]:
+			secretTOMLKeys(ft, keys, seen)
+		}
+	}
+	return keys
+}
+
+const redactedSpecValue = "'xxxxx'"
+
+// secretSpecField is the assignment of a secret field in the lines of a TOML
+// job spec, from line start up to line end, excluded.
+type secretSpecField struct {
+	start, end int
+	prefix     string
+	value      string
+}
+
+// findSecretSpecFields returns the assignments of the secret fields of the
+// lines of a TOML job spec. The fields of job specs are top-level keys, so
+// multi-line strings, like the observationSource, and tables are skipped.
+func findSecretSpecFields(lines []string) (fields []secretSpecField) {
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			break
+		}
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		start := i
+		for _, delim := range []string{`"""`, `'''`} {
+			if !strings.HasPrefix(value, delim) {
+				continue
+			}
+			// a multi-line string ends on the line of its closing delimiter
+			for !strings.Contains(value[len(delim):], delim) && i+1 < len(lines) {
+				i++
+				value += "\n" + strings.TrimRight(lines[i], "\r\n")
+			}
+			break
+		}
+		if _, ok := secretSpecKeys[strings.Trim(strings.TrimSpace(key), `"'`)]; ok {
+			eq := strings.Index(line, "=") + 1
+			prefix := line[:eq] + line[eq:len(line)-len(strings.TrimLeft(line[eq:], " \t"))]
+			fields = append(fields, secretSpecField{start: start, end: i + 1, prefix: prefix, value: value})
+		}
+	}
+	return fields
+}
+
+// RedactSpec returns the TOML spec of a job with the values of its secret
+// fields, like the webhook signatureKey, replaced.
+func RedactSpec(spec string) string {
+	lines := strings.SplitAfter(spec, "\n")
+	fields := findSecretSpecFields(lines)
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := fields[i]
+		redacted := f.prefix + redactedSpecValue
+		if strings.HasSuffix(lines[f.end-1], "\n") {
+			redacted += "\n"
+		}
+		lines = append(lines[:f.start], append([]string{redacted}, lines[f.end:]...)...)
+	}
+	return strings.Join(lines, "")
+}
+
+// HasRedactedSecrets returns whether a secret field of the TOML spec holds
+// the value set by RedactSpec.
+func HasRedactedSecrets(spec string) bool {
+	for _, f := range findSecretSpecFields(strings.SplitAfter(spec, "\n")) {
+		if f.value == redactedSpecValue {
+			return true
+		}
+	}
+	return false
+}
+
+// Redacted returns the version with the secrets of its spec redacted.
+func (v JobVersion) Redacted() JobVersion {
+	v.Spec = RedactSpec(v.Spec)
+	return v
+}
+
 type PipelineRun struct {
 	ID         int64 `json:"-"`
 	PruningKey int64 `json:"-"`
@@ -467,11 +627,58 @@ type ExternalInitiatorWebhookSpec struct {
 	Spec                models.JSON
 }
 
+// WebhookSignatureAlgorithm is the algorithm third parties sign the requests
+// triggering a webhook job with.
+type WebhookSignatureAlgorithm string
+
+const (
+	// WebhookSignatureNone disables signed requests.
+	WebhookSignatureNone WebhookSignatureAlgorithm = ""
+	// WebhookSignatureHMACSHA256 signs requests with a shared secret.
+	WebhookSignatureHMACSHA256 WebhookSignatureAlgorithm = "hmac-sha256"
+	// WebhookSignatureEd25519 signs requests with an Ed25519 private key, the
+	// job spec holds the public key.
+	WebhookSignatureEd25519 WebhookSignatureAlgorithm = "ed25519"
+)
+
+// ParseWebhookSignatureAlgorithm returns the WebhookSignatureAlgorithm.
+func ParseWebhookSignatureAlgorithm(val string) (WebhookSignatureAlgorithm, error) {
+	switch a := WebhookSignatureAlgorithm(val); a {
+	case WebhookSignatureNone, WebhookSignatureHMACSHA256, WebhookSignatureEd25519:
+		return a, nil
+	default:
+		return "", errors.Errorf("unknown signature algorithm %q, must be one of %s or %s", val,
+			WebhookSignatureHMACSHA256, WebhookSignatureEd25519)
+	}
+}
+
+// DefaultWebhookSignatureTolerance is the maximum age of a signed request
+// when the spec does not set one.
+const DefaultWebhookSignatureTolerance = 5 * time.Minute
+
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SignatureAlgorithm enables triggering the job with requests signed by
+	// SignatureKey, without a node credential.
+	SignatureAlgorithm WebhookSignatureAlgorithm `toml:"signatureAlgorithm"`
+	// SignatureKey is the shared secret for hmac-sha256, or the hex encoded
+	// public key for ed25519. It formats redacted and is redacted from
+	// exported specs.
+	SignatureKey models.Secret `json:"-" toml:"signatureKey"`
+	// SignatureTolerance is the maximum clock skew between the timestamp of a
+	// signed request and the node.
+	SignatureTolerance models.Interval `toml:"signatureTolerance"`
+	CreatedAt          time.Time       `json:"createdAt" toml:"-"`
+	UpdatedAt          time.Time       `json:"updatedAt" toml:"-"`
+}
+
+// Tolerance returns the signature tolerance, or the default one.
+func (w WebhookSpec) Tolerance() time.Duration {
+	if w.SignatureTolerance == 0 {
+		return DefaultWebhookSignatureTolerance
+	}
+	return w.SignatureTolerance.Duration()
 }
 
 func (w WebhookSpec) GetID() string {
@@ -498,12 +705,77 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// CronConcurrencyPolicy defines what a cron job does when it is scheduled
+// while its previous run has not finished yet.
+type CronConcurrencyPolicy string
+
+const (
+	// CronConcurrencyAllow starts the new run alongside the previous one.
+	CronConcurrencyAllow CronConcurrencyPolicy = "allow"
+	// CronConcurrencyForbid skips the new run.
+	CronConcurrencyForbid CronConcurrencyPolicy = "forbid"
+	// CronConcurrencyReplace cancels the previous run and starts the new one.
+	CronConcurrencyReplace CronConcurrencyPolicy = "replace"
+)
+
+// ParseCronConcurrencyPolicy returns the CronConcurrencyPolicy, an empty
+// string is the default allow policy.
+func ParseCronConcurrencyPolicy(val string) (CronConcurrencyPolicy, error) {
+	switch p := CronConcurrencyPolicy(val); p {
+	case "":
+		return CronConcurrencyAllow, nil
+	case CronConcurrencyAllow, CronConcurrencyForbid, CronConcurrencyReplace:
+		return p, nil
+	default:
+		return "", errors.Errorf("unknown concurrency policy %q, must be one of %s, %s or %s", val,
+			CronConcurrencyAllow, CronConcurrencyForbid, CronConcurrencyReplace)
+	}
+}
+
+// Value returns this instance serialized for database storage.
+func (p CronConcurrencyPolicy) Value() (driver.Value, error) {
+	if p == "" {
+		return string(CronConcurrencyAllow), nil
+	}
+	return string(p), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (p *CronConcurrencyPolicy) Scan(value interface{}) error {
+	temp, ok := value.(string)
+	if !ok {
+		return errors.Errorf("unable to convert %v of %T to CronConcurrencyPolicy", value, value)
+	}
+	*p = CronConcurrencyPolicy(temp)
+	return nil
+}
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID                int32                 `toml:"-"`
+	CronSchedule      string                `toml:"schedule"`
+	EVMChainID        *big.Big              `toml:"evmChainID"`
+	ConcurrencyPolicy CronConcurrencyPolicy `toml:"concurrencyPolicy"`
+	// CatchUp is the maximum number of schedules missed while the node was
+	// down to run on start, the most recent ones are run. Zero disables
+	// catching up.
+	CatchUp uint32 `toml:"catchUp"`
+	// Timezone is the IANA time zone of the schedule, it replaces a CRON_TZ
+	// prefix in the schedule.
+	Timezone string `toml:"timezone"`
+	// Jitter is the maximum random delay of each run.
+	Jitter models.Interval `toml:"jitter"`
+	// LastFiredAt is the scheduled time of the last run.
+	LastFiredAt null.Time `toml:"-"`
+	CreatedAt   time.Time `toml:"-"`
+	UpdatedAt   time.Time `toml:"-"`
+}
+
+// Schedule returns the cron schedule in the time zone of the spec.
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" {
+		return s.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", s.Timezone, s.CronSchedule)
 }
 
 func (s CronSpec) GetID() string {
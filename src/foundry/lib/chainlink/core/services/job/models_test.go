@@ -376,3 +376,27 @@ func TestWorkflowSpec_Validate(t *testing.T) {
 		assert.Equal(t, "wf-2", w.WorkflowName)
 	})
 }
+
+func TestRedactSpec(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		spec string
+		exp  string
+	}{
+		{"no secrets", "type = 'webhook'\nsignatureAlgorithm = 'hmac-sha256'\n", "type = 'webhook'\nsignatureAlgorithm = 'hmac-sha256'\n"},
+		{"basic string", "type = 'webhook'\nsignatureKey = \"s3cr3t\"\nname = 'x'\n", "type = 'webhook'\nsignatureKey = 'xxxxx'\nname = 'x'\n"},
+		{"literal string", "  signatureKey='s3cr3t' # key\n", "  signatureKey='xxxxx'\n"},
+		{"quoted key", "\"signatureKey\" = 's3cr3t'", "\"signatureKey\" = 'xxxxx'"},
+		{"multi-line string", "signatureKey = \"\"\"\ns3cr3t\n\"\"\"\nname = 'x'", "signatureKey = 'xxxxx'\nname = 'x'"},
+		{"multi-line literal string", "name = '''x'''\nsignatureKey = '''\ns3cr3t\n'''\n", "name = '''x'''\nsignatureKey = 'xxxxx'\n"},
+		// only the secret fields of job specs are redacted
+		{"cron pipeline", "type = 'cron'\nobservationSource = \"\"\"\nsignatureKey = 's3cr3t'\n\"\"\"\n", "type = 'cron'\nobservationSource = \"\"\"\nsignatureKey = 's3cr3t'\n\"\"\"\n"},
+		{"offchainreporting2 table", "type = 'offchainreporting2'\n[pluginConfig]\nsignatureKey = 's3cr3t'\n", "type = 'offchainreporting2'\n[pluginConfig]\nsignatureKey = 's3cr3t'\n"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, job.RedactSpec(tt.spec))
+			assert.False(t, job.HasRedactedSecrets(tt.spec))
+			assert.Equal(t, tt.exp != tt.spec, job.HasRedactedSecrets(job.RedactSpec(tt.spec)))
+		})
+	}
+}
@@ -39,6 +39,7 @@ var (
 	ErrNoSuchTransmitterKey = errors.New("no such transmitter key exists")
 	ErrNoSuchSendingKey     = errors.New("no such sending key exists")
 	ErrNoSuchPublicKey      = errors.New("no such public key exists")
+	ErrJobPaused            = errors.New("job is paused")
 )
 
 type ORM interface {
@@ -83,6 +84,15 @@ type ORM interface {
 	FindGatewayJobID(ctx context.Context, spec GatewaySpec) (int32, error)
 
 	FindJobIDByStreamID(ctx context.Context, streamID uint32) (int32, error)
+
+	InsertJobVersion(ctx context.Context, v *JobVersion) error
+	FindJobVersions(ctx context.Context, jobID int32) ([]JobVersion, error)
+	FindJobVersion(ctx context.Context, jobID int32, version int32) (JobVersion, error)
+	DetachPipelineSpecs(ctx context.Context, jobID int32) ([]int32, error)
+	AttachPipelineSpecs(ctx context.Context, jobID int32, ids []int32) error
+
+	PauseJob(ctx context.Context, id int32) error
+	ResumeJob(ctx context.Context, id int32) error
 }
 
 type ORMConfig interface {
@@ -577,8 +587,8 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, concurrency_policy, catch_up, timezone, jitter, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :concurrency_policy, :catch_up, :timezone, :jitter, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -709,8 +719,8 @@ func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Maste
 }
 
 func (o *orm) InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) error {
-	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (signature_algorithm, signature_key, signature_tolerance, created_at, updated_at)
+			VALUES (:signature_algorithm, :signature_key, :signature_tolerance, NOW(), NOW())
 			RETURNING *;`, webhookSpec)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -726,18 +736,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, max_concurrent_runs, max_runs_per_minute, run_limit_mode, retention_max_successful_runs, retention_keep_failed_for, retention_keep_tags, paused_at, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :max_concurrent_runs, :max_runs_per_minute, :run_limit_mode, :retention_max_successful_runs, :retention_keep_failed_for, :retention_keep_tags, :paused_at, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, max_concurrent_runs, max_runs_per_minute, run_limit_mode, retention_max_successful_runs, retention_keep_failed_for, retention_keep_tags, paused_at, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :max_concurrent_runs, :max_runs_per_minute, :run_limit_mode, :retention_max_successful_runs, :retention_keep_failed_for, :retention_keep_tags, :paused_at, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -812,9 +822,18 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 								%s
 							),`, q)
 	}
+	// The versions and runs of the job are deleted with its pipeline specs.
+	// Those of pipeline specs detached by DetachPipelineSpecs are kept, as the
+	// job is created again with the same ID when it is updated.
 	query += `
 		deleted_job_pipeline_specs AS (
 			DELETE FROM job_pipeline_specs WHERE job_id IN (SELECT id FROM deleted_jobs) RETURNING pipeline_spec_id
+		),
+		deleted_job_versions AS (
+			DELETE FROM job_versions WHERE job_id IN (SELECT id FROM deleted_jobs) AND pipeline_spec_id IN (SELECT pipeline_spec_id FROM deleted_job_pipeline_specs)
+		),
+		deleted_pipeline_runs AS (
+			DELETE FROM pipeline_runs WHERE pruning_key IN (SELECT id FROM deleted_jobs) AND pipeline_spec_id IN (SELECT pipeline_spec_id FROM deleted_job_pipeline_specs)
 		)
 		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_job_pipeline_specs)`
 	res, err := o.ds.ExecContext(ctx, query, id)
@@ -878,6 +897,100 @@ func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+// InsertJobVersion records v.Spec as the next version of the job, produced by
+// its current primary pipeline spec.
+func (o *orm) InsertJobVersion(ctx context.Context, v *JobVersion) error {
+	stmt := `INSERT INTO job_versions (job_id, version, spec, author, pipeline_spec_id, created_at)
+	SELECT $1, COALESCE((SELECT MAX(version) FROM job_versions WHERE job_id = $1), 0) + 1, $2, $3, pipeline_spec_id, NOW()
+	FROM job_pipeline_specs WHERE job_id = $1 AND is_primary
+	RETURNING *;`
+	err := o.ds.GetContext(ctx, v, stmt, v.JobID, v.Spec, v.Author)
+	return errors.Wrap(err, "InsertJobVersion failed")
+}
+
+// FindJobVersions returns the versions of a job, latest first.
+func (o *orm) FindJobVersions(ctx context.Context, jobID int32) (versions []JobVersion, err error) {
+	stmt := `SELECT * FROM job_versions WHERE job_id = $1 ORDER BY version DESC;`
+	err = o.ds.SelectContext(ctx, &versions, stmt, jobID)
+	return versions, errors.Wrap(err, "FindJobVersions failed")
+}
+
+func (o *orm) FindJobVersion(ctx context.Context, jobID int32, version int32) (v JobVersion, err error) {
+	stmt := `SELECT * FROM job_versions WHERE job_id = $1 AND version = $2;`
+	err = o.ds.GetContext(ctx, &v, stmt, jobID, version)
+	return v, errors.Wrap(err, "FindJobVersion failed")
+}
+
+// DetachPipelineSpecs unlinks all pipeline specs from the job, so that they
+// and their runs are not deleted with it. The job keeps a copy of its primary
+// pipeline spec, so it can still be loaded and deleted. It returns the IDs of
+// the detached pipeline specs.
+func (o *orm) DetachPipelineSpecs(ctx context.Context, jobID int32) (ids []int32, err error) {
+	err = o.transact(ctx, false, func(tx *orm) error {
+		var detached []PipelineSpec
+		stmt := `DELETE FROM job_pipeline_specs WHERE job_id = $1 RETURNING *;`
+		if err = tx.ds.SelectContext(ctx, &detached, stmt, jobID); err != nil {
+			return err
+		}
+		for _, spec := range detached {
+			ids = append(ids, spec.PipelineSpecID)
+			if !spec.IsPrimary {
+				continue
+			}
+			stmt = `WITH copied_pipeline_specs AS (
+				INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
+				SELECT dot_dag_source, max_task_duration, NOW() FROM pipeline_specs WHERE id = $2
+				RETURNING id
+			), copied_pipeline_spec_bridges AS (
+				INSERT INTO pipeline_spec_bridges (pipeline_spec_id, bridge_name)
+				SELECT copied_pipeline_specs.id, bridge_name FROM copied_pipeline_specs, pipeline_spec_bridges WHERE pipeline_spec_id = $2
+			)
+			INSERT INTO job_pipeline_specs (job_id, pipeline_spec_id, is_primary) SELECT $1, id, true FROM copied_pipeline_specs;`
+			if _, err = tx.ds.ExecContext(ctx, stmt, jobID, spec.PipelineSpecID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return ids, errors.Wrap(err, "DetachPipelineSpecs failed")
+}
+
+// AttachPipelineSpecs links detached pipeline specs back to the job, as
+// non-primary specs.
+func (o *orm) AttachPipelineSpecs(ctx context.Context, jobID int32, ids []int32) error {
+	stmt := `INSERT INTO job_pipeline_specs (job_id, pipeline_spec_id, is_primary) SELECT $1, unnest($2::int[]), false;`
+	_, err := o.ds.ExecContext(ctx, stmt, jobID, ids)
+	return errors.Wrap(err, "AttachPipelineSpecs failed")
+}
+
+// PauseJob marks the job as paused. It keeps the time it was first paused at
+// if it already is.
+func (o *orm) PauseJob(ctx context.Context, id int32) error {
+	stmt := `UPDATE jobs SET paused_at = COALESCE(paused_at, NOW()) WHERE id = $1;`
+	return o.updatePausedAt(ctx, stmt, id)
+}
+
+// ResumeJob clears the paused state of the job.
+func (o *orm) ResumeJob(ctx context.Context, id int32) error {
+	stmt := `UPDATE jobs SET paused_at = NULL WHERE id = $1;`
+	return o.updatePausedAt(ctx, stmt, id)
+}
+
+func (o *orm) updatePausedAt(ctx context.Context, stmt string, id int32) error {
+	res, err := o.ds.ExecContext(ctx, stmt, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to update paused state of job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, count int, err error) {
 	err = o.transact(ctx, false, func(tx *orm) error {
 		sql := `SELECT count(*) FROM jobs;`
@@ -888,7 +1001,7 @@ func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, coun
 
 		sql = `SELECT jobs.*, job_pipeline_specs.pipeline_spec_id as pipeline_spec_id
 			FROM jobs
-			    JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id)
+			    JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id AND job_pipeline_specs.is_primary)
 			ORDER BY jobs.created_at DESC, jobs.id DESC OFFSET $1 LIMIT $2;`
 		err = tx.ds.SelectContext(ctx, &jobs, sql, offset, limit)
 		if err != nil {
@@ -998,7 +1111,7 @@ func (o *orm) FindJob(ctx context.Context, id int32) (jb Job, err error) {
 // FindJobWithoutSpecErrors returns a job by ID, without loading SpecVal Errors preloaded
 func (o *orm) FindJobWithoutSpecErrors(ctx context.Context, id int32) (jb Job, err error) {
 	err = o.transact(ctx, true, func(tx *orm) error {
-		stmt := "SELECT jobs.*, job_pipeline_specs.pipeline_spec_id as pipeline_spec_id FROM jobs JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id) WHERE jobs.id = $1 LIMIT 1"
+		stmt := "SELECT jobs.*, job_pipeline_specs.pipeline_spec_id as pipeline_spec_id FROM jobs JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id) WHERE jobs.id = $1 AND job_pipeline_specs.is_primary LIMIT 1"
 		err = tx.ds.GetContext(ctx, &jb, stmt, id)
 		if err != nil {
 			return errors.Wrap(err, "failed to load job")
@@ -28,6 +28,23 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// CreateJobInTx creates a new job in the transaction ds, without
+		// starting its services. StartJob must be called once ds is committed.
+		CreateJobInTx(ctx context.Context, ds sqlutil.DataSource, jb *Job) error
+		// StartJob starts the services of a job created by CreateJobInTx,
+		// unless it is paused.
+		StartJob(ctx context.Context, jb Job) error
+		// DeleteJobInTx deletes a job in the transaction ds, without stopping
+		// its services. StopJob must be called once ds is committed.
+		DeleteJobInTx(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// StopJob stops the active services of a job deleted by DeleteJobInTx.
+		StopJob(jobID int32)
+		// PauseJob stops the services of a job and keeps them stopped, also
+		// across restarts, until the job is resumed. The job is kept with its
+		// runs.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob starts the services of a paused job.
+		ResumeJob(ctx context.Context, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -134,11 +151,19 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
-	jobIDs := make([]int32, len(jbs))
-	for i, jb := range jbs {
-		jobIDs[i] = jb.ID
+	var pausedJobIDs []int32
+	jobIDs := make([]int32, 0, len(jbs))
+	unpaused := jbs[:0]
+	for _, jb := range jbs {
+		if jb.Paused() {
+			pausedJobIDs = append(pausedJobIDs, jb.ID)
+			continue
+		}
+		jobIDs = append(jobIDs, jb.ID)
+		unpaused = append(unpaused, jb)
 	}
-	js.lggr.Debugw("Starting jobs...", "jobIDs", jobIDs)
+	jbs = unpaused
+	js.lggr.Debugw("Starting jobs...", "jobIDs", jobIDs, "pausedJobIDs", pausedJobIDs)
 	wg := sync.WaitGroup{}
 	wg.Add(len(jbs))
 	for _, jb := range jbs {
@@ -222,6 +247,13 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
+	if jb.MaxConcurrentRuns.Valid {
+		jb.PipelineSpec.MaxConcurrentRuns = &jb.MaxConcurrentRuns.Uint32
+	}
+	if jb.MaxRunsPerMinute.Valid {
+		jb.PipelineSpec.MaxRunsPerMinute = &jb.MaxRunsPerMinute.Uint32
+	}
+	jb.PipelineSpec.RunLimitMode = jb.RunLimitMode.ValueOrZero()
 
 	srvs, err := delegate.ServicesForSpec(ctx, jb)
 	if err != nil {
@@ -254,7 +286,15 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 }
 
 // Should not get called before Start()
-func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error) {
+func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) error {
+	if err := js.CreateJobInTx(ctx, ds, jb); err != nil {
+		return err
+	}
+	return js.StartJob(ctx, *jb)
+}
+
+// Should not get called before Start()
+func (js *spawner) CreateJobInTx(ctx context.Context, ds sqlutil.DataSource, jb *Job) error {
 	orm := js.orm
 	if ds != nil {
 		orm = orm.WithDataSource(ds)
@@ -262,32 +302,54 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		js.lggr.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
-		err = pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
-		return
+		return pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
 	}
 
-	err = orm.CreateJob(ctx, jb)
-	if err != nil {
+	if err := orm.CreateJob(ctx, jb); err != nil {
 		js.lggr.Errorw("Error creating job", "type", jb.Type, "err", err)
-		return
+		return err
 	}
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
 	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(ctx, *jb)
-	if err != nil {
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) StartJob(ctx context.Context, jb Job) (err error) {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+
+	if jb.Paused() {
+		js.lggr.Infow("Not starting services of paused job", "type", jb.Type, "jobID", jb.ID)
+	} else if err = js.StartService(ctx, jb); err != nil {
 		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
 	} else {
 		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
 	}
 
-	delegate.AfterJobCreated(*jb)
+	delegate.AfterJobCreated(jb)
 
 	return err
 }
 
 // Should not get called before Start()
 func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	err := js.DeleteJobInTx(ctx, ds, jobID)
+
+	// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
+	js.StopJob(jobID)
+	if err == nil {
+		js.lggr.Infow("Stopped and deleted job", "jobID", jobID)
+	}
+
+	return err
+}
+
+// Should not get called before Start()
+func (js *spawner) DeleteJobInTx(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	if ds == nil {
 		ds = js.orm.DataSource()
 	}
@@ -325,7 +387,7 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 
 	aj.delegate.BeforeJobDeleted(aj.spec)
 
-	err := sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
+	return sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
 		err := tx.DeleteJob(ctx, jobID, aj.spec.Type)
 		if err != nil {
 			js.lggr.Errorw("Error deleting job", "jobID", jobID, "err", err)
@@ -334,21 +396,53 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 		// This comes after calling orm.DeleteJob(), so that any non-db side effects inside it only get executed if
 		// we know the DELETE will succeed.  The DELETE will be finalized only if all db transactions in OnDeleteJob()
 		// succeed.  If either of those fails, the job will not be stopped and everything will be rolled back.
-		err = aj.delegate.OnDeleteJob(ctx, aj.spec)
-		if err != nil {
-			return err
-		}
-
-		return nil
+		return aj.delegate.OnDeleteJob(ctx, aj.spec)
 	})
+}
 
-	if exists {
-		// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
+// StopJob stops the active services of a job.
+func (js *spawner) StopJob(jobID int32) {
+	js.activeJobsMu.RLock()
+	_, active := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if active {
 		js.stopService(jobID)
 	}
-	lggr.Infow("Stopped and deleted job")
+}
 
-	return err
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	if err := js.orm.PauseJob(ctx, jobID); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause job %d", jobID)
+	}
+	// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
+	js.stopService(jobID)
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	if err := js.orm.ResumeJob(ctx, jobID); err != nil {
+		return pkgerrors.Wrapf(err, "failed to resume job %d", jobID)
+	}
+	js.activeJobsMu.RLock()
+	_, active := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if active {
+		return nil
+	}
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		return err
+	}
+	js.lggr.Infow("Resumed job", "type", jb.Type, "jobID", jb.ID)
+	return nil
 }
 
 func (js *spawner) ActiveJobs() map[int32]Job {
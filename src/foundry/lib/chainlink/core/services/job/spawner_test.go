@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -280,6 +281,53 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops job services on 'PauseJob()' until 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1}, 0, nil, d}
+		delegates := map[job.Type]job.Delegate{jobA.Type: delegateA}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, spawner.Start(ctx))
+		require.NoError(t, spawner.CreateJob(ctx, nil, jobA))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused())
+
+		// Paused jobs are not started on boot
+		restarted := job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+		require.NoError(t, restarted.Start(ctx))
+		assert.NotContains(t, restarted.ActiveJobs(), jobA.ID)
+		require.NoError(t, restarted.Close())
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.Paused())
+
+		require.ErrorIs(t, spawner.PauseJob(ctx, 0), sql.ErrNoRows)
+
+		serviceA1.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
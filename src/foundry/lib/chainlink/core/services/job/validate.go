@@ -5,12 +5,16 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 )
 
 var (
 	ErrNoPipelineSpec       = errors.New("pipeline spec not specified")
 	ErrInvalidJobType       = errors.New("invalid job type")
 	ErrInvalidSchemaVersion = errors.New("invalid schema version")
+	ErrInvalidRunLimitMode  = errors.Errorf("invalid runLimitMode, must be %q or %q", config.RunLimitModeQueue, config.RunLimitModeDrop)
+	ErrInvalidRunRetention  = errors.New("invalid run retention, retentionKeepFailedFor must be positive and retentionKeepTags non-empty")
 	jobTypes                = map[Type]struct{}{
 		BlockHeaderFeeder:       {},
 		BlockhashStore:          {},
@@ -67,6 +71,17 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if jb.RunLimitMode.Valid && jb.RunLimitMode.String != config.RunLimitModeQueue && jb.RunLimitMode.String != config.RunLimitModeDrop {
+		return "", ErrInvalidRunLimitMode
+	}
+	if jb.RetentionKeepFailedFor.Duration() < 0 {
+		return "", ErrInvalidRunRetention
+	}
+	for _, tag := range jb.RetentionKeepTags {
+		if tag == "" {
+			return "", ErrInvalidRunRetention
+		}
+	}
 	// spec.CustomRevertsPipelineEnabled == false, default is custom reverted txns pipeline disabled
 
 	if strings.Contains(ts, "<{}>") {
@@ -90,6 +90,36 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "invalid run limit mode",
+			spec: `
+type="webhook"
+schemaVersion=1
+maxConcurrentRuns=2
+runLimitMode="reject"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.True(t, errors.Is(errors.Cause(err), ErrInvalidRunLimitMode))
+			},
+		},
+		{
+			name: "invalid run retention",
+			spec: `
+type="webhook"
+schemaVersion=1
+retentionKeepFailedFor="720h"
+retentionKeepTags=["audit", ""]
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.True(t, errors.Is(errors.Cause(err), ErrInvalidRunRetention))
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
@@ -0,0 +1,145 @@
+// Package jobarchive defines the portable archive of the jobs of a node, with
+// the bridges, external initiators and forwarders they depend on, which is
+// exported from a node and imported into another.
+package jobarchive
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// Version is the version of the archive format.
+const Version = 1
+
+// VersionAuthor is the author of the job versions recorded for imported jobs.
+const VersionAuthor = "import"
+
+// ErrUnsupportedVersion is returned for archives of another format version.
+var ErrUnsupportedVersion = errors.Errorf("unsupported archive version, expected %d", Version)
+
+// Archive is the portable configuration of the jobs of a node.
+type Archive struct {
+	Version int   `json:"version"`
+	Jobs    []Job `json:"jobs"`
+	// Bridges are the bridges used by the jobs. Their tokens are not exported,
+	// importing a bridge generates new ones.
+	Bridges []bridges.BridgeTypeRequest `json:"bridges"`
+	// ExternalInitiators are the external initiators the webhook jobs are
+	// bound to. Their credentials are not exported, they must be created on
+	// the importing node before importing their jobs.
+	ExternalInitiators []ExternalInitiator `json:"externalInitiators"`
+	Forwarders         []Forwarder         `json:"forwarders"`
+	// Skipped are the jobs which were not exported.
+	Skipped []Skipped `json:"skipped,omitempty"`
+}
+
+// Job is the spec of a job, as it was last created or updated. The secrets of
+// the spec, like the webhook signatureKey, are redacted by the export and must
+// be set again before importing the job.
+type Job struct {
+	ExternalJobID uuid.UUID `json:"externalJobID"`
+	Name          string    `json:"name"`
+	Type          job.Type  `json:"type"`
+	Spec          string    `json:"spec"`
+}
+
+type ExternalInitiator struct {
+	Name string         `json:"name"`
+	URL  *models.WebURL `json:"url"`
+}
+
+type Forwarder struct {
+	Address    common.Address `json:"address"`
+	EVMChainID ubig.Big       `json:"evmChainID"`
+}
+
+// Resource kinds of the entries of a Report.
+const (
+	KindJob               = "job"
+	KindBridge            = "bridge"
+	KindExternalInitiator = "external_initiator"
+	KindForwarder         = "forwarder"
+)
+
+// Skipped is a resource which was not exported or imported, and why.
+type Skipped struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Imported is a resource created by an import.
+type Imported struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// Report is the outcome of an import.
+type Report struct {
+	Imported []Imported
+	Skipped  []Skipped
+}
+
+func (r *Report) Import(kind, name string) {
+	r.Imported = append(r.Imported, Imported{Kind: kind, Name: name})
+}
+
+func (r *Report) Skip(kind, name string, reason string) {
+	r.Skipped = append(r.Skipped, Skipped{Kind: kind, Name: name, Reason: reason})
+}
+
+// References are the dependencies of a job spec.
+type References struct {
+	Bridges            []bridges.BridgeName
+	ExternalInitiators []string
+}
+
+// ParseReferences returns the bridges and external initiators referenced by
+// spec. Bridges named by variables are resolved at run time and not included.
+func ParseReferences(spec string) (refs References, err error) {
+	var s struct {
+		ObservationSource  string `toml:"observationSource"`
+		ExternalInitiators []struct {
+			Name string `toml:"name"`
+		} `toml:"externalInitiators"`
+	}
+	if err = toml.Unmarshal([]byte(spec), &s); err != nil {
+		return refs, errors.Wrap(err, "failed to parse job spec")
+	}
+	for _, ei := range s.ExternalInitiators {
+		refs.ExternalInitiators = append(refs.ExternalInitiators, ei.Name)
+	}
+	if s.ObservationSource == "" {
+		return refs, nil
+	}
+
+	p, err := pipeline.Parse(s.ObservationSource)
+	if err != nil {
+		return refs, errors.Wrap(err, "failed to parse observationSource")
+	}
+	seen := make(map[bridges.BridgeName]struct{})
+	for _, task := range p.Tasks {
+		bt, ok := task.(*pipeline.BridgeTask)
+		if !ok {
+			continue
+		}
+		name, err := bridges.ParseBridgeName(bt.Name)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			refs.Bridges = append(refs.Bridges, name)
+		}
+	}
+	return refs, nil
+}
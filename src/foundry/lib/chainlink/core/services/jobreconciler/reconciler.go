@@ -0,0 +1,374 @@
+package jobreconciler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// VersionAuthor is the author of the job versions recorded by the reconciler.
+const VersionAuthor = "job-reconciler"
+
+// bundleSeparator separates the job specs of a bundle file.
+const bundleSeparator = "---"
+
+var (
+	// ErrJobManaged is the error of drift on jobs managed by the feeds
+	// manager, which the reconciler never changes.
+	ErrJobManaged = errors.New("job is managed by the feeds manager")
+	// ErrMissingExternalJobID is the error of specs which can't be reconciled
+	// because they don't set the externalJobID they are keyed by.
+	ErrMissingExternalJobID = errors.New("externalJobID is required to reconcile a job")
+	// ErrDeleteSkipped is the error of unlisted jobs which are not deleted
+	// because a spec failed to parse, or no spec was loaded, so the specs may
+	// not list every job they should.
+	ErrDeleteSkipped = errors.New("unlisted job not deleted, as the job specs are incomplete")
+)
+
+// Application applies the changes of the reconciler through the job spawner.
+type Application interface {
+	AddJobV2(ctx context.Context, j *job.Job) error
+	UpdateJobV2(ctx context.Context, j *job.Job, version *job.JobVersion) error
+	DeleteJob(ctx context.Context, jobID int32) error
+	JobORM() job.ORM
+	GetFeedsService() feeds.Service
+}
+
+// ValidateFunc parses a job spec and validates it with the validator of its
+// job type.
+type ValidateFunc func(ctx context.Context, spec string) (job.Job, error)
+
+// Action is the change reconciling a drift.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Drift is a difference between the jobs of the node and the job specs at
+// the reconciled path.
+type Drift struct {
+	Action        Action
+	ExternalJobID uuid.UUID
+	// JobID is the ID of the job, it is zero for jobs not created yet.
+	JobID int32
+	// Source is the file the spec was loaded from, it is empty for deletes.
+	Source string
+	// Diff is the unified diff from the latest version of the job to the
+	// spec of an update.
+	Diff string
+	// Err is the reason the drift was not reconciled.
+	Err error
+}
+
+type spec struct {
+	source string
+	toml   string
+}
+
+// Reconciler creates, updates and optionally deletes the jobs of the node
+// so that they match the job specs at a path, keyed by their externalJobID.
+type Reconciler struct {
+	services.StateMachine
+	cfg      config.JobReconciler
+	app      Application
+	validate ValidateFunc
+	lggr     logger.SugaredLogger
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*Reconciler)(nil)
+
+func New(cfg config.JobReconciler, app Application, validate ValidateFunc, lggr logger.Logger) *Reconciler {
+	return &Reconciler{
+		cfg:      cfg,
+		app:      app,
+		validate: validate,
+		lggr:     logger.Sugared(lggr.Named("JobReconciler")),
+		stopCh:   make(services.StopChan),
+	}
+}
+
+// Start reconciles the jobs, and again at every poll interval.
+func (r *Reconciler) Start(context.Context) error {
+	return r.StartOnce("JobReconciler", func() error {
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Reconciler) Close() error {
+	return r.StopOnce("JobReconciler", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *Reconciler) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Reconciler) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *Reconciler) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.PollInterval())
+	defer ticker.Stop()
+	for {
+		r.reconcileAndLog(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reconciler) reconcileAndLog(ctx context.Context) {
+	drifts, err := r.Reconcile(ctx)
+	if err != nil {
+		r.lggr.Errorw("Failed to reconcile jobs", "path", r.cfg.Path(), "err", err)
+		return
+	}
+	for _, d := range drifts {
+		lggr := r.lggr.With("action", d.Action, "externalJobID", d.ExternalJobID, "jobID", d.JobID, "source", d.Source)
+		switch {
+		case d.Err != nil:
+			lggr.Errorw("Job drift was not reconciled", "err", d.Err)
+		case r.cfg.DryRun():
+			lggr.Warnw("Job drift detected", "diff", d.Diff)
+		default:
+			lggr.Infow("Reconciled job")
+		}
+	}
+}
+
+// Reconcile compares the jobs of the node with the job specs at the path,
+// and applies the changes unless in dry-run mode. It returns the drift it
+// found, with the reason for any drift it did not reconcile.
+//
+// Jobs without a recorded version are updated once, so that the spec they
+// are compared with is known from then on. Unlisted jobs are not deleted when
+// a spec fails to parse or no spec is loaded.
+func (r *Reconciler) Reconcile(ctx context.Context) ([]Drift, error) {
+	specs, err := loadSpecs(r.cfg.Path())
+	if err != nil {
+		return nil, err
+	}
+	jobs, _, err := r.app.JobORM().FindJobs(ctx, 0, math.MaxUint32)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs")
+	}
+	existing := make(map[uuid.UUID]job.Job, len(jobs))
+	for _, jb := range jobs {
+		existing[jb.ExternalJobID] = jb
+	}
+
+	var drifts []Drift
+	incomplete := len(specs) == 0
+	listed := make(map[uuid.UUID]string, len(specs))
+	for _, s := range specs {
+		d := Drift{Action: ActionCreate, Source: s.source}
+		d.ExternalJobID, d.Err = parseExternalJobID(s.toml)
+		if d.Err != nil {
+			incomplete = true
+			drifts = append(drifts, d)
+			continue
+		}
+		if source, ok := listed[d.ExternalJobID]; ok {
+			d.Err = errors.Errorf("duplicate externalJobID, also set by %s", source)
+			drifts = append(drifts, d)
+			continue
+		}
+		listed[d.ExternalJobID] = s.source
+
+		if current, ok := existing[d.ExternalJobID]; ok {
+			d.Action = ActionUpdate
+			d.JobID = current.ID
+			var changed bool
+			d.Diff, changed, d.Err = r.diff(ctx, current.ID, s.toml)
+			if d.Err == nil && !changed {
+				continue
+			}
+		}
+		if d.Err == nil {
+			d.Err = r.reconcile(ctx, &d, s.toml)
+		}
+		drifts = append(drifts, d)
+	}
+
+	if !r.cfg.DeleteUnlisted() {
+		return drifts, nil
+	}
+	for _, jb := range jobs {
+		if _, ok := listed[jb.ExternalJobID]; ok {
+			continue
+		}
+		d := Drift{Action: ActionDelete, ExternalJobID: jb.ExternalJobID, JobID: jb.ID}
+		if incomplete {
+			d.Err = ErrDeleteSkipped
+		} else {
+			d.Err = r.reconcile(ctx, &d, "")
+		}
+		drifts = append(drifts, d)
+	}
+	return drifts, nil
+}
+
+// diff returns the diff from the latest version of the job to spec, and
+// whether they differ.
+func (r *Reconciler) diff(ctx context.Context, jobID int32, spec string) (string, bool, error) {
+	versions, err := r.app.JobORM().FindJobVersions(ctx, jobID)
+	if err != nil {
+		return "", false, err
+	}
+	if len(versions) == 0 {
+		return "", true, nil
+	}
+	latest := versions[0]
+	if strings.TrimSpace(latest.Spec) == strings.TrimSpace(spec) {
+		return "", false, nil
+	}
+	diff, err := job.JobVersion{Version: latest.Version + 1, Spec: spec}.Diff(latest)
+	return diff, true, err
+}
+
+// reconcile validates the spec of the drift and applies it, unless the job is
+// managed by the feeds manager or in dry-run mode.
+func (r *Reconciler) reconcile(ctx context.Context, d *Drift, spec string) error {
+	if d.JobID != 0 {
+		managed, err := r.app.GetFeedsService().IsJobManaged(ctx, int64(d.JobID))
+		if err != nil {
+			return err
+		}
+		if managed {
+			return ErrJobManaged
+		}
+	}
+
+	if d.Action == ActionDelete {
+		if r.cfg.DryRun() {
+			return nil
+		}
+		return r.app.DeleteJob(ctx, d.JobID)
+	}
+
+	jb, err := r.validate(ctx, spec)
+	if err != nil {
+		return errors.Wrap(err, "invalid job spec")
+	}
+	if r.cfg.DryRun() {
+		return nil
+	}
+
+	version := job.JobVersion{Spec: spec, Author: VersionAuthor}
+	if d.Action == ActionUpdate {
+		jb.ID = d.JobID
+		return r.app.UpdateJobV2(ctx, &jb, &version)
+	}
+	if err = r.app.AddJobV2(ctx, &jb); err != nil {
+		return err
+	}
+	d.JobID = jb.ID
+	version.JobID = jb.ID
+	return r.app.JobORM().InsertJobVersion(ctx, &version)
+}
+
+// loadSpecs loads the job specs of the *.toml files of a directory, or of a
+// bundle file whose specs are separated by lines of "---".
+func loadSpecs(path string) ([]spec, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load job specs")
+	}
+
+	if !info.IsDir() {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load job specs")
+		}
+		var specs []spec
+		for i, s := range splitBundle(string(b)) {
+			specs = append(specs, spec{source: fmt.Sprintf("%s#%d", path, i+1), toml: s})
+		}
+		return specs, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load job specs")
+	}
+	var specs []spec
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".toml" {
+			continue
+		}
+		source := filepath.Join(path, e.Name())
+		b, err := os.ReadFile(source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load job specs")
+		}
+		specs = append(specs, spec{source: source, toml: string(b)})
+	}
+	return specs, nil
+}
+
+func splitBundle(bundle string) []string {
+	var specs []string
+	var b strings.Builder
+	flush := func() {
+		if s := b.String(); strings.TrimSpace(s) != "" {
+			specs = append(specs, s)
+		}
+		b.Reset()
+	}
+	for _, line := range strings.SplitAfter(bundle, "\n") {
+		if strings.TrimSpace(line) == bundleSeparator {
+			flush()
+			continue
+		}
+		b.WriteString(line)
+	}
+	flush()
+	return specs
+}
+
+func parseExternalJobID(spec string) (uuid.UUID, error) {
+	tree, err := toml.Load(spec)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "failed to parse TOML")
+	}
+	s, ok := tree.Get("externalJobID").(string)
+	if !ok || s == "" {
+		return uuid.Nil, ErrMissingExternalJobID
+	}
+	id, err := uuid.Parse(s)
+	return id, errors.Wrap(err, "invalid externalJobID")
+}
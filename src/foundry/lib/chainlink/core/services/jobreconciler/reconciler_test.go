@@ -0,0 +1,234 @@
+package jobreconciler_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	feedsmocks "github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobreconciler"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+type reconcilerConfig struct {
+	path           string
+	deleteUnlisted bool
+	dryRun         bool
+}
+
+func (c *reconcilerConfig) Enabled() bool               { return true }
+func (c *reconcilerConfig) Path() string                { return c.path }
+func (c *reconcilerConfig) PollInterval() time.Duration { return time.Minute }
+func (c *reconcilerConfig) DeleteUnlisted() bool        { return c.deleteUnlisted }
+func (c *reconcilerConfig) DryRun() bool                { return c.dryRun }
+
+// managedApp reports the jobs of its feeds service as managed.
+type managedApp struct {
+	*cltest.TestApplication
+	feeds feeds.Service
+}
+
+func (a *managedApp) GetFeedsService() feeds.Service {
+	return a.feeds
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	validate := func(ctx context.Context, spec string) (job.Job, error) {
+		return webhook.ValidatedWebhookSpec(ctx, spec, app.GetExternalInitiatorManager())
+	}
+
+	listedID, otherID, unlistedID := uuid.New(), uuid.New(), uuid.New()
+	unlisted, err := validate(ctx, testspecs.GetWebhookSpecNoBody(unlistedID, fetchBridge.Name.String(), submitBridge.Name.String()))
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &unlisted))
+	cltest.MustInsertPipelineRunWithStatus(t, app.GetDB(), unlisted.PipelineSpecID, pipeline.RunStatusCompleted, unlisted.ID)
+
+	dir := t.TempDir()
+	writeSpec := func(name, spec string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(spec), 0600))
+	}
+	listedSpec := testspecs.GetWebhookSpecNoBody(listedID, fetchBridge.Name.String(), submitBridge.Name.String())
+	writeSpec("listed.toml", listedSpec)
+	writeSpec("other.toml", testspecs.GetWebhookSpecNoBody(otherID, fetchBridge.Name.String(), submitBridge.Name.String()))
+	writeSpec("README.md", "not a job spec")
+
+	cfg := &reconcilerConfig{path: dir, deleteUnlisted: true, dryRun: true}
+	reconciler := jobreconciler.New(cfg, app, validate, logger.TestLogger(t))
+
+	actions := func(drifts []jobreconciler.Drift) map[uuid.UUID]jobreconciler.Action {
+		m := make(map[uuid.UUID]jobreconciler.Action)
+		for _, d := range drifts {
+			require.NoError(t, d.Err)
+			m[d.ExternalJobID] = d.Action
+		}
+		return m
+	}
+
+	t.Run("reports drift without changing jobs in dry-run mode", func(t *testing.T) {
+		drifts, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]jobreconciler.Action{
+			listedID:   jobreconciler.ActionCreate,
+			otherID:    jobreconciler.ActionCreate,
+			unlistedID: jobreconciler.ActionDelete,
+		}, actions(drifts))
+
+		_, count, err := app.JobORM().FindJobs(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("creates missing jobs and deletes unlisted ones", func(t *testing.T) {
+		cfg.dryRun = false
+		drifts, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Len(t, actions(drifts), 3)
+
+		_, err = app.JobORM().FindJobByExternalJobID(ctx, unlistedID)
+		require.Error(t, err)
+		var runs int
+		require.NoError(t, app.GetDB().GetContext(ctx, &runs, `SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1`, unlisted.ID))
+		assert.Zero(t, runs)
+		jb, err := app.JobORM().FindJobByExternalJobID(ctx, listedID)
+		require.NoError(t, err)
+		versions, err := app.JobORM().FindJobVersions(ctx, jb.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, listedSpec, versions[0].Spec)
+		assert.Equal(t, jobreconciler.VersionAuthor, versions[0].Author)
+
+		drifts, err = reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, drifts)
+	})
+
+	t.Run("updates changed jobs", func(t *testing.T) {
+		before, err := app.JobORM().FindJobByExternalJobID(ctx, listedID)
+		require.NoError(t, err)
+		writeSpec("listed.toml", testspecs.GetWebhookSpecNoBody(listedID, submitBridge.Name.String(), fetchBridge.Name.String()))
+
+		drifts, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Len(t, drifts, 1)
+		require.NoError(t, drifts[0].Err)
+		assert.Equal(t, jobreconciler.ActionUpdate, drifts[0].Action)
+		assert.Equal(t, before.ID, drifts[0].JobID)
+		assert.Contains(t, drifts[0].Diff, `+    fetch          [type=bridge name="`+submitBridge.Name.String()+`"]`)
+
+		after, err := app.JobORM().FindJobByExternalJobID(ctx, listedID)
+		require.NoError(t, err)
+		assert.Equal(t, before.ID, after.ID)
+		assert.Contains(t, after.PipelineSpec.DotDagSource, `fetch          [type=bridge name="`+submitBridge.Name.String()+`"]`)
+	})
+
+	t.Run("does not touch jobs managed by the feeds manager", func(t *testing.T) {
+		jb, err := app.JobORM().FindJobByExternalJobID(ctx, listedID)
+		require.NoError(t, err)
+		feedsService := feedsmocks.NewService(t)
+		feedsService.On("IsJobManaged", mock.Anything, int64(jb.ID)).Return(true, nil)
+
+		writeSpec("listed.toml", listedSpec)
+		managed := jobreconciler.New(cfg, &managedApp{app, feedsService}, validate, logger.TestLogger(t))
+		drifts, err := managed.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Len(t, drifts, 1)
+		require.ErrorIs(t, drifts[0].Err, jobreconciler.ErrJobManaged)
+
+		versions, err := app.JobORM().FindJobVersions(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+	})
+
+	t.Run("reports invalid and duplicate specs", func(t *testing.T) {
+		writeSpec("listed.toml", listedSpec)
+		writeSpec("missing.toml", strings.Replace(listedSpec, `externalJobID   = "`+listedID.String()+`"`, "", 1))
+		writeSpec("other.toml", testspecs.GetWebhookSpecNoBody(listedID, fetchBridge.Name.String(), submitBridge.Name.String()))
+		t.Cleanup(func() {
+			require.NoError(t, os.Remove(filepath.Join(dir, "missing.toml")))
+		})
+
+		cfg.dryRun = true
+		t.Cleanup(func() { cfg.dryRun = false })
+		drifts, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+
+		errs := make(map[string]error)
+		for _, d := range drifts {
+			errs[filepath.Base(d.Source)] = d.Err
+		}
+		require.ErrorIs(t, errs["missing.toml"], jobreconciler.ErrMissingExternalJobID)
+		require.ErrorContains(t, errs["other.toml"], "duplicate externalJobID")
+	})
+
+	t.Run("does not delete unlisted jobs when a spec fails to parse", func(t *testing.T) {
+		broken := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(broken, "other.toml"), []byte(`externalJobID = "`+otherID.String()), 0600))
+
+		incomplete := jobreconciler.New(&reconcilerConfig{path: broken, deleteUnlisted: true}, app, validate, logger.TestLogger(t))
+		drifts, err := incomplete.Reconcile(ctx)
+		require.NoError(t, err)
+
+		errs := make(map[uuid.UUID]error)
+		for _, d := range drifts {
+			if d.Action == jobreconciler.ActionDelete {
+				errs[d.ExternalJobID] = d.Err
+			}
+		}
+		require.ErrorIs(t, errs[otherID], jobreconciler.ErrDeleteSkipped)
+		_, err = app.JobORM().FindJobByExternalJobID(ctx, otherID)
+		require.NoError(t, err)
+	})
+
+	t.Run("does not delete jobs when no spec is loaded", func(t *testing.T) {
+		empty := jobreconciler.New(&reconcilerConfig{path: t.TempDir(), deleteUnlisted: true}, app, validate, logger.TestLogger(t))
+		drifts, err := empty.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Len(t, drifts, 2)
+		for _, d := range drifts {
+			assert.Equal(t, jobreconciler.ActionDelete, d.Action)
+			require.ErrorIs(t, d.Err, jobreconciler.ErrDeleteSkipped)
+		}
+
+		_, count, err := app.JobORM().FindJobs(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("loads the specs of a bundle file", func(t *testing.T) {
+		bundle := filepath.Join(t.TempDir(), "jobs.toml")
+		require.NoError(t, os.WriteFile(bundle, []byte(strings.Join([]string{
+			testspecs.GetWebhookSpecNoBody(otherID, fetchBridge.Name.String(), submitBridge.Name.String()),
+			testspecs.GetWebhookSpecNoBody(unlistedID, fetchBridge.Name.String(), submitBridge.Name.String()),
+		}, "\n---\n")), 0600))
+
+		bundled := jobreconciler.New(&reconcilerConfig{path: bundle, dryRun: true}, app, validate, logger.TestLogger(t))
+		drifts, err := bundled.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Len(t, drifts, 1)
+		require.NoError(t, drifts[0].Err)
+		assert.Equal(t, jobreconciler.ActionCreate, drifts[0].Action)
+		assert.Equal(t, unlistedID, drifts[0].ExternalJobID)
+		assert.Equal(t, bundle+"#2", drifts[0].Source)
+	})
+}
@@ -9,15 +9,21 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	evmkeystore "github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+// ErrNoRemoteSigner is returned for keys of a remote signer backend which is
+// not registered, see RemoteSigners in the node config.
+var ErrNoRemoteSigner = errors.New("no remote signer registered")
+
 // Eth is the external interface for EthKeyStore
 type Eth interface {
 	Get(ctx context.Context, id string) (ethkey.KeyV2, error)
@@ -27,6 +33,16 @@ type Eth interface {
 	Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(ctx context.Context, id string, password string) ([]byte, error)
 
+	// RegisterRemoteSigner makes the remote signer available under the backend name,
+	// for AddRemote and for signing with the keys it holds.
+	RegisterRemoteSigner(backend string, signer remotesigner.Signer)
+	// AddRemote adds a reference to the key with keyID held by the remote signer
+	// backend, and enables it for the given chain IDs.
+	AddRemote(ctx context.Context, backend string, keyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+
+	SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error)
+	SignTx(ctx context.Context, fromAddress common.Address, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error)
+
 	Enable(ctx context.Context, address common.Address, chainID *big.Int) error
 	Disable(ctx context.Context, address common.Address, chainID *big.Int) error
 	Add(ctx context.Context, address common.Address, chainID *big.Int) error
@@ -78,13 +94,14 @@ func (e *EthSigner) Sign(ctx context.Context, account string, data []byte) (sign
 	if data == nil {
 		return nil, nil
 	}
-	return k.Sign(data)
+	return e.SignHash(ctx, k.Address, data)
 }
 
 type eth struct {
 	*keyManager
 	keystateORM
 	ds            sqlutil.DataSource
+	remoteSigners map[string]remotesigner.Signer
 	resourceMutex map[common.Address]*evmkeystore.Mutex // ResourceMutex is an internal field and ought not be persisted to the database. Its main usage is to verify that the same key is not used for both TXMv1 and TXMv2 (usage in both TXMs will cause nonce drift and will lead to missing transactions). This functionality should be removed after we completely switch to TXMv2
 }
 
@@ -108,9 +125,10 @@ var _ Eth = &eth{}
 
 func newEthKeyStore(km *keyManager, orm keystateORM, ds sqlutil.DataSource) *eth {
 	return &eth{
-		keystateORM: orm,
-		keyManager:  km,
-		ds:          ds,
+		keystateORM:   orm,
+		keyManager:    km,
+		ds:            ds,
+		remoteSigners: make(map[string]remotesigner.Signer),
 	}
 }
 
@@ -223,6 +241,81 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
+func (ks *eth) RegisterRemoteSigner(backend string, signer remotesigner.Signer) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	ks.remoteSigners[backend] = signer
+}
+
+func (ks *eth) AddRemote(ctx context.Context, backend string, keyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	ks.lock.RLock()
+	signer, found := ks.remoteSigners[backend]
+	ks.lock.RUnlock()
+	if !found {
+		return ethkey.KeyV2{}, fmt.Errorf("%w with name %s", ErrNoRemoteSigner, backend)
+	}
+	// the signer is only reachable over the network, so do not hold the lock while resolving the address
+	address, err := remotesigner.Address(ctx, signer, keyID)
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	key, err := ethkey.NewRemoteV2(ethkey.RemoteKeyRef{Backend: backend, KeyID: keyID, Address: address})
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, ErrKeyExists
+	}
+	err = ks.add(ctx, key, chainIDs...)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	ks.logger.Infow("Added remote EVM key with ID "+key.Address.Hex(), "address", key.Address.Hex(), "backend", backend, "keyID", keyID, "evmChainIDs", chainIDs)
+	return key, nil
+}
+
+// SignHash signs the hash with the key of address, delegating to its remote signer for remote keys.
+func (ks *eth) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	ks.lock.RLock()
+	if ks.isLocked() {
+		ks.lock.RUnlock()
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.Hex())
+	if err != nil {
+		ks.lock.RUnlock()
+		return nil, err
+	}
+	ref, remote := key.Remote()
+	if !remote {
+		ks.lock.RUnlock()
+		return key.Sign(hash)
+	}
+	signer, found := ks.remoteSigners[ref.Backend]
+	ks.lock.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("%w with name %s for key %s", ErrNoRemoteSigner, ref.Backend, address.Hex())
+	}
+	return remotesigner.SignEthereum(ctx, signer, ref.KeyID, ref.Address, hash)
+}
+
+// SignTx signs the transaction for chainID with the key of fromAddress.
+func (ks *eth) SignTx(ctx context.Context, fromAddress common.Address, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	signer := gethtypes.LatestSignerForChainID(chainID)
+	h := signer.Hash(tx)
+	sig, err := ks.SignHash(ctx, fromAddress, h[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+	return tx.WithSignature(signer, sig)
+}
+
 func (ks *eth) Add(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 )
 
 func Test_EthKeyStore(t *testing.T) {
@@ -751,3 +754,84 @@ func Test_EthKeyStore_Disable(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ks := keyStore.Eth()
+
+	signer := remotesigner.NewSoftware()
+	keyID, err := signer.CreateKey()
+	require.NoError(t, err)
+	expectedAddress, err := remotesigner.Address(ctx, signer, keyID)
+	require.NoError(t, err)
+
+	_, err = ks.AddRemote(ctx, "kms", keyID, testutils.FixtureChainID)
+	require.ErrorIs(t, err, keystore.ErrNoRemoteSigner)
+	require.ErrorContains(t, err, "no remote signer registered with name kms")
+
+	ks.RegisterRemoteSigner("kms", signer)
+	_, err = ks.AddRemote(ctx, "kms", "unknown", testutils.FixtureChainID)
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+
+	key, err := ks.AddRemote(ctx, "kms", keyID, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress, key.Address)
+	ref, ok := key.Remote()
+	require.True(t, ok)
+	assert.Equal(t, ethkey.RemoteKeyRef{Backend: "kms", KeyID: keyID, Address: expectedAddress}, ref)
+	_, err = ks.AddRemote(ctx, "kms", keyID, testutils.FixtureChainID)
+	require.ErrorIs(t, err, keystore.ErrKeyExists)
+
+	enabled, err := ks.EnabledAddressesForChain(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{expectedAddress}, enabled)
+
+	_, err = ks.Export(ctx, key.ID(), cltest.Password)
+	require.ErrorIs(t, err, ethkey.ErrRemoteKey)
+
+	// only the reference is persisted, and is restored on unlock
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, err = ks.Get(ctx, expectedAddress.Hex())
+	require.NoError(t, err)
+	_, ok = key.Remote()
+	require.True(t, ok)
+
+	to := testutils.NewAddress()
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		ChainID:   testutils.FixtureChainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(3),
+	})
+	signedTx, err := ks.SignTx(ctx, expectedAddress, tx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(testutils.FixtureChainID), signedTx)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress, sender)
+
+	sig, err := keystore.NewEthSigner(ks, testutils.FixtureChainID).Sign(ctx, expectedAddress.Hex(), crypto.Keccak256([]byte("data")))
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(crypto.Keccak256([]byte("data")), sig)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress, crypto.PubkeyToAddress(*pub))
+
+	// the keyring keeps the reference when the remote key is destroyed
+	signer.DeleteKey(keyID)
+	_, err = ks.SignTx(ctx, expectedAddress, tx, testutils.FixtureChainID)
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+
+	_, err = ks.Delete(ctx, expectedAddress.Hex())
+	require.NoError(t, err)
+	_, err = ks.SignTx(ctx, expectedAddress, tx, testutils.FixtureChainID)
+	require.ErrorIs(t, err, keystore.ErrKeyNotFound)
+}
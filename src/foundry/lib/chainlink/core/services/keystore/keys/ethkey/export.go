@@ -9,6 +9,9 @@ import (
 )
 
 func (key KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if key.remote != nil {
+		return nil, ErrRemoteKey
+	}
 	// DEV: uuid is derived directly from the address, since it is not stored internally
 	id, err := uuid.FromBytes(key.Address.Bytes()[:16])
 	if err != nil {
@@ -33,6 +33,7 @@ func KeyFor(raw internal.Raw) KeyV2 {
 type KeyV2 struct {
 	raw          internal.Raw
 	getPK        func() *ecdsa.PrivateKey
+	remote       *RemoteKeyRef
 	Address      common.Address
 	EIP55Address types.EIP55Address
 }
@@ -67,7 +68,12 @@ func (key KeyV2) ID() string {
 
 func (key KeyV2) Raw() internal.Raw { return key.raw }
 
-func (key KeyV2) Sign(data []byte) ([]byte, error) { return crypto.Sign(data, key.getPK()) }
+func (key KeyV2) Sign(data []byte) ([]byte, error) {
+	if key.remote != nil {
+		return nil, ErrRemoteKey
+	}
+	return crypto.Sign(data, key.getPK())
+}
 
 // Cmp uses byte-order address comparison to give a stable comparison between two keys
 func (key KeyV2) Cmp(key2 KeyV2) int {
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestEthKeyV2_ToKey(t *testing.T) {
@@ -34,3 +36,33 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.getPK())
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_Remote(t *testing.T) {
+	ref := RemoteKeyRef{Backend: "kms", KeyID: "key-1", Address: common.HexToAddress("0x1234567890123456789012345678901234567890")}
+	key, err := NewRemoteV2(ref)
+	require.NoError(t, err)
+	assert.Equal(t, ref.Address, key.Address)
+	assert.Equal(t, ref.Address.Hex(), key.ID())
+	gotRef, ok := key.Remote()
+	require.True(t, ok)
+	assert.Equal(t, ref, gotRef)
+
+	_, err = key.Sign(crypto.Keccak256([]byte("data")))
+	require.ErrorIs(t, err, ErrRemoteKey)
+	_, err = key.ToEncryptedJSON("password", utils.FastScryptParams)
+	require.ErrorIs(t, err, ErrRemoteKey)
+
+	decoded, err := RemoteKeyFor(key.Raw())
+	require.NoError(t, err)
+	gotRef, ok = decoded.Remote()
+	require.True(t, ok)
+	assert.Equal(t, ref, gotRef)
+
+	_, err = NewRemoteV2(RemoteKeyRef{Address: ref.Address})
+	require.Error(t, err)
+
+	local, err := NewV2()
+	require.NoError(t, err)
+	_, ok = local.Remote()
+	assert.False(t, ok)
+}
@@ -0,0 +1,61 @@
+package ethkey
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+)
+
+// ErrRemoteKey is returned when using the private key of a key held by a remote signer.
+var ErrRemoteKey = errors.New("key is held by a remote signer and cannot be used or exported locally")
+
+// RemoteKeyRef references a key held by a remote signer. It is stored in the
+// keyring in place of the private key.
+type RemoteKeyRef struct {
+	// Backend is the name of the remote signer holding the key.
+	Backend string
+	// KeyID identifies the key within the backend.
+	KeyID   string
+	Address common.Address
+}
+
+// NewRemoteV2 returns a key signing with the remote signer key referenced by ref.
+func NewRemoteV2(ref RemoteKeyRef) (KeyV2, error) {
+	if ref.Backend == "" || ref.KeyID == "" {
+		return KeyV2{}, errors.New("remote key reference requires a backend and a key ID")
+	}
+	raw, err := json.Marshal(ref)
+	if err != nil {
+		return KeyV2{}, err
+	}
+	return KeyV2{
+		raw:          internal.NewRaw(raw),
+		remote:       &ref,
+		Address:      ref.Address,
+		EIP55Address: types.EIP55AddressFromAddress(ref.Address),
+	}, nil
+}
+
+// RemoteKeyFor returns the remote key of the reference serialized in raw.
+func RemoteKeyFor(raw internal.Raw) (KeyV2, error) {
+	var ref RemoteKeyRef
+	if err := json.Unmarshal(internal.Bytes(raw), &ref); err != nil {
+		return KeyV2{}, fmt.Errorf("failed to decode remote key reference: %w", err)
+	}
+	return NewRemoteV2(ref)
+}
+
+// Remote returns the reference of a key held by a remote signer, and false
+// for keys held by the keyring.
+func (key KeyV2) Remote() (RemoteKeyRef, bool) {
+	if key.remote == nil {
+		return RemoteKeyRef{}, false
+	}
+	return *key.remote, true
+}
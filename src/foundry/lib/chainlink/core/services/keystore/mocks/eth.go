@@ -8,11 +8,15 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	remotesigner "github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
 	keys "github.com/smartcontractkit/chainlink-evm/pkg/keys"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/ethereum/go-ethereum/core/types"
 )
 
 // Eth is an autogenerated mock type for the Eth type
@@ -76,6 +80,79 @@ func (_c *Eth_Add_Call) RunAndReturn(run func(context.Context, common.Address, *
 	return _c
 }
 
+// AddRemote provides a mock function with given fields: ctx, backend, keyID, chainIDs
+func (_m *Eth) AddRemote(ctx context.Context, backend string, keyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, backend, keyID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemote")
+	}
+
+	var r0 ethkey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...*big.Int) (ethkey.KeyV2, error)); ok {
+		return rf(ctx, backend, keyID, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(ctx, backend, keyID, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...*big.Int) error); ok {
+		r1 = rf(ctx, backend, keyID, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_AddRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemote'
+type Eth_AddRemote_Call struct {
+	*mock.Call
+}
+
+// AddRemote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backend string
+//   - keyID string
+//   - chainIDs ...*big.Int
+func (_e *Eth_Expecter) AddRemote(ctx interface{}, backend interface{}, keyID interface{}, chainIDs ...interface{}) *Eth_AddRemote_Call {
+	return &Eth_AddRemote_Call{Call: _e.mock.On("AddRemote",
+		append([]interface{}{ctx, backend, keyID}, chainIDs...)...)}
+}
+
+func (_c *Eth_AddRemote_Call) Run(run func(ctx context.Context, backend string, keyID string, chainIDs ...*big.Int)) *Eth_AddRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*big.Int, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*big.Int)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Eth_AddRemote_Call) Return(_a0 ethkey.KeyV2, _a1 error) *Eth_AddRemote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_AddRemote_Call) RunAndReturn(run func(context.Context, string, string, ...*big.Int) (ethkey.KeyV2, error)) *Eth_AddRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckEnabled provides a mock function with given fields: ctx, address, chainID
 func (_m *Eth) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ret := _m.Called(ctx, address, chainID)
@@ -1072,6 +1149,161 @@ func (_c *Eth_Import_Call) RunAndReturn(run func(context.Context, []byte, string
 	return _c
 }
 
+// RegisterRemoteSigner provides a mock function with given fields: backend, signer
+func (_m *Eth) RegisterRemoteSigner(backend string, signer remotesigner.Signer) {
+	_m.Called(backend, signer)
+}
+
+// Eth_RegisterRemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterRemoteSigner'
+type Eth_RegisterRemoteSigner_Call struct {
+	*mock.Call
+}
+
+// RegisterRemoteSigner is a helper method to define mock.On call
+//   - backend string
+//   - signer remotesigner.Signer
+func (_e *Eth_Expecter) RegisterRemoteSigner(backend interface{}, signer interface{}) *Eth_RegisterRemoteSigner_Call {
+	return &Eth_RegisterRemoteSigner_Call{Call: _e.mock.On("RegisterRemoteSigner", backend, signer)}
+}
+
+func (_c *Eth_RegisterRemoteSigner_Call) Run(run func(backend string, signer remotesigner.Signer)) *Eth_RegisterRemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(remotesigner.Signer))
+	})
+	return _c
+}
+
+func (_c *Eth_RegisterRemoteSigner_Call) Return() *Eth_RegisterRemoteSigner_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Eth_RegisterRemoteSigner_Call) RunAndReturn(run func(string, remotesigner.Signer)) *Eth_RegisterRemoteSigner_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SignHash provides a mock function with given fields: ctx, address, hash
+func (_m *Eth) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	ret := _m.Called(ctx, address, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignHash")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) ([]byte, error)); ok {
+		return rf(ctx, address, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) []byte); ok {
+		r0 = rf(ctx, address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignHash'
+type Eth_SignHash_Call struct {
+	*mock.Call
+}
+
+// SignHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - hash []byte
+func (_e *Eth_Expecter) SignHash(ctx interface{}, address interface{}, hash interface{}) *Eth_SignHash_Call {
+	return &Eth_SignHash_Call{Call: _e.mock.On("SignHash", ctx, address, hash)}
+}
+
+func (_c *Eth_SignHash_Call) Run(run func(ctx context.Context, address common.Address, hash []byte)) *Eth_SignHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *Eth_SignHash_Call) Return(_a0 []byte, _a1 error) *Eth_SignHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignHash_Call) RunAndReturn(run func(context.Context, common.Address, []byte) ([]byte, error)) *Eth_SignHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
+func (_m *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ret := _m.Called(ctx, fromAddress, tx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignTx")
+	}
+
+	var r0 *types.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *types.Transaction, *big.Int) (*types.Transaction, error)); ok {
+		return rf(ctx, fromAddress, tx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *types.Transaction, *big.Int) *types.Transaction); ok {
+		r0 = rf(ctx, fromAddress, tx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *types.Transaction, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, tx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignTx'
+type Eth_SignTx_Call struct {
+	*mock.Call
+}
+
+// SignTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - tx *types.Transaction
+//   - chainID *big.Int
+func (_e *Eth_Expecter) SignTx(ctx interface{}, fromAddress interface{}, tx interface{}, chainID interface{}) *Eth_SignTx_Call {
+	return &Eth_SignTx_Call{Call: _e.mock.On("SignTx", ctx, fromAddress, tx, chainID)}
+}
+
+func (_c *Eth_SignTx_Call) Run(run func(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int)) *Eth_SignTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*types.Transaction), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Eth_SignTx_Call) Return(_a0 *types.Transaction, _a1 error) *Eth_SignTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignTx_Call) RunAndReturn(run func(context.Context, common.Address, *types.Transaction, *big.Int) (*types.Transaction, error)) *Eth_SignTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // XXXTestingOnlyAdd provides a mock function with given fields: ctx, key
 func (_m *Eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 	_m.Called(ctx, key)
@@ -217,6 +217,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, internal.RawBytes(csaKey))
 	}
 	for _, ethKey := range kr.Eth {
+		if _, ok := ethKey.Remote(); ok {
+			rawKeys.RemoteEth = append(rawKeys.RemoteEth, internal.RawBytes(ethKey))
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, internal.RawBytes(ethKey))
 	}
 	for _, ocrKey := range kr.OCR {
@@ -350,6 +354,7 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // (like public keys) to the database
 type rawKeyRing struct {
 	Eth        [][]byte
+	RemoteEth  [][]byte
 	CSA        [][]byte
 	OCR        [][]byte
 	OCR2       [][]byte
@@ -374,6 +379,13 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		ethKey := ethkey.KeyFor(internal.NewRaw(rawETHKey))
 		keyRing.Eth[ethKey.ID()] = ethKey
 	}
+	for _, rawRemoteETHKey := range rawKeys.RemoteEth {
+		ethKey, err := ethkey.RemoteKeyFor(internal.NewRaw(rawRemoteETHKey))
+		if err != nil {
+			return nil, err
+		}
+		keyRing.Eth[ethKey.ID()] = ethKey
+	}
 	for _, rawOCRKey := range rawKeys.OCR {
 		ocrKey := ocrkey.KeyFor(internal.NewRaw(rawOCRKey))
 		keyRing.OCR[ocrKey.ID()] = ocrKey
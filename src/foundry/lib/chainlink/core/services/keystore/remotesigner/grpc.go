@@ -0,0 +1,131 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pb"
+)
+
+const (
+	grpcServiceName  = "remotesigner.RemoteSigner"
+	grpcGetPublicKey = "/" + grpcServiceName + "/GetPublicKey"
+	grpcSign         = "/" + grpcServiceName + "/Sign"
+)
+
+var _ Signer = &GRPC{}
+
+// GRPC is a Signer calling an external signer, e.g. a KMS or a gateway to a
+// PKCS#11 token, over the RemoteSigner gRPC service of pb/remotesigner.proto.
+type GRPC struct {
+	conn *grpc.ClientConn
+}
+
+// NewGRPC returns a Signer calling the RemoteSigner service at target. The
+// connection is made on the first call, so the signer does not need to be
+// reachable when the node starts.
+func NewGRPC(target string, opts ...grpc.DialOption) (*GRPC, error) {
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client for %s: %w", target, err)
+	}
+	return &GRPC{conn: conn}, nil
+}
+
+// Close closes the connection to the signer.
+func (g *GRPC) Close() error {
+	return g.conn.Close()
+}
+
+func (g *GRPC) PublicKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	var resp pb.GetPublicKeyResponse
+	if err := g.conn.Invoke(ctx, grpcGetPublicKey, &pb.GetPublicKeyRequest{KeyId: keyID}, &resp); err != nil {
+		return nil, fromStatus(err)
+	}
+	pub, err := crypto.UnmarshalPubkey(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return pub, nil
+}
+
+func (g *GRPC) SignDigest(ctx context.Context, keyID string, digest []byte) (*big.Int, *big.Int, error) {
+	var resp pb.SignResponse
+	if err := g.conn.Invoke(ctx, grpcSign, &pb.SignRequest{KeyId: keyID, Digest: digest}, &resp); err != nil {
+		return nil, nil, fromStatus(err)
+	}
+	return new(big.Int).SetBytes(resp.R), new(big.Int).SetBytes(resp.S), nil
+}
+
+func fromStatus(err error) error {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, s.Message())
+	}
+	return err
+}
+
+func toStatus(err error) error {
+	if errors.Is(err, ErrKeyNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// RegisterGRPCServer serves signer over the RemoteSigner gRPC service, e.g.
+// to run the Software signer as an external signer in tests.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, signer Signer) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: grpcServiceName,
+		HandlerType: (*Signer)(nil),
+		Methods: []grpc.MethodDesc{
+			{MethodName: "GetPublicKey", Handler: getPublicKeyHandler},
+			{MethodName: "Sign", Handler: signHandler},
+		},
+		Metadata: "remotesigner.proto",
+	}, signer)
+}
+
+func getPublicKeyHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	req := new(pb.GetPublicKeyRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		pub, err := srv.(Signer).PublicKey(ctx, req.(*pb.GetPublicKeyRequest).KeyId)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return &pb.GetPublicKeyResponse{PublicKey: crypto.FromECDSAPub(pub)}, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: grpcGetPublicKey}, handler)
+}
+
+func signHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	req := new(pb.SignRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		r := req.(*pb.SignRequest)
+		sigR, sigS, err := srv.(Signer).SignDigest(ctx, r.KeyId, r.Digest)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return &pb.SignResponse{R: sigR.Bytes(), S: sigS.Bytes()}, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: grpcSign}, handler)
+}
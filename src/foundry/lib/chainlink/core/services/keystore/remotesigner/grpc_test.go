@@ -0,0 +1,53 @@
+package remotesigner_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+func TestGRPC(t *testing.T) {
+	t.Parallel()
+
+	software := remotesigner.NewSoftware()
+	keyID, err := software.CreateKey()
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	remotesigner.RegisterGRPCServer(server, software)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	signer, err := remotesigner.NewGRPC(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, signer.Close()) })
+
+	ctx := context.Background()
+	expected, err := remotesigner.Address(ctx, software, keyID)
+	require.NoError(t, err)
+	address, err := remotesigner.Address(ctx, signer, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, expected, address)
+
+	digest := crypto.Keccak256([]byte("hello"))
+	sig, err := remotesigner.SignEthereum(ctx, signer, keyID, address, digest)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+
+	_, err = signer.PublicKey(ctx, "unknown")
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+	_, _, err = signer.SignDigest(ctx, "unknown", digest)
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: remotesigner.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_remotesigner_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPublicKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type GetPublicKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// public_key is the uncompressed SEC 1 encoding of the secp256k1 public key.
+	PublicKey     []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyResponse) Reset() {
+	*x = GetPublicKeyResponse{}
+	mi := &file_remotesigner_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyResponse) ProtoMessage() {}
+
+func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPublicKeyResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type SignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// digest is the 32 byte digest to sign.
+	Digest        []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	mi := &file_remotesigner_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SignRequest) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type SignResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// r and s are the big-endian values of the ECDSA signature.
+	R             []byte `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
+	S             []byte `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	mi := &file_remotesigner_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesigner_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_remotesigner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignResponse) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *SignResponse) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+var File_remotesigner_proto protoreflect.FileDescriptor
+
+const file_remotesigner_proto_rawDesc = "" +
+	"\n" +
+	"\x12remotesigner.proto\x12\fremotesigner\",\n" +
+	"\x13GetPublicKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"5\n" +
+	"\x14GetPublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\"<\n" +
+	"\vSignRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\fR\x06digest\"*\n" +
+	"\fSignResponse\x12\f\n" +
+	"\x01r\x18\x01 \x01(\fR\x01r\x12\f\n" +
+	"\x01s\x18\x02 \x01(\fR\x01s2\xa4\x01\n" +
+	"\fRemoteSigner\x12U\n" +
+	"\fGetPublicKey\x12!.remotesigner.GetPublicKeyRequest\x1a\".remotesigner.GetPublicKeyResponse\x12=\n" +
+	"\x04Sign\x12\x19.remotesigner.SignRequest\x1a\x1a.remotesigner.SignResponseBQZOgithub.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pbb\x06proto3"
+
+var (
+	file_remotesigner_proto_rawDescOnce sync.Once
+	file_remotesigner_proto_rawDescData []byte
+)
+
+func file_remotesigner_proto_rawDescGZIP() []byte {
+	file_remotesigner_proto_rawDescOnce.Do(func() {
+		file_remotesigner_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_remotesigner_proto_rawDesc), len(file_remotesigner_proto_rawDesc)))
+	})
+	return file_remotesigner_proto_rawDescData
+}
+
+var file_remotesigner_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_remotesigner_proto_goTypes = []any{
+	(*GetPublicKeyRequest)(nil),  // 0: remotesigner.GetPublicKeyRequest
+	(*GetPublicKeyResponse)(nil), // 1: remotesigner.GetPublicKeyResponse
+	(*SignRequest)(nil),          // 2: remotesigner.SignRequest
+	(*SignResponse)(nil),         // 3: remotesigner.SignResponse
+}
+var file_remotesigner_proto_depIdxs = []int32{
+	0, // 0: remotesigner.RemoteSigner.GetPublicKey:input_type -> remotesigner.GetPublicKeyRequest
+	2, // 1: remotesigner.RemoteSigner.Sign:input_type -> remotesigner.SignRequest
+	1, // 2: remotesigner.RemoteSigner.GetPublicKey:output_type -> remotesigner.GetPublicKeyResponse
+	3, // 3: remotesigner.RemoteSigner.Sign:output_type -> remotesigner.SignResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_remotesigner_proto_init() }
+func file_remotesigner_proto_init() {
+	if File_remotesigner_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remotesigner_proto_rawDesc), len(file_remotesigner_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_remotesigner_proto_goTypes,
+		DependencyIndexes: file_remotesigner_proto_depIdxs,
+		MessageInfos:      file_remotesigner_proto_msgTypes,
+	}.Build()
+	File_remotesigner_proto = out.File
+	file_remotesigner_proto_goTypes = nil
+	file_remotesigner_proto_depIdxs = nil
+}
@@ -0,0 +1,83 @@
+// Package remotesigner defines the protocol the keystore uses to sign with
+// Ethereum keys held outside of the keyring, e.g. by a KMS or in an HSM
+// accessed over PKCS#11.
+package remotesigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Div(secp256k1N, big.NewInt(2))
+)
+
+// ErrKeyNotFound is returned by a Signer which does not hold a key with the given ID.
+var ErrKeyNotFound = errors.New("remote key not found")
+
+// Signer is an external signer holding secp256k1 keys. It mirrors the
+// operations offered by KMS APIs and PKCS#11 tokens (CKM_ECDSA): the private
+// key never leaves the signer, which only reveals the public key and signs
+// digests.
+type Signer interface {
+	// PublicKey returns the public key of the key with keyID.
+	PublicKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error)
+	// SignDigest signs the 32 byte digest with the key with keyID and returns
+	// the R and S values of the ECDSA signature. S does not need to be
+	// normalized and no recovery ID is expected.
+	SignDigest(ctx context.Context, keyID string, digest []byte) (r, s *big.Int, err error)
+}
+
+// Address returns the Ethereum address of the key with keyID.
+func Address(ctx context.Context, signer Signer, keyID string) (common.Address, error) {
+	pub, err := signer.PublicKey(ctx, keyID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get public key of remote key %s: %w", keyID, err)
+	}
+	if pub == nil || pub.Curve != crypto.S256() {
+		return common.Address{}, fmt.Errorf("remote key %s is not a secp256k1 key", keyID)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// SignEthereum signs the digest with the key with keyID, which must belong to
+// address, and returns the signature in the 65 byte [R || S || V] format of
+// crypto.Sign, with V being 0 or 1.
+func SignEthereum(ctx context.Context, signer Signer, keyID string, address common.Address, digest []byte) ([]byte, error) {
+	if len(digest) != common.HashLength {
+		return nil, fmt.Errorf("digest must be %d bytes, got %d", common.HashLength, len(digest))
+	}
+	r, s, err := signer.SignDigest(ctx, keyID, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with remote key %s: %w", keyID, err)
+	}
+	if r == nil || s == nil || r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(secp256k1N) >= 0 || s.Cmp(secp256k1N) >= 0 {
+		return nil, fmt.Errorf("remote key %s returned an invalid signature", keyID)
+	}
+	// Ethereum only accepts signatures in the lower half of the curve order (EIP-2),
+	// which external signers do not necessarily produce.
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(secp256k1N, s)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	// The recovery ID is not returned by external signers, so find the one
+	// which recovers the expected address.
+	for _, v := range []byte{0, 1} {
+		sig[64] = v
+		pub, err := crypto.SigToPub(digest, sig)
+		if err == nil && crypto.PubkeyToAddress(*pub) == address {
+			return sig, nil
+		}
+	}
+	return nil, fmt.Errorf("signature of remote key %s does not recover to address %s", keyID, address.Hex())
+}
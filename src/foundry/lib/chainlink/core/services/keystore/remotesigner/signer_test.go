@@ -0,0 +1,112 @@
+package remotesigner_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+// highSSigner returns the non normalized form of the signatures of the Software signer,
+// as KMS and PKCS#11 signers do for about half of the signatures.
+type highSSigner struct {
+	*remotesigner.Software
+}
+
+func (h highSSigner) SignDigest(ctx context.Context, keyID string, digest []byte) (*big.Int, *big.Int, error) {
+	r, s, err := h.Software.SignDigest(ctx, keyID, digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, new(big.Int).Sub(crypto.S256().Params().N, s), nil
+}
+
+type p256Signer struct {
+	key *ecdsa.PrivateKey
+}
+
+func (p p256Signer) PublicKey(context.Context, string) (*ecdsa.PublicKey, error) {
+	return &p.key.PublicKey, nil
+}
+
+func (p p256Signer) SignDigest(_ context.Context, _ string, digest []byte) (*big.Int, *big.Int, error) {
+	return ecdsa.Sign(rand.Reader, p.key, digest)
+}
+
+func TestSignEthereum(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	digest := crypto.Keccak256([]byte("message"))
+
+	software := remotesigner.NewSoftware()
+	keyID, err := software.CreateKey()
+	require.NoError(t, err)
+	address, err := remotesigner.Address(ctx, software, keyID)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name   string
+		signer remotesigner.Signer
+	}{
+		{"low S", software},
+		{"high S", highSSigner{software}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := remotesigner.SignEthereum(ctx, tt.signer, keyID, address, digest)
+			require.NoError(t, err)
+			require.Len(t, sig, crypto.SignatureLength)
+			assert.True(t, crypto.ValidateSignatureValues(sig[64], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), true))
+
+			pub, err := crypto.SigToPub(digest, sig)
+			require.NoError(t, err)
+			assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := remotesigner.SignEthereum(ctx, software, keyID, address, digest[:31])
+		require.ErrorContains(t, err, "digest must be 32 bytes")
+
+		_, err = remotesigner.SignEthereum(ctx, software, "unknown", address, digest)
+		require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+
+		otherKeyID, err := software.CreateKey()
+		require.NoError(t, err)
+		_, err = remotesigner.SignEthereum(ctx, software, otherKeyID, address, digest)
+		require.ErrorContains(t, err, "does not recover to address")
+	})
+}
+
+func TestAddress(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	software := remotesigner.NewSoftware()
+	keyID := software.AddKey(privateKey)
+
+	address, err := remotesigner.Address(ctx, software, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), address)
+
+	_, err = remotesigner.Address(ctx, software, "unknown")
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+
+	software.DeleteKey(keyID)
+	_, err = remotesigner.Address(ctx, software, keyID)
+	require.ErrorIs(t, err, remotesigner.ErrKeyNotFound)
+
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, err = remotesigner.Address(ctx, p256Signer{p256Key}, "p256")
+	require.ErrorContains(t, err, "is not a secp256k1 key")
+}
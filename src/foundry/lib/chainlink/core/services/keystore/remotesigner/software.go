@@ -0,0 +1,74 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+var _ Signer = &Software{}
+
+// Software is an in memory Signer standing in for an external signer in tests
+// and local development. It must not be used to hold keys of real value.
+type Software struct {
+	mu   sync.RWMutex
+	keys map[string]*ecdsa.PrivateKey
+}
+
+// NewSoftware returns an empty Software signer.
+func NewSoftware() *Software {
+	return &Software{keys: make(map[string]*ecdsa.PrivateKey)}
+}
+
+// CreateKey generates a new key and returns its ID.
+func (s *Software) CreateKey() (string, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return "", err
+	}
+	return s.AddKey(privateKey), nil
+}
+
+// AddKey adds an existing private key and returns its ID.
+func (s *Software) AddKey(privateKey *ecdsa.PrivateKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keyID := uuid.New().String()
+	s.keys[keyID] = privateKey
+	return keyID
+}
+
+// DeleteKey removes the key with keyID, as destroying it in the external signer would.
+func (s *Software) DeleteKey(keyID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, keyID)
+}
+
+func (s *Software) PublicKey(_ context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	privateKey, ok := s.keys[keyID]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return &privateKey.PublicKey, nil
+}
+
+func (s *Software) SignDigest(_ context.Context, keyID string, digest []byte) (*big.Int, *big.Int, error) {
+	s.mu.RLock()
+	privateKey, ok := s.keys[keyID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, nil, ErrKeyNotFound
+	}
+	sig, err := crypto.Sign(digest, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), nil
+}
@@ -0,0 +1,82 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// RotatePassword re-encrypts the keyring stored in ds, which must be encrypted
+// with oldPassword, with newPassword and scryptParams. The keyring is read,
+// re-encrypted, verified and written in a single transaction, so it is left
+// untouched on any error. The keystore must not be unlocked by a running node
+// meanwhile, as the node would save the keyring with the old password again.
+func RotatePassword(ctx context.Context, ds sqlutil.DataSource, oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	return sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		var ekr encryptedKeyRing
+		if err := tx.GetContext(ctx, &ekr, `SELECT * FROM encrypted_key_rings LIMIT 1 FOR UPDATE`); err != nil {
+			return errors.Wrap(err, "unable to get encrypted key ring")
+		}
+		if len(ekr.EncryptedKeys) == 0 {
+			return errors.New("key ring is empty, there is no password to rotate")
+		}
+		kr, err := ekr.Decrypt(oldPassword)
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt encrypted key ring with the old password")
+		}
+		rotated, err := kr.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			return errors.Wrap(err, "unable to encrypt keyRing")
+		}
+
+		// verify the rotated key ring holds exactly the same keys before replacing the old one
+		oldKeys, err := ekr.decryptRawKeys(oldPassword)
+		if err != nil {
+			return err
+		}
+		newKeys, err := rotated.decryptRawKeys(newPassword)
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt rotated key ring")
+		}
+		if !reflect.DeepEqual(oldKeys, newKeys) {
+			return errors.New("rotated key ring does not match the original key ring")
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE encrypted_key_rings SET encrypted_keys = $1, updated_at = NOW()`, rotated.EncryptedKeys)
+		return errors.Wrap(err, "while saving keyring")
+	})
+}
+
+// decryptRawKeys returns the raw keys of the key ring by field, in a stable order.
+func (ekr encryptedKeyRing) decryptRawKeys(password string) (rawLegacyKeys, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON); err != nil {
+		return nil, err
+	}
+	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+	if err != nil {
+		return nil, err
+	}
+	keys := rawLegacyKeys{}
+	if err = json.Unmarshal(marshalledRawKeyRingJson, &keys); err != nil {
+		return nil, err
+	}
+	for name, values := range keys {
+		if len(values) == 0 {
+			delete(keys, name)
+			continue
+		}
+		sort.Strings(values)
+	}
+	return keys, nil
+}
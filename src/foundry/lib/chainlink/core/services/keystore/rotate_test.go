@@ -0,0 +1,71 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestRotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	const newPassword = "n3wP4ssw0rd-for-the-k3yst0re"
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.ErrorContains(t, keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams), "unable to get encrypted key ring")
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	require.ErrorContains(t, keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams), "key ring is empty")
+
+	ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	csaKey, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	var before []byte
+	require.NoError(t, db.GetContext(ctx, &before, `SELECT encrypted_keys FROM encrypted_key_rings`))
+
+	t.Run("fails with the wrong old password", func(t *testing.T) {
+		err := keystore.RotatePassword(ctx, db, "wrong password", newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "unable to decrypt encrypted key ring with the old password")
+		var after []byte
+		require.NoError(t, db.GetContext(ctx, &after, `SELECT encrypted_keys FROM encrypted_key_rings`))
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("fails with an empty new password", func(t *testing.T) {
+		require.Error(t, keystore.RotatePassword(ctx, db, cltest.Password, "", utils.FastScryptParams))
+	})
+
+	scryptParams := utils.ScryptParams{N: 4, P: 1}
+	require.NoError(t, keystore.RotatePassword(ctx, db, cltest.Password, newPassword, scryptParams))
+	cltest.AssertCount(t, db, "encrypted_key_rings", 1)
+
+	var after []byte
+	require.NoError(t, db.GetContext(ctx, &after, `SELECT encrypted_keys FROM encrypted_key_rings`))
+	var cryptoJSON gethkeystore.CryptoJSON
+	require.NoError(t, json.Unmarshal(after, &cryptoJSON))
+	assert.EqualValues(t, scryptParams.N, cryptoJSON.KDFParams["n"])
+	assert.EqualValues(t, scryptParams.P, cryptoJSON.KDFParams["p"])
+
+	rotated := keystore.ExposedNewMaster(t, db)
+	require.Error(t, rotated.Unlock(ctx, cltest.Password))
+	require.NoError(t, rotated.Unlock(ctx, newPassword))
+
+	gotEthKey, err := rotated.Eth().Get(ctx, ethKey.ID())
+	require.NoError(t, err)
+	requireEqualKeys(t, ethKey, gotEthKey)
+	gotCSAKey, err := rotated.CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+	requireEqualKeys(t, csaKey, gotCSAKey)
+}
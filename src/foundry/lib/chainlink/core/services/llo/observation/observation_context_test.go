@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -180,6 +181,16 @@ func (m *mockPipelineConfig) ReaperThreshold() time.Duration { return 0 }
 
 // func (m *mockPipelineConfig) VerboseLogging() bool           { return true }
 func (m *mockPipelineConfig) VerboseLogging() bool { return false }
+func (m *mockPipelineConfig) HTTPCredentials(string) *config.HTTPCredentials {
+	return nil
+}
+func (m *mockPipelineConfig) MaxConcurrentRuns() uint32 { return 0 }
+func (m *mockPipelineConfig) MaxRunsPerMinute() uint32  { return 0 }
+func (m *mockPipelineConfig) RunLimitMode() string      { return config.RunLimitModeQueue }
+func (m *mockPipelineConfig) RunRetention(string) config.RunRetention {
+	return config.RunRetention{}
+}
+func (m *mockPipelineConfig) RunArchivePath() string { return "" }
 
 type mockBridgeConfig struct{}
 
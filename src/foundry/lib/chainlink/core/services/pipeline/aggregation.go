@@ -0,0 +1,126 @@
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Reasons reported for inputs discarded by an aggregation task.
+const (
+	DiscardReasonError            = "error"
+	DiscardReasonTrimmed          = "trimmed"
+	DiscardReasonOutlier          = "outlier"
+	DiscardReasonOutsideTolerance = "outside tolerance"
+)
+
+// aggregationInput is a numeric input of an aggregation task, along with its
+// position in the task's values.
+type aggregationInput struct {
+	index int
+	value decimal.Decimal
+}
+
+// discardedInputs collects the inputs an aggregation task leaves out of its
+// result, so they can be reported in the task output.
+type discardedInputs []interface{}
+
+func (d *discardedInputs) add(index int, value interface{}, reason string) {
+	*d = append(*d, map[string]interface{}{
+		"index":  index,
+		"value":  value,
+		"reason": reason,
+	})
+}
+
+// resolveAggregationInputs converts the non-errored values to decimals and
+// records errored values as discarded. It fails if there are more errored
+// values than allowed, or no values left.
+func resolveAggregationInputs(taskType TaskType, valuesAndErrs SliceParam, maybeAllowedFaults MaybeUint64Param) ([]aggregationInput, discardedInputs, error) {
+	allowedFaults := max(len(valuesAndErrs)-1, 0)
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	}
+
+	var (
+		values    []aggregationInput
+		discarded = discardedInputs{}
+		faults    int
+	)
+	for i, val := range valuesAndErrs {
+		if err, isErr := val.(error); isErr {
+			faults++
+			discarded.add(i, err.Error(), DiscardReasonError)
+			continue
+		}
+		var d DecimalParam
+		if err := d.UnmarshalPipelineParam(val); err != nil {
+			return nil, nil, errors.Wrapf(ErrBadInput, "values: %v", err)
+		}
+		values = append(values, aggregationInput{index: i, value: d.Decimal()})
+	}
+
+	if faults > allowedFaults {
+		return nil, nil, errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to %s task > number allowed faults %v", faults, taskType, allowedFaults)
+	} else if len(values) == 0 {
+		return nil, nil, errors.Wrap(ErrWrongInputCardinality, "values")
+	}
+	return values, discarded, nil
+}
+
+// sortAggregationInputs sorts the inputs by value, keeping the original order
+// of equal values.
+func sortAggregationInputs(values []aggregationInput) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value.LessThan(values[j].value)
+	})
+}
+
+// inputDecimals returns the values of the inputs.
+func inputDecimals(values []aggregationInput) []decimal.Decimal {
+	decimals := make([]decimal.Decimal, len(values))
+	for i, val := range values {
+		decimals[i] = val.value
+	}
+	return decimals
+}
+
+// outputValues returns the values of the inputs as a task output.
+func outputValues(values []aggregationInput) []interface{} {
+	output := make([]interface{}, len(values))
+	for i, val := range values {
+		output[i] = val.value
+	}
+	return output
+}
+
+// sortDecimals sorts the decimals in place and returns them.
+func sortDecimals(decimals []decimal.Decimal) []decimal.Decimal {
+	sort.Slice(decimals, func(i, j int) bool {
+		return decimals[i].LessThan(decimals[j])
+	})
+	return decimals
+}
+
+// sortedMedian returns the median of the sorted, non-empty decimals.
+func sortedMedian(sorted []decimal.Decimal) decimal.Decimal {
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
+
+// sortedQuantile returns the q-quantile of the sorted, non-empty decimals,
+// interpolating linearly between the closest ranks.
+func sortedQuantile(sorted []decimal.Decimal, q decimal.Decimal) decimal.Decimal {
+	pos := q.Mul(decimal.NewFromInt(int64(len(sorted) - 1)))
+	lower := pos.Floor()
+	i := int(lower.IntPart())
+	if i >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	frac := pos.Sub(lower)
+	return sorted[i].Add(sorted[i+1].Sub(sorted[i]).Mul(frac))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	cnull "github.com/smartcontractkit/chainlink/v2/core/null"
 )
 
@@ -72,6 +73,12 @@ type (
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
 		VerboseLogging() bool
+		HTTPCredentials(name string) *coreconfig.HTTPCredentials
+		MaxConcurrentRuns() uint32
+		MaxRunsPerMinute() uint32
+		RunLimitMode() string
+		RunRetention(jobType string) coreconfig.RunRetention
+		RunArchivePath() string
 	}
 
 	BridgeConfig interface {
@@ -109,6 +116,9 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// Iterations holds the task run results of every iteration executed by a
+	// foreach task, in item order
+	Iterations []TaskRunResults
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -215,6 +225,11 @@ func (result *TaskRunResult) IsPending() bool {
 	return !result.FinishedAt.Valid && result.Result == Result{}
 }
 
+// Iterations returns the task run results of every iteration executed by a foreach task
+func (result *TaskRunResult) Iterations() []TaskRunResults {
+	return result.runInfo.Iterations
+}
+
 func (result *TaskRunResult) IsTerminal() bool {
 	return len(result.Task.Outputs()) == 0
 }
@@ -320,9 +335,11 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeForEach          TaskType = "foreach"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeJQ               TaskType = "jq"
 	TaskTypeJSONParse        TaskType = "jsonparse"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
@@ -333,11 +350,15 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeQuorum           TaskType = "quorum"
+	TaskTypeRejectOutliers   TaskType = "rejectoutliers"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -378,12 +399,22 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRejectOutliers:
+		task = &RejectOutliersTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeQuorum:
+		task = &QuorumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJQ:
+		task = &JQTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -436,6 +467,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -485,6 +518,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	if forEach, isForEach := task.(*ForEachTask); isForEach {
+		if err = forEach.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return task, nil
 }
 
@@ -23,32 +23,14 @@ func makeHTTPRequest(
 	reqHeaders []string,
 	requestData MapParam,
 	client *http.Client,
+	signer *requestSigner,
 	httpLimit int64,
 ) (responseBytes []byte, statusCode int, respHeaders http.Header, start, finish time.Time, err error) {
-	var bodyReader io.Reader
-	if requestData != nil {
-		var bodyBytes []byte
-		bodyBytes, err = json.Marshal(requestData)
-		if err != nil {
-			err = errors.Wrap(err, "failed to encode request body as JSON")
-			return
-		}
-		bodyReader = bytes.NewReader(bodyBytes)
-	}
-
 	var request *http.Request
-	request, err = http.NewRequestWithContext(ctx, string(method), url.String(), bodyReader)
+	request, client, err = newHTTPRequest(ctx, method, url, reqHeaders, requestData, client, signer)
 	if err != nil {
-		err = errors.Wrap(err, "failed to create http.Request")
 		return
 	}
-	request.Header.Set("Content-Type", "application/json")
-	if len(reqHeaders)%2 != 0 {
-		panic("headers must have an even number of elements")
-	}
-	for i := 0; i+1 < len(reqHeaders); i += 2 {
-		request.Header.Set(reqHeaders[i], reqHeaders[i+1])
-	}
 
 	httpRequest := clhttp.HTTPRequest{
 		Client:  client,
@@ -75,6 +57,54 @@ func makeHTTPRequest(
 	return
 }
 
+// newHTTPRequest builds a request with a JSON encoded body, signed by signer,
+// and returns the client to send it with.
+func newHTTPRequest(
+	ctx context.Context,
+	method StringParam,
+	url URLParam,
+	reqHeaders []string,
+	requestData MapParam,
+	client *http.Client,
+	signer *requestSigner,
+) (*http.Request, *http.Client, error) {
+	var (
+		bodyReader io.Reader
+		bodyBytes  []byte
+	)
+	if requestData != nil {
+		var err error
+		bodyBytes, err = json.Marshal(requestData)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to encode request body as JSON")
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, string(method), url.String(), bodyReader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create http.Request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(reqHeaders)%2 != 0 {
+		panic("headers must have an even number of elements")
+	}
+	for i := 0; i+1 < len(reqHeaders); i += 2 {
+		request.Header.Set(reqHeaders[i], reqHeaders[i+1])
+	}
+	injectTraceContext(ctx, request.Header)
+	if err = signer.checkHost(request.URL); err != nil {
+		return nil, nil, err
+	}
+	if err = signer.sign(request, bodyBytes, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	if client, err = signer.client(client); err != nil {
+		return nil, nil, err
+	}
+	return request, client, nil
+}
+
 type PossibleErrorResponses struct {
 	Error        string `json:"error"`
 	ErrorMessage string `json:"errorMessage"`
@@ -67,6 +67,42 @@ func TestTaskAnyUnmarshal(t *testing.T) {
 	require.True(t, p.Tasks[0].Base().FailEarly)
 }
 
+func TestTaskForEachUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parses sub-pipeline", func(t *testing.T) {
+		a := `fe [type=foreach items="$(assets)" parallelism=4 pipeline=<
+			ds [type=http method=GET url="https://chain.link" requestData=<{"asset": $(item)}>];
+			ds_parse [type=jsonparse path="price"];
+			ds -> ds_parse;
+		>];`
+		p, err := pipeline.Parse(a)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+
+		task, ok := p.Tasks[0].(*pipeline.ForEachTask)
+		require.True(t, ok)
+		require.Equal(t, "$(assets)", task.Items)
+		require.Equal(t, "4", task.Parallelism)
+		require.Contains(t, task.Pipeline, "ds -> ds_parse;")
+	})
+
+	t.Run("rejects invalid sub-pipeline", func(t *testing.T) {
+		_, err := pipeline.Parse(`fe [type=foreach items="$(assets)" pipeline="ds [type=nope];"];`)
+		require.ErrorContains(t, err, "foreach sub-pipeline")
+	})
+
+	t.Run("rejects async tasks", func(t *testing.T) {
+		_, err := pipeline.Parse(`fe [type=foreach items="$(assets)" pipeline="tx [type=ethtx];"];`)
+		require.ErrorContains(t, err, "cannot contain async tasks")
+	})
+
+	t.Run("rejects reserved task names", func(t *testing.T) {
+		_, err := pipeline.Parse(`fe [type=foreach items="$(assets)" pipeline="item [type=memo value=1];"];`)
+		require.ErrorContains(t, err, "reserved keyword")
+	})
+}
+
 func TestRetryUnmarshal(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +182,10 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMean, &pipeline.MeanTask{}},
 		{pipeline.TaskTypeMedian, &pipeline.MedianTask{}},
 		{pipeline.TaskTypeMode, &pipeline.ModeTask{}},
+		{pipeline.TaskTypeTrimmedMean, &pipeline.TrimmedMeanTask{}},
+		{pipeline.TaskTypeWeightedMedian, &pipeline.WeightedMedianTask{}},
+		{pipeline.TaskTypeRejectOutliers, &pipeline.RejectOutliersTask{}},
+		{pipeline.TaskTypeQuorum, &pipeline.QuorumTask{}},
 		{pipeline.TaskTypeSum, &pipeline.SumTask{}},
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
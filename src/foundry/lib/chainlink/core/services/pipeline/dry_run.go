@@ -0,0 +1,224 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+var ErrDryRunUnknownTask = errors.New("mock output given for unknown task")
+
+// MockOutput is the result a task returns instead of being executed during a
+// dry run. Exactly one of Value and Error must be given.
+type MockOutput struct {
+	Error null.String     `json:"error"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ToResult decodes the mock output like the jsonparse task decodes JSON.
+func (m MockOutput) ToResult() (Result, error) {
+	if m.Error.Valid && m.Value == nil {
+		return Result{Error: errors.New(m.Error.ValueOrZero())}, nil
+	}
+	if m.Error.Valid || m.Value == nil {
+		return Result{}, errors.New("must provide only one of either 'value' or 'error' key")
+	}
+	var value interface{}
+	d := json.NewDecoder(bytes.NewReader(m.Value))
+	d.UseNumber()
+	if err := d.Decode(&value); err != nil {
+		return Result{}, errors.Wrap(err, "invalid JSON value")
+	}
+	value, err := jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Value: value}, nil
+}
+
+// RecordedETHTx is a transaction an ethtx task would have sent during a dry run.
+type RecordedETHTx struct {
+	DotID            string                 `json:"dotId"`
+	EVMChainID       string                 `json:"evmChainID"`
+	From             []string               `json:"from"`
+	To               string                 `json:"to"`
+	Data             string                 `json:"data"`
+	GasLimit         *uint64                `json:"gasLimit"`
+	MinConfirmations *uint64                `json:"minConfirmations"`
+	TxMeta           map[string]interface{} `json:"txMeta"`
+}
+
+// DryRunResult is the outcome of executing a pipeline without side effects.
+type DryRunResult struct {
+	Run            *Run
+	TaskRunResults TaskRunResults
+	Transactions   []RecordedETHTx
+}
+
+// ethTxRecorder stands in for an ethtx task during a dry run. It resolves the
+// task's parameters like the real task does, but records the transaction
+// instead of handing it to the transaction manager.
+type ethTxRecorder struct {
+	*ETHTxTask
+
+	mu  *sync.Mutex
+	txs *[]RecordedETHTx
+}
+
+func (t *ethTxRecorder) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+
+	var (
+		chainID               StringParam
+		fromAddrs             AddressSliceParam
+		toAddr                AddressParam
+		data                  BytesParam
+		gasLimit              Uint64Param
+		txMetaMap             MapParam
+		maybeMinConfirmations MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
+		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
+		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
+		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
+	)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	tx := RecordedETHTx{
+		DotID:      t.DotID(),
+		EVMChainID: string(chainID),
+		From:       []string{},
+		To:         common.Address(toAddr).Hex(),
+		Data:       hexutil.Encode(data),
+		TxMeta:     txMetaMap,
+	}
+	for _, addr := range fromAddrs {
+		tx.From = append(tx.From, addr.Hex())
+	}
+	// without a chain the default gas limit is unknown, only explicit limits are recorded
+	if t.GasLimit != "" {
+		if err = ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit))); err != nil {
+			return Result{Error: errors.Wrap(err, "gasLimit")}, RunInfo{}
+		}
+		limit := uint64(gasLimit)
+		tx.GasLimit = &limit
+	} else if t.specGasLimit != nil {
+		limit := uint64(*t.specGasLimit)
+		tx.GasLimit = &limit
+	}
+	if minConfirmations, isSet := maybeMinConfirmations.Uint64(); isSet {
+		tx.MinConfirmations = &minConfirmations
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	*t.txs = append(*t.txs, tx)
+	return Result{}, RunInfo{}
+}
+
+// dryRunBridgeORM stands in for the bridge ORM of bridge tasks during a dry
+// run, so that their responses are not cached.
+type dryRunBridgeORM struct {
+	bridges.ORM
+}
+
+func (dryRunBridgeORM) UpsertBridgeResponse(context.Context, string, int32, []byte) error {
+	return nil
+}
+
+// dryRunTasks replaces the tasks of p having side effects: tasks with an entry
+// in mockOutputs return the given result, ethtx tasks record their transaction
+// in txs, bridge tasks neither cache their responses nor use the circuit
+// breakers and round-robin state of live runs, and foreach tasks apply the same
+// to the tasks of their iterations.
+func dryRunTasks(p *Pipeline, mockOutputs map[string]Result, mu *sync.Mutex, txs *[]RecordedETHTx) {
+	replacements := make(map[Task]Task)
+	for _, task := range p.Tasks {
+		if result, isMocked := mockOutputs[task.DotID()]; isMocked {
+			replacements[task] = &stubTask{Task: task, result: result}
+			continue
+		}
+		switch t := task.(type) {
+		case *ETHTxTask:
+			replacements[task] = &ethTxRecorder{ETHTxTask: t, mu: mu, txs: txs}
+		case *BridgeTask:
+			t.orm = dryRunBridgeORM{ORM: t.orm}
+			t.circuitBreakers = nil
+			t.roundRobin = nil
+		case *ForEachTask:
+			t.prepareIteration = func(iteration *Pipeline) {
+				dryRunTasks(iteration, nil, mu, txs)
+			}
+		}
+	}
+	p.replaceTasks(replacements)
+}
+
+// DryRun executes the pipeline of the given spec in memory. Tasks with an
+// entry in mockOutputs are not executed and return the given result instead,
+// ethtx tasks record the transaction they would have sent, and bridge tasks
+// leave the bridge response cache and circuit breakers untouched. Nothing is
+// persisted.
+func (r *runner) DryRun(ctx context.Context, spec Spec, vars Vars, mockOutputs map[string]Result) (*DryRunResult, error) {
+	ctx, cancel := commonutils.ContextWithDeadlineFn(ctx, func(orig time.Time) time.Time {
+		if tenPct := time.Until(orig) / 10; overtime > tenPct {
+			return orig.Add(-tenPct)
+		}
+		return orig.Add(-overtime)
+	})
+	defer cancel()
+
+	// always re-parse, the tasks of the pipeline are replaced below
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	for dotID := range mockOutputs {
+		if p.ByDotID(dotID) == nil {
+			return nil, errors.Wrap(ErrDryRunUnknownTask, dotID)
+		}
+	}
+
+	var (
+		mu  sync.Mutex
+		txs = []RecordedETHTx{}
+	)
+	dryRunTasks(p, mockOutputs, &mu, &txs)
+
+	run := NewRun(spec, vars)
+	taskRunResults := r.runUnrecorded(ctx, p, run, vars)
+	if run.Pending {
+		return nil, fmt.Errorf("unexpected async run for spec ID %v, async tasks must be mocked in a dry run", spec.ID)
+	}
+
+	return &DryRunResult{
+		Run:            run,
+		TaskRunResults: taskRunResults,
+		Transactions:   txs,
+	}, nil
+}
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -42,7 +43,7 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 			err = fmt.Errorf("could not unmarshal DOT into a pipeline.Graph: %v", rerr)
 		}
 	}()
-	bs = append([]byte("digraph {\n"), bs...)
+	bs = append([]byte("digraph {\n"), escapeHTMLStringEdges(bs)...)
 	bs = append(bs, []byte("\n}")...)
 	err = dot.Unmarshal(bs, g)
 	if err != nil {
@@ -52,6 +53,61 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	return nil
 }
 
+// htmlStringEdge replaces the '>' of an edge operator inside an angle-bracket
+// quoted string, which would otherwise terminate the string.
+const htmlStringEdge = '\x1f'
+
+// escapeHTMLStringEdges escapes edge operators inside angle-bracket quoted
+// strings, so that attributes like a foreach sub-pipeline can contain edges.
+// Double-quoted strings and comments are left untouched.
+func escapeHTMLStringEdges(src []byte) []byte {
+	out := make([]byte, 0, len(src))
+	depth := 0
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if depth > 0 {
+			switch {
+			case c == '>' && src[i-1] == '-':
+				c = htmlStringEdge
+			case c == '>':
+				depth--
+			case c == '<':
+				depth++
+			}
+			out = append(out, c)
+			continue
+		}
+
+		end := i + 1
+		switch {
+		case c == '<':
+			depth++
+		case c == '"':
+			for end < len(src) && src[end] != '"' {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end++
+		case c == '#', c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for end < len(src) && src[end] != '\n' {
+				end++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			if j := strings.Index(string(src[i+2:]), "*/"); j >= 0 {
+				end = i + 2 + j + 2
+			} else {
+				end = len(src)
+			}
+		}
+		end = min(end, len(src))
+		out = append(out, src[i:end]...)
+		i = end - 1
+	}
+	return out
+}
+
 // Looks at node attributes and searches for implicit dependencies on other nodes
 // expressed as attribute values. Adds those dependencies as implicit edges in the graph.
 func (g *Graph) AddImplicitDependenciesAsEdges() {
@@ -139,6 +195,7 @@ func (n *GraphNode) SetAttribute(attr encoding.Attribute) error {
 	// Strings quoted in angle brackets (supported natively by DOT) should
 	// have those brackets removed before decoding to task parameter types
 	sanitized := bracketQuotedAttrRegexp.ReplaceAllString(attr.Value, "$1")
+	sanitized = strings.ReplaceAll(sanitized, "-"+string(htmlStringEdge), "->")
 
 	n.attrs[attr.Key] = sanitized
 	return nil
@@ -183,6 +240,23 @@ func (p *Pipeline) MinTimeout() (time.Duration, bool, error) {
 	return minTimeout, aTimeoutSet, nil
 }
 
+// BridgeNames returns the names of the bridges called by the tasks of the
+// pipeline, including those of foreach sub-pipelines.
+func (p *Pipeline) BridgeNames() (names []string) {
+	for _, task := range p.Tasks {
+		switch t := task.(type) {
+		case *BridgeTask:
+			names = append(names, t.Name)
+		case *ForEachTask:
+			if sub, err := Parse(t.source()); err == nil {
+				names = append(names, sub.BridgeNames()...)
+			}
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
 func (p *Pipeline) RequiresPreInsert() bool {
 	for _, task := range p.Tasks {
 		switch task.Type() {
@@ -208,6 +282,32 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
+// replaceTasks swaps tasks of the pipeline for their replacements, re-linking
+// the inputs and outputs of all other tasks so the graph stays intact.
+func (p *Pipeline) replaceTasks(replacements map[Task]Task) {
+	if len(replacements) == 0 {
+		return
+	}
+	for i, task := range p.Tasks {
+		if replacement, ok := replacements[task]; ok {
+			p.Tasks[i] = replacement
+		}
+	}
+	for _, task := range p.Tasks {
+		base := task.Base()
+		for i, output := range base.outputs {
+			if replacement, ok := replacements[output]; ok {
+				base.outputs[i] = replacement
+			}
+		}
+		for i, input := range base.inputs {
+			if replacement, ok := replacements[input.InputTask]; ok {
+				base.inputs[i].InputTask = replacement
+			}
+		}
+	}
+}
+
 func Parse(text string) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
@@ -251,6 +251,40 @@ func TestGraph_ImplicitDependencies(t *testing.T) {
 	require.True(t, g.HasEdgeFromTo(nodes["c"], nodes["d"]))
 }
 
+func TestGraph_EdgesInAngleBracketStrings(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		// a <comment
+		a [type=memo value=<x -> y>];
+		# another <comment
+		b [type=memo value="<x -> y"];
+		/* a -> b < */
+		a -> b;
+	`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 2)
+	assert.Equal(t, "x -> y", p.Tasks[0].(*pipeline.MemoTask).Value)
+	assert.Equal(t, "<x -> y", p.Tasks[1].(*pipeline.MemoTask).Value)
+	assert.Len(t, p.Tasks[0].Outputs(), 1)
+}
+
+func TestPipeline_BridgeNames(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		a [type=bridge name=voter_turnout];
+		b [type=bridge name=election_winner];
+		c [type=foreach items="$(a)" pipeline=<
+			d [type=bridge name=voter_turnout];
+			e [type=bridge name=recount];
+		>];
+		a -> b -> c;
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"election_winner", "recount", "voter_turnout"}, p.BridgeNames())
+}
+
 func TestParse(t *testing.T) {
 	for _, s := range []struct {
 		name     string
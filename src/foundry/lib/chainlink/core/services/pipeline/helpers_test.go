@@ -1,10 +1,10 @@
 package pipeline
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -45,6 +45,14 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetCircuitBreakers(circuitBreakers *bridges.CircuitBreakers) {
+	t.circuitBreakers = circuitBreakers
+}
+
+func (t *BridgeTask) HelperSetRoundRobin(roundRobin *bridges.RoundRobin) {
+	t.roundRobin = roundRobin
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -65,4 +73,6 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.jobType = jobType
 }
 
-func (o *orm) Prune(ctx context.Context, pipelineSpecID int32) { o.prune(ctx, o.ds, pipelineSpecID) }
+func (r *runner) HelperSetTracerProvider(tp trace.TracerProvider) {
+	r.tracer = newTracer(tp)
+}
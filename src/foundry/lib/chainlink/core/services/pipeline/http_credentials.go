@@ -0,0 +1,282 @@
+package pipeline
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+var (
+	// ErrUnknownCredentials is returned when a task references a credential
+	// profile that is not configured in the secrets.
+	ErrUnknownCredentials = errors.New("unknown credentials")
+	// ErrCredentialsHostNotAllowed is returned when a task would send a
+	// credential profile to a host that is not in its AllowedHosts.
+	ErrCredentialsHostNotAllowed = errors.New("host not allowed for credentials")
+)
+
+// requestSigner authenticates the requests of http and bridge tasks with a
+// credential profile from the [JobPipeline.HTTPCredentials] secrets. The
+// signed headers are only set on the outgoing request, they are never added
+// to the headers that are logged or stored with the task run.
+type requestSigner struct {
+	name  string
+	creds *coreconfig.HTTPCredentials
+	// hash identifies the content of the profile in the caches.
+	hash [sha256.Size]byte
+}
+
+// newRequestSigner returns the signer for the named credential profile, or
+// nil if name is empty.
+func newRequestSigner(cfg Config, name string) (*requestSigner, error) {
+	if name == "" {
+		return nil, nil
+	}
+	creds := cfg.HTTPCredentials(name)
+	if creds == nil {
+		return nil, errors.Wrapf(ErrUnknownCredentials, "no credentials named %q", name)
+	}
+	b, err := json.Marshal(creds)
+	if err != nil {
+		return nil, errors.Wrapf(err, "credentials %q", name)
+	}
+	return &requestSigner{name: name, creds: creds, hash: sha256.Sum256(b)}, nil
+}
+
+// credentialsCacheEntry is a value derived from the content of a credential
+// profile.
+type credentialsCacheEntry struct {
+	hash  [sha256.Size]byte
+	value interface{}
+}
+
+// cached returns the value cached under key for the content of the profile,
+// creating it with fn otherwise. The entry of a previous content of the
+// profile, from before the secrets were reloaded, is evicted, so the caches
+// hold at most one version of the secret material of each profile.
+func (s *requestSigner) cached(cache *sync.Map, key interface{}, fn func() (interface{}, error)) (interface{}, error) {
+	if e, ok := cache.Load(key); ok && e.(*credentialsCacheEntry).hash == s.hash {
+		return e.(*credentialsCacheEntry).value, nil
+	}
+	v, err := fn()
+	if err != nil {
+		return nil, err
+	}
+	cache.Store(key, &credentialsCacheEntry{hash: s.hash, value: v})
+	return v, nil
+}
+
+// checkHost returns an error if the profile may not authenticate requests to u.
+func (s *requestSigner) checkHost(u *url.URL) error {
+	if s == nil {
+		return nil
+	}
+	for _, allowed := range s.creds.AllowedHosts {
+		if _, _, err := net.SplitHostPort(allowed); err == nil {
+			if strings.EqualFold(allowed, u.Host) {
+				return nil
+			}
+		} else if strings.EqualFold(allowed, u.Hostname()) {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrCredentialsHostNotAllowed, "credentials %q cannot be used with %s", s.name, u.Host)
+}
+
+// signedClients caches the clients of the credential profiles, keyed by the
+// base client and profile name, so connections are reused across runs.
+var signedClients sync.Map
+
+type signedClientKey struct {
+	base *http.Client
+	name string
+}
+
+// client returns the client to send the request with: a copy of base which
+// only follows redirects to the allowed hosts of the profile, and presents
+// the client certificate of profiles with TLS credentials.
+func (s *requestSigner) client(base *http.Client) (*http.Client, error) {
+	if s == nil {
+		return base, nil
+	}
+	c, err := s.cached(&signedClients, signedClientKey{base: base, name: s.name}, func() (interface{}, error) {
+		return s.newClient(base)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.(*http.Client), nil
+}
+
+func (s *requestSigner) newClient(base *http.Client) (*http.Client, error) {
+	c := *base
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if err := s.checkHost(req.URL); err != nil {
+			return err
+		}
+		if base.CheckRedirect != nil {
+			return base.CheckRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+	if s.creds.TLS == nil {
+		return &c, nil
+	}
+
+	cert, err := tls.X509KeyPair([]byte(s.creds.TLS.CertPEM), []byte(s.creds.TLS.KeyPEM))
+	if err != nil {
+		return nil, errors.Wrapf(err, "credentials %q: invalid client certificate", s.name)
+	}
+
+	var transport *http.Transport
+	switch rt := base.Transport.(type) {
+	case *http.Transport:
+		transport = rt.Clone()
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	default:
+		return nil, errors.Errorf("credentials %q: client certificates are not supported by transport %T", s.name, rt)
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	if s.creds.TLS.CAPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(s.creds.TLS.CAPEM)) {
+			return nil, errors.Errorf("credentials %q: invalid CA certificate", s.name)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	c.Transport = transport
+	return &c, nil
+}
+
+// sign sets the authentication headers of the request with the given body.
+func (s *requestSigner) sign(request *http.Request, body []byte, now time.Time) error {
+	if s == nil {
+		return nil
+	}
+	if s.creds.HMAC != nil {
+		if err := signHMAC(request, body, now, s.creds.HMAC); err != nil {
+			return errors.Wrapf(err, "credentials %q", s.name)
+		}
+	}
+	if s.creds.JWT != nil {
+		token, err := s.signJWT(now)
+		if err != nil {
+			return errors.Wrapf(err, "credentials %q", s.name)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	return nil
+}
+
+// signHMAC signs the timestamp, method, request URI and body of the request,
+// separated by newlines, and sets the hex encoded signature.
+func signHMAC(request *http.Request, body []byte, now time.Time, creds *coreconfig.HTTPCredentialsHMAC) error {
+	var h func() hash.Hash
+	switch creds.Algorithm {
+	case coreconfig.HMACAlgorithmSHA256:
+		h = sha256.New
+	case coreconfig.HMACAlgorithmSHA512:
+		h = sha512.New
+	default:
+		return errors.Errorf("unsupported HMAC algorithm %q", creds.Algorithm)
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(h, []byte(creds.Key))
+	mac.Write([]byte(timestamp + "\n" + request.Method + "\n" + request.URL.RequestURI() + "\n"))
+	mac.Write(body)
+
+	request.Header.Set(creds.TimestampHeader, timestamp)
+	request.Header.Set(creds.SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	if creds.KeyID != "" {
+		request.Header.Set(creds.KeyIDHeader, creds.KeyID)
+	}
+	return nil
+}
+
+// jwtKeys caches the parsed signing keys of the JWT credentials, keyed by
+// the profile name, so PEM keys are parsed once rather than for every request.
+var jwtKeys sync.Map
+
+// jwtKey returns the signing method and parsed key of the JWT credentials.
+func (s *requestSigner) jwtKey() (jwt.SigningMethod, interface{}, error) {
+	creds := s.creds.JWT
+	var method jwt.SigningMethod
+	switch creds.Algorithm {
+	case coreconfig.JWTAlgorithmHS256:
+		return jwt.SigningMethodHS256, []byte(creds.Key), nil
+	case coreconfig.JWTAlgorithmRS256:
+		method = jwt.SigningMethodRS256
+	case coreconfig.JWTAlgorithmES256:
+		method = jwt.SigningMethodES256
+	default:
+		return nil, nil, errors.Errorf("unsupported JWT algorithm %q", creds.Algorithm)
+	}
+
+	key, err := s.cached(&jwtKeys, s.name, func() (interface{}, error) {
+		var (
+			key interface{}
+			err error
+		)
+		if creds.Algorithm == coreconfig.JWTAlgorithmRS256 {
+			key, err = jwt.ParseRSAPrivateKeyFromPEM([]byte(creds.Key))
+		} else {
+			key, err = jwt.ParseECPrivateKeyFromPEM([]byte(creds.Key))
+		}
+		return key, err
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid JWT key")
+	}
+	return method, key, nil
+}
+
+// signJWT issues a token valid for the configured TTL.
+func (s *requestSigner) signJWT(now time.Time) (string, error) {
+	method, key, err := s.jwtKey()
+	if err != nil {
+		return "", err
+	}
+
+	creds := s.creds.JWT
+	claims := jwt.RegisteredClaims{
+		Issuer:    creds.Issuer,
+		Subject:   creds.Subject,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(creds.TTL)),
+		ID:        uuid.NewString(),
+	}
+	if creds.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{creds.Audience}
+	}
+	token := jwt.NewWithClaims(method, claims)
+	if creds.KeyID != "" {
+		token.Header["kid"] = creds.KeyID
+	}
+	return token.SignedString(key)
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	config "github.com/smartcontractkit/chainlink-common/pkg/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -112,6 +113,99 @@ func (_c *Config_DefaultHTTPTimeout_Call) RunAndReturn(run func() config.Duratio
 	return _c
 }
 
+// HTTPCredentials provides a mock function with given fields: name
+func (_m *Config) HTTPCredentials(name string) *coreconfig.HTTPCredentials {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCredentials")
+	}
+
+	var r0 *coreconfig.HTTPCredentials
+	if rf, ok := ret.Get(0).(func(string) *coreconfig.HTTPCredentials); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coreconfig.HTTPCredentials)
+		}
+	}
+
+	return r0
+}
+
+// Config_HTTPCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCredentials'
+type Config_HTTPCredentials_Call struct {
+	*mock.Call
+}
+
+// HTTPCredentials is a helper method to define mock.On call
+//   - name string
+func (_e *Config_Expecter) HTTPCredentials(name interface{}) *Config_HTTPCredentials_Call {
+	return &Config_HTTPCredentials_Call{Call: _e.mock.On("HTTPCredentials", name)}
+}
+
+func (_c *Config_HTTPCredentials_Call) Run(run func(name string)) *Config_HTTPCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCredentials_Call) Return(_a0 *coreconfig.HTTPCredentials) *Config_HTTPCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCredentials_Call) RunAndReturn(run func(string) *coreconfig.HTTPCredentials) *Config_HTTPCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxConcurrentRuns provides a mock function with no fields
+func (_m *Config) MaxConcurrentRuns() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxConcurrentRuns")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_MaxConcurrentRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxConcurrentRuns'
+type Config_MaxConcurrentRuns_Call struct {
+	*mock.Call
+}
+
+// MaxConcurrentRuns is a helper method to define mock.On call
+func (_e *Config_Expecter) MaxConcurrentRuns() *Config_MaxConcurrentRuns_Call {
+	return &Config_MaxConcurrentRuns_Call{Call: _e.mock.On("MaxConcurrentRuns")}
+}
+
+func (_c *Config_MaxConcurrentRuns_Call) Run(run func()) *Config_MaxConcurrentRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MaxConcurrentRuns_Call) Return(_a0 uint32) *Config_MaxConcurrentRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_MaxConcurrentRuns_Call) RunAndReturn(run func() uint32) *Config_MaxConcurrentRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MaxRunDuration provides a mock function with no fields
 func (_m *Config) MaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -157,6 +251,51 @@ func (_c *Config_MaxRunDuration_Call) RunAndReturn(run func() time.Duration) *Co
 	return _c
 }
 
+// MaxRunsPerMinute provides a mock function with no fields
+func (_m *Config) MaxRunsPerMinute() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRunsPerMinute")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_MaxRunsPerMinute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRunsPerMinute'
+type Config_MaxRunsPerMinute_Call struct {
+	*mock.Call
+}
+
+// MaxRunsPerMinute is a helper method to define mock.On call
+func (_e *Config_Expecter) MaxRunsPerMinute() *Config_MaxRunsPerMinute_Call {
+	return &Config_MaxRunsPerMinute_Call{Call: _e.mock.On("MaxRunsPerMinute")}
+}
+
+func (_c *Config_MaxRunsPerMinute_Call) Run(run func()) *Config_MaxRunsPerMinute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MaxRunsPerMinute_Call) Return(_a0 uint32) *Config_MaxRunsPerMinute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_MaxRunsPerMinute_Call) RunAndReturn(run func() uint32) *Config_MaxRunsPerMinute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReaperInterval provides a mock function with no fields
 func (_m *Config) ReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -247,6 +386,142 @@ func (_c *Config_ReaperThreshold_Call) RunAndReturn(run func() time.Duration) *C
 	return _c
 }
 
+// RunArchivePath provides a mock function with no fields
+func (_m *Config) RunArchivePath() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunArchivePath")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_RunArchivePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunArchivePath'
+type Config_RunArchivePath_Call struct {
+	*mock.Call
+}
+
+// RunArchivePath is a helper method to define mock.On call
+func (_e *Config_Expecter) RunArchivePath() *Config_RunArchivePath_Call {
+	return &Config_RunArchivePath_Call{Call: _e.mock.On("RunArchivePath")}
+}
+
+func (_c *Config_RunArchivePath_Call) Run(run func()) *Config_RunArchivePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_RunArchivePath_Call) Return(_a0 string) *Config_RunArchivePath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_RunArchivePath_Call) RunAndReturn(run func() string) *Config_RunArchivePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunLimitMode provides a mock function with no fields
+func (_m *Config) RunLimitMode() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunLimitMode")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_RunLimitMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunLimitMode'
+type Config_RunLimitMode_Call struct {
+	*mock.Call
+}
+
+// RunLimitMode is a helper method to define mock.On call
+func (_e *Config_Expecter) RunLimitMode() *Config_RunLimitMode_Call {
+	return &Config_RunLimitMode_Call{Call: _e.mock.On("RunLimitMode")}
+}
+
+func (_c *Config_RunLimitMode_Call) Run(run func()) *Config_RunLimitMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_RunLimitMode_Call) Return(_a0 string) *Config_RunLimitMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_RunLimitMode_Call) RunAndReturn(run func() string) *Config_RunLimitMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunRetention provides a mock function with given fields: jobType
+func (_m *Config) RunRetention(jobType string) coreconfig.RunRetention {
+	ret := _m.Called(jobType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunRetention")
+	}
+
+	var r0 coreconfig.RunRetention
+	if rf, ok := ret.Get(0).(func(string) coreconfig.RunRetention); ok {
+		r0 = rf(jobType)
+	} else {
+		r0 = ret.Get(0).(coreconfig.RunRetention)
+	}
+
+	return r0
+}
+
+// Config_RunRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRetention'
+type Config_RunRetention_Call struct {
+	*mock.Call
+}
+
+// RunRetention is a helper method to define mock.On call
+//   - jobType string
+func (_e *Config_Expecter) RunRetention(jobType interface{}) *Config_RunRetention_Call {
+	return &Config_RunRetention_Call{Call: _e.mock.On("RunRetention", jobType)}
+}
+
+func (_c *Config_RunRetention_Call) Run(run func(jobType string)) *Config_RunRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Config_RunRetention_Call) Return(_a0 coreconfig.RunRetention) *Config_RunRetention_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_RunRetention_Call) RunAndReturn(run func(string) coreconfig.RunRetention) *Config_RunRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerboseLogging provides a mock function with no fields
 func (_m *Config) VerboseLogging() bool {
 	ret := _m.Called()
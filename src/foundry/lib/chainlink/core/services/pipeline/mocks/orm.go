@@ -75,6 +75,65 @@ func (_c *ORM_Close_Call) RunAndReturn(run func() error) *ORM_Close_Call {
 	return _c
 }
 
+// CountRunErrors provides a mock function with given fields: ctx, filter
+func (_m *ORM) CountRunErrors(ctx context.Context, filter pipeline.RunFilter) ([]pipeline.RunErrorCount, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRunErrors")
+	}
+
+	var r0 []pipeline.RunErrorCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFilter) ([]pipeline.RunErrorCount, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFilter) []pipeline.RunErrorCount); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.RunErrorCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RunFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CountRunErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRunErrors'
+type ORM_CountRunErrors_Call struct {
+	*mock.Call
+}
+
+// CountRunErrors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter pipeline.RunFilter
+func (_e *ORM_Expecter) CountRunErrors(ctx interface{}, filter interface{}) *ORM_CountRunErrors_Call {
+	return &ORM_CountRunErrors_Call{Call: _e.mock.On("CountRunErrors", ctx, filter)}
+}
+
+func (_c *ORM_CountRunErrors_Call) Run(run func(ctx context.Context, filter pipeline.RunFilter)) *ORM_CountRunErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.RunFilter))
+	})
+	return _c
+}
+
+func (_c *ORM_CountRunErrors_Call) Return(_a0 []pipeline.RunErrorCount, _a1 error) *ORM_CountRunErrors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CountRunErrors_Call) RunAndReturn(run func(context.Context, pipeline.RunFilter) ([]pipeline.RunErrorCount, error)) *ORM_CountRunErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRun provides a mock function with given fields: ctx, run
 func (_m *ORM) CreateRun(ctx context.Context, run *pipeline.Run) error {
 	ret := _m.Called(ctx, run)
@@ -274,49 +333,60 @@ func (_c *ORM_DeleteRun_Call) RunAndReturn(run func(context.Context, int64) erro
 	return _c
 }
 
-// DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
-func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
-	ret := _m.Called(_a0, _a1)
+// FindJobRunRetentions provides a mock function with given fields: ctx
+func (_m *ORM) FindJobRunRetentions(ctx context.Context) ([]pipeline.JobRunRetention, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRunsOlderThan")
+		panic("no return value specified for FindJobRunRetentions")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 []pipeline.JobRunRetention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]pipeline.JobRunRetention, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []pipeline.JobRunRetention); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.JobRunRetention)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ORM_DeleteRunsOlderThan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRunsOlderThan'
-type ORM_DeleteRunsOlderThan_Call struct {
+// ORM_FindJobRunRetentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobRunRetentions'
+type ORM_FindJobRunRetentions_Call struct {
 	*mock.Call
 }
 
-// DeleteRunsOlderThan is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 time.Duration
-func (_e *ORM_Expecter) DeleteRunsOlderThan(_a0 interface{}, _a1 interface{}) *ORM_DeleteRunsOlderThan_Call {
-	return &ORM_DeleteRunsOlderThan_Call{Call: _e.mock.On("DeleteRunsOlderThan", _a0, _a1)}
+// FindJobRunRetentions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) FindJobRunRetentions(ctx interface{}) *ORM_FindJobRunRetentions_Call {
+	return &ORM_FindJobRunRetentions_Call{Call: _e.mock.On("FindJobRunRetentions", ctx)}
 }
 
-func (_c *ORM_DeleteRunsOlderThan_Call) Run(run func(_a0 context.Context, _a1 time.Duration)) *ORM_DeleteRunsOlderThan_Call {
+func (_c *ORM_FindJobRunRetentions_Call) Run(run func(ctx context.Context)) *ORM_FindJobRunRetentions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Duration))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *ORM_DeleteRunsOlderThan_Call) Return(_a0 error) *ORM_DeleteRunsOlderThan_Call {
-	_c.Call.Return(_a0)
+func (_c *ORM_FindJobRunRetentions_Call) Return(_a0 []pipeline.JobRunRetention, _a1 error) *ORM_FindJobRunRetentions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, time.Duration) error) *ORM_DeleteRunsOlderThan_Call {
+func (_c *ORM_FindJobRunRetentions_Call) RunAndReturn(run func(context.Context) ([]pipeline.JobRunRetention, error)) *ORM_FindJobRunRetentions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -378,6 +448,74 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// FindRuns provides a mock function with given fields: ctx, filter, offset, limit
+func (_m *ORM) FindRuns(ctx context.Context, filter pipeline.RunFilter, offset int, limit int) ([]pipeline.Run, int, error) {
+	ret := _m.Called(ctx, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRuns")
+	}
+
+	var r0 []pipeline.Run
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFilter, int, int) ([]pipeline.Run, int, error)); ok {
+		return rf(ctx, filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFilter, int, int) []pipeline.Run); ok {
+		r0 = rf(ctx, filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RunFilter, int, int) int); ok {
+		r1 = rf(ctx, filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.RunFilter, int, int) error); ok {
+		r2 = rf(ctx, filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_FindRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRuns'
+type ORM_FindRuns_Call struct {
+	*mock.Call
+}
+
+// FindRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter pipeline.RunFilter
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) FindRuns(ctx interface{}, filter interface{}, offset interface{}, limit interface{}) *ORM_FindRuns_Call {
+	return &ORM_FindRuns_Call{Call: _e.mock.On("FindRuns", ctx, filter, offset, limit)}
+}
+
+func (_c *ORM_FindRuns_Call) Run(run func(ctx context.Context, filter pipeline.RunFilter, offset int, limit int)) *ORM_FindRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.RunFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FindRuns_Call) Return(_a0 []pipeline.Run, _a1 int, _a2 error) *ORM_FindRuns_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_FindRuns_Call) RunAndReturn(run func(context.Context, pipeline.RunFilter, int, int) ([]pipeline.Run, int, error)) *ORM_FindRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
@@ -767,6 +905,65 @@ func (_c *ORM_Name_Call) RunAndReturn(run func() string) *ORM_Name_Call {
 	return _c
 }
 
+// PruneRuns provides a mock function with given fields: ctx, retentions, threshold, archive
+func (_m *ORM) PruneRuns(ctx context.Context, retentions []pipeline.JobRunRetention, threshold time.Duration, archive func([]*pipeline.Run) error) (int64, error) {
+	ret := _m.Called(ctx, retentions, threshold, archive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneRuns")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []pipeline.JobRunRetention, time.Duration, func([]*pipeline.Run) error) (int64, error)); ok {
+		return rf(ctx, retentions, threshold, archive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []pipeline.JobRunRetention, time.Duration, func([]*pipeline.Run) error) int64); ok {
+		r0 = rf(ctx, retentions, threshold, archive)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []pipeline.JobRunRetention, time.Duration, func([]*pipeline.Run) error) error); ok {
+		r1 = rf(ctx, retentions, threshold, archive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_PruneRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneRuns'
+type ORM_PruneRuns_Call struct {
+	*mock.Call
+}
+
+// PruneRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retentions []pipeline.JobRunRetention
+//   - threshold time.Duration
+//   - archive func([]*pipeline.Run) error
+func (_e *ORM_Expecter) PruneRuns(ctx interface{}, retentions interface{}, threshold interface{}, archive interface{}) *ORM_PruneRuns_Call {
+	return &ORM_PruneRuns_Call{Call: _e.mock.On("PruneRuns", ctx, retentions, threshold, archive)}
+}
+
+func (_c *ORM_PruneRuns_Call) Run(run func(ctx context.Context, retentions []pipeline.JobRunRetention, threshold time.Duration, archive func([]*pipeline.Run) error)) *ORM_PruneRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]pipeline.JobRunRetention), args[2].(time.Duration), args[3].(func([]*pipeline.Run) error))
+	})
+	return _c
+}
+
+func (_c *ORM_PruneRuns_Call) Return(_a0 int64, _a1 error) *ORM_PruneRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_PruneRuns_Call) RunAndReturn(run func(context.Context, []pipeline.JobRunRetention, time.Duration, func([]*pipeline.Run) error) (int64, error)) *ORM_PruneRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *ORM) Ready() error {
 	ret := _m.Called()
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	context "context"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,6 +28,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeCircuitBreakers provides a mock function with no fields
+func (_m *Runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeCircuitBreakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakers'
+type Runner_BridgeCircuitBreakers_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakers is a helper method to define mock.On call
+func (_e *Runner_Expecter) BridgeCircuitBreakers() *Runner_BridgeCircuitBreakers_Call {
+	return &Runner_BridgeCircuitBreakers_Call{Call: _e.mock.On("BridgeCircuitBreakers")}
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) Run(run func()) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) Return(_a0 *bridges.CircuitBreakers) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -71,6 +120,67 @@ func (_c *Runner_Close_Call) RunAndReturn(run func() error) *Runner_Close_Call {
 	return _c
 }
 
+// DryRun provides a mock function with given fields: ctx, spec, vars, mockOutputs
+func (_m *Runner) DryRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result) (*pipeline.DryRunResult, error) {
+	ret := _m.Called(ctx, spec, vars, mockOutputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRun")
+	}
+
+	var r0 *pipeline.DryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) (*pipeline.DryRunResult, error)); ok {
+		return rf(ctx, spec, vars, mockOutputs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) *pipeline.DryRunResult); ok {
+		r0 = rf(ctx, spec, vars, mockOutputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.DryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) error); ok {
+		r1 = rf(ctx, spec, vars, mockOutputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_DryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRun'
+type Runner_DryRun_Call struct {
+	*mock.Call
+}
+
+// DryRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - mockOutputs map[string]pipeline.Result
+func (_e *Runner_Expecter) DryRun(ctx interface{}, spec interface{}, vars interface{}, mockOutputs interface{}) *Runner_DryRun_Call {
+	return &Runner_DryRun_Call{Call: _e.mock.On("DryRun", ctx, spec, vars, mockOutputs)}
+}
+
+func (_c *Runner_DryRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, mockOutputs map[string]pipeline.Result)) *Runner_DryRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(map[string]pipeline.Result))
+	})
+	return _c
+}
+
+func (_c *Runner_DryRun_Call) Return(_a0 *pipeline.DryRunResult, _a1 error) *Runner_DryRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_DryRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.Result) (*pipeline.DryRunResult, error)) *Runner_DryRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteAndInsertFinishedRun provides a mock function with given fields: ctx, spec, vars, saveSuccessfulTaskRuns
 func (_m *Runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, saveSuccessfulTaskRuns bool) (int64, pipeline.TaskRunResults, error) {
 	ret := _m.Called(ctx, spec, vars, saveSuccessfulTaskRuns)
@@ -208,6 +318,39 @@ func (_c *Runner_ExecuteRun_Call) RunAndReturn(run func(context.Context, pipelin
 	return _c
 }
 
+// ForgetJob provides a mock function with given fields: jobID
+func (_m *Runner) ForgetJob(jobID int32) {
+	_m.Called(jobID)
+}
+
+// Runner_ForgetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgetJob'
+type Runner_ForgetJob_Call struct {
+	*mock.Call
+}
+
+// ForgetJob is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Runner_Expecter) ForgetJob(jobID interface{}) *Runner_ForgetJob_Call {
+	return &Runner_ForgetJob_Call{Call: _e.mock.On("ForgetJob", jobID)}
+}
+
+func (_c *Runner_ForgetJob_Call) Run(run func(jobID int32)) *Runner_ForgetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Runner_ForgetJob_Call) Return() *Runner_ForgetJob_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Runner_ForgetJob_Call) RunAndReturn(run func(int32)) *Runner_ForgetJob_Call {
+	_c.Run(run)
+	return _c
+}
+
 // HealthReport provides a mock function with no fields
 func (_m *Runner) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -534,6 +677,65 @@ func (_c *Runner_Ready_Call) RunAndReturn(run func() error) *Runner_Ready_Call {
 	return _c
 }
 
+// ReplayRun provides a mock function with given fields: ctx, runID
+func (_m *Runner) ReplayRun(ctx context.Context, runID int64) (*pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.ReplayResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*pipeline.ReplayResult, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *pipeline.ReplayResult); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.ReplayResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_ReplayRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRun'
+type Runner_ReplayRun_Call struct {
+	*mock.Call
+}
+
+// ReplayRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Runner_Expecter) ReplayRun(ctx interface{}, runID interface{}) *Runner_ReplayRun_Call {
+	return &Runner_ReplayRun_Call{Call: _e.mock.On("ReplayRun", ctx, runID)}
+}
+
+func (_c *Runner_ReplayRun_Call) Run(run func(ctx context.Context, runID int64)) *Runner_ReplayRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) Return(_a0 *pipeline.ReplayResult, _a1 error) *Runner_ReplayRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) RunAndReturn(run func(context.Context, int64) (*pipeline.ReplayResult, error)) *Runner_ReplayRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
@@ -27,6 +27,12 @@ type Spec struct {
 	GasLimit          *uint32         `json:"-"`
 	ForwardingAllowed bool            `json:"-"`
 
+	// MaxConcurrentRuns, MaxRunsPerMinute and RunLimitMode override the run
+	// limits of the job set by the JobPipeline config.
+	MaxConcurrentRuns *uint32 `json:"-" db:"-"`
+	MaxRunsPerMinute  *uint32 `json:"-" db:"-"`
+	RunLimitMode      string  `json:"-" db:"-"`
+
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
 	JobType string `json:"-"`
@@ -265,16 +271,17 @@ func (rr ResumeRequest) ToResult() (Result, error) {
 }
 
 type TaskRun struct {
-	ID            uuid.UUID                         `json:"id"`
-	Type          TaskType                          `json:"type"`
-	PipelineRun   Run                               `json:"-"`
-	PipelineRunID int64                             `json:"-"`
-	Output        jsonserializable.JSONSerializable `json:"output"`
-	Error         null.String                       `json:"error"`
-	CreatedAt     time.Time                         `json:"createdAt"`
-	FinishedAt    null.Time                         `json:"finishedAt"`
-	Index         int32                             `json:"index"`
-	DotID         string                            `json:"dotId"`
+	ID              uuid.UUID                         `json:"id"`
+	Type            TaskType                          `json:"type"`
+	PipelineRun     Run                               `json:"-"`
+	PipelineRunID   int64                             `json:"-"`
+	Output          jsonserializable.JSONSerializable `json:"output"`
+	Error           null.String                       `json:"error"`
+	CreatedAt       time.Time                         `json:"createdAt"`
+	FinishedAt      null.Time                         `json:"finishedAt"`
+	Index           int32                             `json:"index"`
+	DotID           string                            `json:"dotId"`
+	ParentTaskRunID uuid.NullUUID                     `json:"parentTaskRunId"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -297,6 +304,11 @@ func (tr TaskRun) GetDotID() string {
 	return tr.DotID
 }
 
+// IsIteration returns true if the task run was executed by an iteration of a foreach task.
+func (tr TaskRun) IsIteration() bool {
+	return tr.ParentTaskRunID.Valid
+}
+
 func (tr TaskRun) Result() Result {
 	var result Result
 	if !tr.Error.IsZero() {
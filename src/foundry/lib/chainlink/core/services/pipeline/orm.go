@@ -4,16 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"sync"
-	"sync/atomic"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -90,8 +92,11 @@ type ORM interface {
 	// If saveSuccessfulTaskRuns is false, only errored runs are saved.
 	InsertFinishedRuns(ctx context.Context, run []*Run, saveSuccessfulTaskRuns bool) (err error)
 
-	DeleteRunsOlderThan(context.Context, time.Duration) error
+	FindJobRunRetentions(ctx context.Context) ([]JobRunRetention, error)
+	PruneRuns(ctx context.Context, retentions []JobRunRetention, threshold time.Duration, archive func([]*Run) error) (int64, error)
 	FindRun(ctx context.Context, id int64) (Run, error)
+	FindRuns(ctx context.Context, filter RunFilter, offset, limit int) ([]Run, int, error)
+	CountRunErrors(ctx context.Context, filter RunFilter) ([]RunErrorCount, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
@@ -105,10 +110,6 @@ type orm struct {
 	ds                sqlutil.DataSource
 	lggr              logger.Logger
 	maxSuccessfulRuns uint64
-	// jobID => count
-	pm     sync.Map
-	wg     sync.WaitGroup
-	stopCh services.StopChan
 }
 
 var _ ORM = (*orm)(nil)
@@ -118,7 +119,6 @@ func NewORM(ds sqlutil.DataSource, lggr logger.Logger, jobPipelineMaxSuccessfulR
 		ds:                ds,
 		lggr:              lggr.Named("PipelineORM"),
 		maxSuccessfulRuns: jobPipelineMaxSuccessfulRuns,
-		stopCh:            make(chan struct{}),
 	}
 }
 
@@ -136,11 +136,7 @@ func (o *orm) Start(_ context.Context) error {
 }
 
 func (o *orm) Close() error {
-	return o.StopOnce("PipelineORM", func() error {
-		close(o.stopCh)
-		o.wg.Wait()
-		return nil
-	})
+	return o.StopOnce("PipelineORM", func() error { return nil })
 }
 
 func (o *orm) Name() string {
@@ -176,7 +172,6 @@ func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
 		ds:                ds,
 		lggr:              o.lggr,
 		maxSuccessfulRuns: o.maxSuccessfulRuns,
-		stopCh:            make(chan struct{}),
 	}
 }
 
@@ -188,10 +183,26 @@ func (o *orm) CreateSpec(ctx context.Context, pipeline Pipeline, maxTaskDuration
 	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
 	VALUES ($1, $2, NOW())
 	RETURNING id;`
-	err = o.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration)
+	err = o.transact(ctx, func(tx *orm) error {
+		if err = tx.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration); err != nil {
+			return err
+		}
+		return insertSpecBridges(ctx, tx.ds, id, &pipeline)
+	})
 	return id, errors.WithStack(err)
 }
 
+// insertSpecBridges records the bridges called by the pipeline of spec id,
+// for the runs to be filtered by bridge.
+func insertSpecBridges(ctx context.Context, ds sqlutil.DataSource, id int32, p *Pipeline) error {
+	names := p.BridgeNames()
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := ds.ExecContext(ctx, `INSERT INTO pipeline_spec_bridges (pipeline_spec_id, bridge_name) SELECT $1, unnest($2::text[])`, id, pq.Array(names))
+	return errors.Wrap(err, "failed to insert pipeline_spec_bridges")
+}
+
 func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 	if run.CreatedAt.IsZero() {
 		return errors.New("run.CreatedAt must be set")
@@ -212,8 +223,8 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, parent_task_run_id, type, index, output, error, dot_id, created_at)
+		VALUES (:pipeline_run_id, :id, :parent_task_run_id, :type, :index, :output, :error, :dot_id, :created_at);`
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -223,9 +234,6 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 
 // InsertRun inserts a run into the database
 func (o *orm) InsertRun(ctx context.Context, run *Run) error {
-	if run.Status() == RunStatusCompleted {
-		defer o.prune(ctx, o.ds, run.PruningKey)
-	}
 	query, args, err := o.ds.BindNamed(`INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
 		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
 		RETURNING *;`, run)
@@ -280,7 +288,6 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 				return fmt.Errorf("failed to update pipeline run %d to %s: %w", run.ID, run.State, err)
 			}
 		} else {
-			defer o.prune(ctx, tx.ds, run.PruningKey)
 			// Simply finish the run, no need to do any sort of locking
 			if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
 				return fmt.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
@@ -292,8 +299,8 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, parent_task_run_id, type, index, output, error, dot_id, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :parent_task_run_id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
 		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
 		RETURNING *;
@@ -384,23 +391,15 @@ RETURNING id
 			return errors.Wrap(err, "inserting finished pipeline runs")
 		}
 
-		pruningKeysm := make(map[int32]struct{})
 		for i, run := range runs {
-			pruningKeysm[run.PruningKey] = struct{}{}
 			for j := range run.PipelineTaskRuns {
 				run.PipelineTaskRuns[j].PipelineRunID = runIDs[i]
 			}
 		}
 
-		defer func() {
-			for pruningKey := range pruningKeysm {
-				o.prune(ctx, tx.ds, pruningKey)
-			}
-		}()
-
 		pipelineTaskRunsQuery := `
-INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
+INSERT INTO pipeline_task_runs (pipeline_run_id, id, parent_task_run_id, type, index, output, error, dot_id, created_at, finished_at)
+VALUES (:pipeline_run_id, :id, :parent_task_run_id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
@@ -469,6 +468,11 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 		if err != nil {
 			return errors.Wrap(err, "failed to insert pipeline_specs")
 		}
+		if p, perr := Parse(run.PipelineSpec.DotDagSource); perr == nil {
+			if err = insertSpecBridges(ctx, tx.ds, run.PipelineSpecID, p); err != nil {
+				return err
+			}
+		}
 		// This `job_pipeline_specs` record won't be primary since when this method is called, the job already exists, so it will have primary record.
 		sqlStmt2 := `INSERT INTO job_pipeline_specs (job_id, pipeline_spec_id, is_primary) VALUES ($1, $2, false)`
 		_, err = tx.ds.ExecContext(ctx, sqlStmt2, run.JobID, run.PipelineSpecID)
@@ -503,67 +507,145 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		return nil
 	}
 
-	defer o.prune(ctx, o.ds, run.PruningKey)
 	sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, parent_task_run_id, type, index, output, error, dot_id, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :parent_task_run_id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
 	_, err = o.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
 
-// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
+// JobRunRetention is the retention policy of the runs of a job.
+type JobRunRetention struct {
+	JobID   int32
+	JobType string
+	config.RunRetention
+}
+
+// FindJobRunRetentions returns the retention policies of the runs of all jobs,
+// as set by their specs.
+func (o *orm) FindJobRunRetentions(ctx context.Context) ([]JobRunRetention, error) {
+	var rows []struct {
+		ID                         int32
+		Type                       string
+		RetentionMaxSuccessfulRuns *int64
+		RetentionKeepFailedFor     models.Interval
+		RetentionKeepTags          pq.StringArray
+	}
+	err := o.ds.SelectContext(ctx, &rows, `SELECT id, type, retention_max_successful_runs, retention_keep_failed_for, retention_keep_tags FROM jobs ORDER BY id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load job run retentions")
+	}
+	retentions := make([]JobRunRetention, len(rows))
+	for i, row := range rows {
+		r := JobRunRetention{JobID: row.ID, JobType: row.Type}
+		if row.RetentionMaxSuccessfulRuns != nil {
+			n := uint64(*row.RetentionMaxSuccessfulRuns)
+			r.MaxSuccessfulRuns = &n
+		}
+		if !row.RetentionKeepFailedFor.IsZero() {
+			d := row.RetentionKeepFailedFor.Duration()
+			r.KeepFailedFor = &d
+		}
+		r.KeepTags = row.RetentionKeepTags
+		retentions[i] = r
+	}
+	return retentions, nil
+}
+
+// PruneRuns deletes the finished runs of the jobs of retentions which their
+// policy doesn't keep, and the runs of the other jobs which finished before
+// threshold. The successful runs of the jobs of retentions are capped to the
+// latest JobPipeline.MaxSuccessfulRuns. Runs are passed to archive with their task runs once their
+// deletion is committed, unless it is nil. It returns the number of runs deleted.
 // Caller is expected to set timeout on calling context.
-func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
+func (o *orm) PruneRuns(ctx context.Context, retentions []JobRunRetention, threshold time.Duration, archive func([]*Run) error) (int64, error) {
 	start := time.Now()
+	before := start.Add(-threshold)
 
-	queryThreshold := start.Add(-threshold)
-
-	rowsDeleted := int64(0)
+	jobIDs := make([]int32, len(retentions))
+	for i, r := range retentions {
+		jobIDs[i] = r.JobID
+	}
+	rowsDeleted, err := o.pruneRunsWhere(ctx, archive, `finished_at < $1 AND pruning_key <> ALL($2)`, before, jobIDs)
+	if err != nil {
+		return rowsDeleted, err
+	}
 
-	err := pg.Batch(func(_, limit uint) (count uint, err error) {
-		result, err := o.ds.ExecContext(ctx, `
-WITH batched_pipeline_runs AS (
-	SELECT * FROM pipeline_runs
-	WHERE finished_at < ($1)
-	ORDER BY finished_at ASC
-	LIMIT $2
-)
-DELETE FROM pipeline_runs
-USING batched_pipeline_runs
-WHERE pipeline_runs.id = batched_pipeline_runs.id`,
-			queryThreshold,
-			limit,
-		)
-		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to delete old pipeline_runs")
+	for _, r := range retentions {
+		// Without a limit of their own, successful runs are kept until the threshold
+		successful := `(finished_at < $3 OR id NOT IN (SELECT id FROM pipeline_runs WHERE pruning_key = $1 AND state = 'completed' ORDER BY id DESC LIMIT $5))`
+		maxSuccessful := o.maxSuccessfulRuns
+		if r.MaxSuccessfulRuns != nil {
+			successful = `id NOT IN (SELECT id FROM pipeline_runs WHERE pruning_key = $1 AND state = 'completed' ORDER BY id DESC LIMIT $5)`
+			maxSuccessful = min(maxSuccessful, *r.MaxSuccessfulRuns)
 		}
-
-		rowsAffected, err := result.RowsAffected()
+		failedBefore := before
+		if r.KeepFailedFor != nil {
+			failedBefore = start.Add(-*r.KeepFailedFor)
+		}
+		n, err := o.pruneRunsWhere(ctx, archive, `pruning_key = $1 AND finished_at IS NOT NULL
+	AND NOT COALESCE(jsonb_exists_any(meta->'tags', $2), false)
+	AND ((state = 'completed' AND `+successful+`) OR (state = 'errored' AND finished_at < $4))`,
+			r.JobID, []string(r.KeepTags), before, failedBefore, maxSuccessful)
+		rowsDeleted += n
 		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
+			return rowsDeleted, errors.Wrapf(err, "failed to prune runs of job %d", r.JobID)
 		}
-		rowsDeleted += rowsAffected
-
-		return uint(rowsAffected), err
-	})
-	if err != nil {
-		return errors.Wrap(err, "DeleteRunsOlderThan failed")
 	}
 
 	deleteTS := time.Now()
-
-	o.lggr.Debugw("pipeline_runs reaper DELETE query completed", "rowsDeleted", rowsDeleted, "duration", deleteTS.Sub(start))
-	defer func(start time.Time) {
-		o.lggr.Debugw("pipeline_runs reaper VACUUM ANALYZE query completed", "duration", time.Since(start))
-	}(deleteTS)
-
-	_, err = o.ds.ExecContext(ctx, "VACUUM ANALYZE pipeline_runs")
-	if err != nil {
-		o.lggr.Warnw("DeleteRunsOlderThan successfully deleted old pipeline_runs rows, but failed to run VACUUM ANALYZE", "err", err)
-		return nil
+	o.lggr.Debugw("pipeline_runs reaper DELETE queries completed", "rowsDeleted", rowsDeleted, "duration", deleteTS.Sub(start))
+	if _, err = o.ds.ExecContext(ctx, "VACUUM ANALYZE pipeline_runs"); err != nil {
+		o.lggr.Warnw("PruneRuns successfully deleted pipeline_runs rows, but failed to run VACUUM ANALYZE", "err", err)
 	}
+	return rowsDeleted, nil
+}
 
-	return nil
+// pruneRunsWhere deletes the runs matching where in batches, archiving each
+// batch once its deletion is committed unless archive is nil.
+func (o *orm) pruneRunsWhere(ctx context.Context, archive func([]*Run) error, where string, args ...any) (int64, error) {
+	rowsDeleted := int64(0)
+	query := fmt.Sprintf(`SELECT * FROM pipeline_runs WHERE %s ORDER BY id ASC LIMIT $%d`, where, len(args)+1)
+	err := pg.Batch(func(_, limit uint) (count uint, err error) {
+		var runs []*Run
+		err = o.transact(ctx, func(tx *orm) error {
+			if err = tx.ds.SelectContext(ctx, &runs, query, append(args, limit)...); err != nil {
+				return errors.Wrap(err, "failed to load runs")
+			}
+			if len(runs) == 0 {
+				return nil
+			}
+			if archive != nil {
+				if err = loadAssociations(ctx, tx.ds, runs); err != nil {
+					return err
+				}
+			}
+			ids := make([]int64, len(runs))
+			for i, run := range runs {
+				ids[i] = run.ID
+			}
+			result, err := tx.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = ANY($1)`, ids)
+			if err != nil {
+				return errors.Wrap(err, "failed to delete runs")
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return errors.Wrap(err, "failed to get rows affected")
+			}
+			rowsDeleted += rowsAffected
+			return nil
+		})
+		if err != nil || len(runs) == 0 {
+			return 0, err
+		}
+		if archive != nil {
+			if err = archive(runs); err != nil {
+				return 0, errors.Wrap(err, "failed to archive runs")
+			}
+		}
+		return uint(len(runs)), nil
+	})
+	return rowsDeleted, err
 }
 
 func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
@@ -580,6 +662,133 @@ func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+// RunFilter selects pipeline runs, its zero fields don't filter.
+type RunFilter struct {
+	JobID  *int32
+	States []RunStatus
+	// Since and Until bound the creation time of the runs.
+	Since, Until time.Time
+	// Error is a case-insensitive substring of an error of the runs.
+	Error string
+	// FailedTaskType is the type of a task which errored in the runs.
+	FailedTaskType TaskType
+	// Bridge is the name of a bridge called by the pipeline of the runs.
+	Bridge string
+	// MinOutput and MaxOutput bound a numeric output of the runs.
+	MinOutput, MaxOutput *decimal.Decimal
+}
+
+// RunErrorCount is the number of runs which failed with an error.
+type RunErrorCount struct {
+	Error string
+	Count int64
+}
+
+// maxRunErrorCounts is the number of most frequent errors counted.
+const maxRunErrorCounts = 100
+
+// where returns the conditions on pipeline_runs pr selecting the runs of f.
+func (f RunFilter) where() (string, []any) {
+	conds := []string{"TRUE"}
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if f.JobID != nil {
+		conds = append(conds, "pr.pruning_key = "+arg(*f.JobID))
+	}
+	if len(f.States) > 0 {
+		states := make([]string, len(f.States))
+		for i, s := range f.States {
+			states[i] = string(s)
+		}
+		conds = append(conds, "pr.state = ANY("+arg(states)+")")
+	}
+	if !f.Since.IsZero() {
+		conds = append(conds, "pr.created_at >= "+arg(f.Since))
+	}
+	if !f.Until.IsZero() {
+		conds = append(conds, "pr.created_at < "+arg(f.Until))
+	}
+	if f.Error != "" {
+		search := arg(f.Error)
+		if !needsJSONEscape(f.Error) {
+			// the text of the errors array contains the search as is, which
+			// skips unpacking the errors of most runs
+			conds = append(conds, "strpos(lower(pr.all_errors::text), lower("+search+")) > 0")
+		}
+		conds = append(conds, `EXISTS (SELECT 1 FROM jsonb_array_elements_text(CASE WHEN jsonb_typeof(pr.all_errors) = 'array' THEN pr.all_errors ELSE '[]'::jsonb END) e WHERE strpos(lower(e), lower(`+search+`)) > 0)`)
+	}
+	if f.FailedTaskType != "" {
+		conds = append(conds, `EXISTS (SELECT 1 FROM pipeline_task_runs tr WHERE tr.pipeline_run_id = pr.id AND tr.type = `+arg(f.FailedTaskType)+` AND tr.error IS NOT NULL)`)
+	}
+	if f.Bridge != "" {
+		conds = append(conds, "pr.pipeline_spec_id IN (SELECT pipeline_spec_id FROM pipeline_spec_bridges WHERE bridge_name = "+arg(f.Bridge)+")")
+	}
+	if f.MinOutput != nil || f.MaxOutput != nil {
+		bounds := []string{"TRUE"}
+		if f.MinOutput != nil {
+			bounds = append(bounds, "o.value >= "+arg(f.MinOutput.String())+"::numeric")
+		}
+		if f.MaxOutput != nil {
+			bounds = append(bounds, "o.value <= "+arg(f.MaxOutput.String())+"::numeric")
+		}
+		conds = append(conds, `EXISTS (SELECT 1 FROM (
+	SELECT CASE
+		WHEN jsonb_typeof(e) = 'number' THEN (e #>> '{}')::numeric
+		WHEN jsonb_typeof(e) = 'string' AND e #>> '{}' ~ '^-?[0-9]+(\.[0-9]+)?$' THEN (e #>> '{}')::numeric
+	END AS value
+	FROM jsonb_array_elements(CASE WHEN jsonb_typeof(pr.outputs) = 'array' THEN pr.outputs ELSE '[]'::jsonb END) e
+) o WHERE `+strings.Join(bounds, " AND ")+`)`)
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// needsJSONEscape reports whether s is written differently in JSON text.
+func needsJSONEscape(s string) bool {
+	return strings.ContainsFunc(s, func(r rune) bool {
+		return r == '"' || r == '\\' || r < 0x20
+	})
+}
+
+// FindRuns returns a page of the runs selected by filter, latest first, with
+// the number of runs it selects.
+func (o *orm) FindRuns(ctx context.Context, filter RunFilter, offset, limit int) (runs []Run, count int, err error) {
+	err = o.transact(ctx, func(tx *orm) error {
+		where, args := filter.where()
+		if err = tx.ds.GetContext(ctx, &count, `SELECT count(*) FROM pipeline_runs pr WHERE `+where, args...); err != nil {
+			return errors.Wrap(err, "failed to count runs")
+		}
+		var ptrs []*Run
+		query := fmt.Sprintf(`SELECT pr.* FROM pipeline_runs pr WHERE %s ORDER BY pr.id DESC OFFSET $%d LIMIT $%d`, where, len(args)+1, len(args)+2)
+		if err = tx.ds.SelectContext(ctx, &ptrs, query, append(args, offset, limit)...); err != nil {
+			return errors.Wrap(err, "failed to load runs")
+		}
+		if err = loadAssociations(ctx, tx.ds, ptrs); err != nil {
+			return err
+		}
+		runs = make([]Run, len(ptrs))
+		for i, run := range ptrs {
+			runs[i] = *run
+		}
+		return nil
+	})
+	return runs, count, errors.Wrap(err, "FindRuns failed")
+}
+
+// CountRunErrors returns the number of runs selected by filter which failed
+// with each error, most frequent first.
+func (o *orm) CountRunErrors(ctx context.Context, filter RunFilter) (counts []RunErrorCount, err error) {
+	where, args := filter.where()
+	query := fmt.Sprintf(`SELECT e AS error, count(DISTINCT pr.id) AS count
+FROM pipeline_runs pr, jsonb_array_elements_text(CASE WHEN jsonb_typeof(pr.fatal_errors) = 'array' THEN pr.fatal_errors ELSE '[]'::jsonb END) e
+WHERE e IS NOT NULL AND %s
+GROUP BY e ORDER BY count DESC, e LIMIT %d`, where, maxRunErrorCounts)
+	err = o.ds.SelectContext(ctx, &counts, query, args...)
+	return counts, errors.Wrap(err, "CountRunErrors failed")
+}
+
 func (o *orm) GetAllRuns(ctx context.Context) (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
@@ -678,93 +887,3 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 
 	return nil
 }
-
-func (o *orm) loadCount(jobID int32) *atomic.Uint64 {
-	// fast path; avoids allocation
-	actual, exists := o.pm.Load(jobID)
-	if exists {
-		return actual.(*atomic.Uint64)
-	}
-	// "slow" path
-	actual, _ = o.pm.LoadOrStore(jobID, new(atomic.Uint64))
-	return actual.(*atomic.Uint64)
-}
-
-// Runs will be pruned async on a sampled basis if maxSuccessfulRuns is set to
-// this value or higher
-const syncLimit = 1000
-
-// prune attempts to keep the pipeline_runs table capped close to the
-// maxSuccessfulRuns length for each job_id.
-//
-// It does this synchronously for small values and async/sampled for large
-// values.
-//
-// Note this does not guarantee the pipeline_runs table is kept to exactly the
-// max length, rather that it doesn't excessively larger than it.
-func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
-	if jobID == 0 {
-		o.lggr.Panic("expected a non-zero job ID")
-	}
-	// For small maxSuccessfulRuns its fast enough to prune every time
-	if o.maxSuccessfulRuns < syncLimit {
-		o.withDataSource(tx).execPrune(ctx, jobID)
-		return
-	}
-	// for large maxSuccessfulRuns we do it async on a sampled basis
-	every := o.maxSuccessfulRuns / 20 // it can get up to 5% larger than maxSuccessfulRuns before a prune
-	cnt := o.loadCount(jobID)
-	val := cnt.Add(1)
-	if val%every == 0 {
-		ok := o.IfStarted(func() {
-			o.wg.Add(1)
-			go func(ctx context.Context) {
-				o.lggr.Debugw("Pruning runs", "jobID", jobID, "count", val, "every", every, "maxSuccessfulRuns", o.maxSuccessfulRuns)
-				defer o.wg.Done()
-				ctx, cancel := o.stopCh.CtxCancel(context.WithTimeout(sqlutil.WithoutDefaultTimeout(ctx), time.Minute))
-				defer cancel()
-
-				// Must not use tx here since it could be stale by the time we execute async.
-				o.execPrune(ctx, jobID)
-			}(context.WithoutCancel(ctx)) // don't propagate cancellation
-		})
-		if !ok {
-			o.lggr.Warnw("Cannot prune: ORM is not running", "jobID", jobID)
-			return
-		}
-	}
-}
-
-func (o *orm) execPrune(ctx context.Context, jobID int32) {
-	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE pruning_key = $1 AND state = $2 AND id NOT IN (
-SELECT id FROM pipeline_runs
-WHERE pruning_key = $1 AND state = $2
-ORDER BY id DESC
-LIMIT $3
-)`, jobID, RunStatusCompleted, o.maxSuccessfulRuns)
-	if err != nil {
-		o.lggr.Errorw("Failed to prune runs", "err", err, "jobID", jobID)
-		return
-	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		o.lggr.Errorw("Failed to get RowsAffected while pruning runs", "err", err, "jobID", jobID)
-		return
-	}
-	if rowsAffected == 0 {
-		// check the spec still exists and garbage collect if necessary
-		var exists bool
-		if err := o.ds.GetContext(ctx, &exists, `SELECT EXISTS(SELECT ps.* FROM pipeline_specs ps JOIN job_pipeline_specs jps ON (ps.id=jps.pipeline_spec_id) WHERE jps.job_id = $1)`, jobID); err != nil {
-			o.lggr.Errorw("Failed check existence of pipeline_spec while pruning runs", "err", err, "jobID", jobID)
-			return
-		}
-		if !exists {
-			o.lggr.Debugw("Pipeline spec no longer exists, removing prune count", "jobID", jobID)
-			o.pm.Delete(jobID)
-		}
-	} else if o.maxSuccessfulRuns < syncLimit {
-		o.lggr.Tracew("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
-	} else {
-		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
-	}
-}
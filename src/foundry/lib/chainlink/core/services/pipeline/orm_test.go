@@ -2,10 +2,12 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -598,7 +601,7 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	require.Error(t, err, "not found")
 }
 
-func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
+func Test_PipelineORM_PruneRunsOlderThan(t *testing.T) {
 	ctx := testutils.Context(t)
 	_, orm, jorm := setupHeavyORM(t)
 
@@ -634,7 +637,7 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 		runsIds = append(runsIds, run.ID)
 	}
 
-	err := orm.DeleteRunsOlderThan(testutils.Context(t), 1*time.Second)
+	_, err := orm.PruneRuns(testutils.Context(t), nil, 1*time.Second, nil)
 	assert.NoError(t, err)
 
 	for _, runId := range runsIds {
@@ -643,6 +646,186 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 	}
 }
 
+func Test_PipelineORM_PruneRuns(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, jorm := setupHeavyORM(t)
+
+	running := mustInsertAsyncRun(t, orm, jorm)
+	insertRun := func(state pipeline.RunStatus, age time.Duration, tags ...string) int64 {
+		finishedAt := time.Now().Add(-age)
+		run := &pipeline.Run{
+			PipelineSpecID: running.PipelineSpecID,
+			PruningKey:     running.PruningKey,
+			State:          state,
+			Outputs:        jsonserializable.JSONSerializable{Val: 1, Valid: true},
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			CreatedAt:      finishedAt,
+			FinishedAt:     null.TimeFrom(finishedAt),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.New(),
+				Type:       pipeline.TaskTypeMedian,
+				DotID:      "answer1",
+				Output:     jsonserializable.JSONSerializable{Val: 1, Valid: true},
+				CreatedAt:  finishedAt,
+				FinishedAt: null.TimeFrom(finishedAt),
+			}},
+		}
+		if state == pipeline.RunStatusErrored {
+			run.AllErrors = pipeline.RunErrors{null.StringFrom("boom")}
+			run.FatalErrors = pipeline.RunErrors{null.StringFrom("boom")}
+		}
+		if len(tags) > 0 {
+			run.Meta = jsonserializable.JSONSerializable{Val: map[string]interface{}{"tags": tags}, Valid: true}
+		}
+		require.NoError(t, orm.InsertFinishedRun(ctx, run, true))
+		return run.ID
+	}
+
+	oldSuccessful := insertRun(pipeline.RunStatusCompleted, 48*time.Hour)
+	previousSuccessful := insertRun(pipeline.RunStatusCompleted, time.Hour)
+	lastSuccessful := insertRun(pipeline.RunStatusCompleted, time.Hour)
+	oldFailed := insertRun(pipeline.RunStatusErrored, 48*time.Hour)
+	recentFailed := insertRun(pipeline.RunStatusErrored, time.Hour)
+	taggedFailed := insertRun(pipeline.RunStatusErrored, 48*time.Hour, "audit")
+
+	retentions, err := orm.FindJobRunRetentions(ctx)
+	require.NoError(t, err)
+	require.Len(t, retentions, 1)
+	assert.Equal(t, pipeline.JobRunRetention{JobID: running.PruningKey, JobType: string(job.DirectRequest)}, retentions[0])
+
+	keepFailedFor := 24 * time.Hour
+	retentions[0].RunRetention = config.RunRetention{
+		MaxSuccessfulRuns: ptr[uint64](1),
+		KeepFailedFor:     &keepFailedFor,
+		KeepTags:          []string{"audit"},
+	}
+	var archived []int64
+	deleted, err := orm.PruneRuns(ctx, retentions, 30*time.Minute, func(runs []*pipeline.Run) error {
+		for _, run := range runs {
+			assert.Len(t, run.PipelineTaskRuns, 1)
+			archived = append(archived, run.ID)
+			// runs are archived once their deletion is committed
+			_, err := orm.FindRun(ctx, run.ID)
+			assert.ErrorIs(t, err, sql.ErrNoRows)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.ElementsMatch(t, []int64{oldSuccessful, previousSuccessful, oldFailed}, archived)
+
+	for _, id := range archived {
+		_, err = orm.FindRun(ctx, id)
+		require.Error(t, err, "not found")
+	}
+	for _, id := range []int64{running.ID, lastSuccessful, recentFailed, taggedFailed} {
+		_, err = orm.FindRun(ctx, id)
+		require.NoError(t, err)
+	}
+
+	// without a policy, all runs finished before the threshold are pruned
+	deleted, err = orm.PruneRuns(ctx, nil, 30*time.Minute, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	_, err = orm.FindRun(ctx, running.ID)
+	require.NoError(t, err)
+}
+
+func Test_PipelineORM_FindRuns(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, jorm := setupHeavyORM(t)
+
+	running := mustInsertAsyncRun(t, orm, jorm)
+	insertRun := func(age time.Duration, output interface{}, failedTask pipeline.TaskType, errString string) int64 {
+		createdAt := time.Now().Add(-age)
+		run := &pipeline.Run{
+			PipelineSpecID: running.PipelineSpecID,
+			PruningKey:     running.PruningKey,
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        jsonserializable.JSONSerializable{Val: []interface{}{output}, Valid: true},
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			CreatedAt:      createdAt,
+			FinishedAt:     null.TimeFrom(createdAt),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.New(),
+				Type:       pipeline.TaskTypeMedian,
+				DotID:      "answer1",
+				Output:     jsonserializable.JSONSerializable{Val: output, Valid: true},
+				CreatedAt:  createdAt,
+				FinishedAt: null.TimeFrom(createdAt),
+			}},
+		}
+		if errString != "" {
+			run.State = pipeline.RunStatusErrored
+			run.Outputs = jsonserializable.JSONSerializable{Val: []interface{}{nil}, Valid: true}
+			run.AllErrors = pipeline.RunErrors{null.StringFrom(errString)}
+			run.FatalErrors = pipeline.RunErrors{null.StringFrom(errString)}
+			run.PipelineTaskRuns[0].Type = failedTask
+			run.PipelineTaskRuns[0].Output = jsonserializable.JSONSerializable{}
+			run.PipelineTaskRuns[0].Error = null.StringFrom(errString)
+		}
+		require.NoError(t, orm.InsertFinishedRun(ctx, run, true))
+		return run.ID
+	}
+
+	low := insertRun(3*time.Hour, "10", "", "")
+	high := insertRun(time.Hour, 1000, "", "")
+	timedOut := insertRun(3*time.Hour, nil, pipeline.TaskTypeHTTP, "HTTP request timed out")
+	timedOutAgain := insertRun(time.Hour, nil, pipeline.TaskTypeHTTP, "http request timed out")
+	unreachable := insertRun(time.Hour, nil, pipeline.TaskTypeBridge, "bridge unreachable")
+
+	find := func(filter pipeline.RunFilter) []int64 {
+		runs, count, err := orm.FindRuns(ctx, filter, 0, 10)
+		require.NoError(t, err)
+		ids := make([]int64, len(runs))
+		for i, run := range runs {
+			ids[i] = run.ID
+			assert.Equal(t, running.PipelineSpecID, run.PipelineSpec.ID)
+		}
+		assert.Equal(t, len(runs), count)
+		return ids
+	}
+	dec := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+
+	assert.Equal(t, []int64{unreachable, timedOutAgain, timedOut, high, low, running.ID}, find(pipeline.RunFilter{JobID: &running.PruningKey}))
+	assert.Empty(t, find(pipeline.RunFilter{JobID: ptr(running.PruningKey + 1)}))
+	assert.Equal(t, []int64{unreachable, timedOutAgain, timedOut}, find(pipeline.RunFilter{States: []pipeline.RunStatus{pipeline.RunStatusErrored}}))
+	assert.Equal(t, []int64{running.ID}, find(pipeline.RunFilter{States: []pipeline.RunStatus{pipeline.RunStatusRunning}}))
+	assert.Equal(t, []int64{unreachable, timedOutAgain, high, running.ID}, find(pipeline.RunFilter{Since: time.Now().Add(-2 * time.Hour)}))
+	assert.Equal(t, []int64{timedOut, low}, find(pipeline.RunFilter{Until: time.Now().Add(-2 * time.Hour)}))
+	assert.Equal(t, []int64{timedOutAgain, timedOut}, find(pipeline.RunFilter{Error: "Timed Out"}))
+	assert.Empty(t, find(pipeline.RunFilter{Error: `"`}), "the quotes of the errors JSON are not searched")
+	assert.Equal(t, []int64{unreachable}, find(pipeline.RunFilter{FailedTaskType: pipeline.TaskTypeBridge}))
+	assert.Equal(t, []int64{timedOutAgain}, find(pipeline.RunFilter{FailedTaskType: pipeline.TaskTypeHTTP, Since: time.Now().Add(-2 * time.Hour)}))
+	assert.Len(t, find(pipeline.RunFilter{Bridge: "election_winner"}), 6)
+	assert.Empty(t, find(pipeline.RunFilter{Bridge: "election"}))
+	assert.Equal(t, []int64{high, low}, find(pipeline.RunFilter{MinOutput: dec("5")}))
+	assert.Equal(t, []int64{low}, find(pipeline.RunFilter{MinOutput: dec("5"), MaxOutput: dec("100")}))
+
+	page, count, err := orm.FindRuns(ctx, pipeline.RunFilter{JobID: &running.PruningKey}, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 6, count)
+	require.Len(t, page, 2)
+	assert.Equal(t, timedOutAgain, page[0].ID)
+
+	counts, err := orm.CountRunErrors(ctx, pipeline.RunFilter{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []pipeline.RunErrorCount{
+		{Error: "HTTP request timed out", Count: 1},
+		{Error: "bridge unreachable", Count: 1},
+		{Error: "http request timed out", Count: 1},
+	}, counts)
+
+	counts, err = orm.CountRunErrors(ctx, pipeline.RunFilter{Error: "unreachable"})
+	require.NoError(t, err)
+	assert.Equal(t, []pipeline.RunErrorCount{{Error: "bridge unreachable", Count: 1}}, counts)
+}
+
 func Test_GetUnfinishedRuns_Keepers(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -864,10 +1047,12 @@ func Test_Prune(t *testing.T) {
 
 	t.Run("when there are no runs to prune, does nothing", func(t *testing.T) {
 		ctx := tests.Context(t)
-		porm.Prune(ctx, jobID)
+		deleted, err := porm.PruneRuns(ctx, []pipeline.JobRunRetention{{JobID: jobID}}, time.Hour, nil)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
 
 		// no error logs; it did nothing
-		assert.Empty(t, observed.All())
+		assert.Empty(t, observed.FilterLevelExact(zapcore.ErrorLevel).All())
 	})
 
 	_, err = db.Exec(`SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
@@ -900,7 +1085,9 @@ func Test_Prune(t *testing.T) {
 		cltest.MustInsertPipelineRunWithStatus(t, db, ps2.ID, pipeline.RunStatusSuspended, jobID2)
 	}
 
-	porm.Prune(tests.Context(t), jobID2)
+	// without a policy of its own, the successful runs of a job are capped to MaxSuccessfulRuns
+	_, err = porm.PruneRuns(tests.Context(t), []pipeline.JobRunRetention{{JobID: jobID2}}, time.Hour, nil)
+	require.NoError(t, err)
 
 	cnt := pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2", ps1.ID, pipeline.RunStatusCompleted)
 	assert.Equal(t, 20, cnt)
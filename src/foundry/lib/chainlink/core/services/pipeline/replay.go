@@ -0,0 +1,154 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+var ErrReplayMissingTaskRun = errors.New("run has no recorded result for task")
+
+// recordedTaskTypes are the task types whose results depend on the outside
+// world (network, chain state, key material or randomness). During a replay
+// they are never executed; their recorded results are used instead.
+var recordedTaskTypes = map[TaskType]struct{}{
+	TaskTypeAny:              {},
+	TaskTypeBridge:           {},
+	TaskTypeETHCall:          {},
+	TaskTypeETHTx:            {},
+	TaskTypeEstimateGasLimit: {},
+	TaskTypeForEach:          {},
+	TaskTypeHTTP:             {},
+	TaskTypeVRF:              {},
+	TaskTypeVRFV2:            {},
+	TaskTypeVRFV2Plus:        {},
+}
+
+// ReplayTaskRun compares the recorded and recomputed result of a single task.
+type ReplayTaskRun struct {
+	DotID          string                            `json:"dotId"`
+	Type           TaskType                          `json:"type"`
+	Recorded       bool                              `json:"recorded"`
+	RecordedOutput jsonserializable.JSONSerializable `json:"recordedOutput"`
+	RecordedError  null.String                       `json:"recordedError"`
+	ReplayedOutput jsonserializable.JSONSerializable `json:"replayedOutput"`
+	ReplayedError  null.String                       `json:"replayedError"`
+	Matches        bool                              `json:"matches"`
+}
+
+// ReplayResult is the outcome of re-executing a stored run.
+type ReplayResult struct {
+	RunID           int64                             `json:"runId"`
+	JobID           int32                             `json:"jobId"`
+	RecordedOutputs jsonserializable.JSONSerializable `json:"recordedOutputs"`
+	ReplayedOutputs jsonserializable.JSONSerializable `json:"replayedOutputs"`
+	TaskRuns        []ReplayTaskRun                   `json:"taskRuns"`
+	Matches         bool                              `json:"matches"`
+}
+
+// stubTask stands in for a task that must not be executed, returning a fixed
+// result instead, e.g. the result recorded in the original run of a replay.
+type stubTask struct {
+	Task
+	result Result
+}
+
+func (t *stubTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	return t.result, RunInfo{}
+}
+
+// ReplayRun re-executes a finished run from its recorded task runs. Tasks
+// with external dependencies return their recorded results, all other tasks
+// are executed again and their results compared with the recorded ones.
+// Nothing is persisted.
+func (r *runner) ReplayRun(ctx context.Context, runID int64) (*ReplayResult, error) {
+	recorded, err := r.orm.FindRun(ctx, runID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load run %d", runID)
+	}
+	if !recorded.FinishedAt.Valid {
+		return nil, errors.Errorf("run %d has not finished", runID)
+	}
+
+	// always re-parse, the recorded spec must not share state with live runs
+	spec := recorded.PipelineSpec
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := make(map[Task]Task)
+	for _, task := range p.Tasks {
+		if _, isRecorded := recordedTaskTypes[task.Type()]; !isRecorded {
+			continue
+		}
+		taskRun := recorded.ByDotID(task.DotID())
+		if taskRun == nil {
+			return nil, errors.Wrapf(ErrReplayMissingTaskRun, "%s (%s); only runs with stored task runs can be replayed", task.DotID(), task.Type())
+		}
+		replacements[task] = &stubTask{Task: task, result: taskRun.Result()}
+	}
+	p.replaceTasks(replacements)
+
+	var vars Vars
+	if inputs, ok := recorded.Inputs.Val.(map[string]interface{}); ok {
+		vars = NewVarsFrom(inputs)
+	} else {
+		vars = NewVarsFrom(nil)
+	}
+
+	replay := NewRun(spec, vars)
+	trrs := r.runUnrecorded(ctx, p, replay, vars)
+
+	result := &ReplayResult{
+		RunID:           recorded.ID,
+		JobID:           recorded.PipelineSpec.JobID,
+		RecordedOutputs: recorded.Outputs,
+		ReplayedOutputs: replay.Outputs,
+		Matches:         sameJSON(recorded.Outputs, replay.Outputs),
+	}
+	for _, trr := range trrs {
+		_, isRecorded := trr.Task.(*stubTask)
+		taskRun := ReplayTaskRun{
+			DotID:          trr.Task.DotID(),
+			Type:           trr.Task.Type(),
+			Recorded:       isRecorded,
+			ReplayedOutput: trr.Result.OutputDB(),
+			ReplayedError:  trr.Result.ErrorDB(),
+		}
+		// successful task runs are not always stored, in which case only the
+		// run outputs can be compared
+		if recordedTaskRun := recorded.ByDotID(trr.Task.DotID()); recordedTaskRun != nil {
+			taskRun.RecordedOutput = recordedTaskRun.Output
+			taskRun.RecordedError = recordedTaskRun.Error
+			taskRun.Matches = taskRun.RecordedError == taskRun.ReplayedError && sameJSON(taskRun.RecordedOutput, taskRun.ReplayedOutput)
+			if !taskRun.Matches {
+				result.Matches = false
+			}
+		}
+		result.TaskRuns = append(result.TaskRuns, taskRun)
+	}
+	return result, nil
+}
+
+// sameJSON reports whether both values have the same JSON representation,
+// which is how outputs are stored in the database.
+func sameJSON(a, b jsonserializable.JSONSerializable) bool {
+	var decodedA, decodedB interface{}
+	bytesA, errA := json.Marshal(a)
+	bytesB, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	if json.Unmarshal(bytesA, &decodedA) != nil || json.Unmarshal(bytesB, &decodedB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
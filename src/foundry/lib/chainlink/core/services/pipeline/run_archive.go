@@ -0,0 +1,97 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// archivedRun is the archived record of a pruned run.
+type archivedRun struct {
+	ID             int64                             `json:"id"`
+	JobID          int32                             `json:"jobID"`
+	PipelineSpecID int32                             `json:"pipelineSpecID"`
+	State          RunStatus                         `json:"state"`
+	Meta           jsonserializable.JSONSerializable `json:"meta"`
+	Inputs         jsonserializable.JSONSerializable `json:"inputs"`
+	Outputs        jsonserializable.JSONSerializable `json:"outputs"`
+	AllErrors      RunErrors                         `json:"allErrors"`
+	FatalErrors    RunErrors                         `json:"fatalErrors"`
+	CreatedAt      time.Time                         `json:"createdAt"`
+	FinishedAt     null.Time                         `json:"finishedAt"`
+	TaskRuns       []TaskRun                         `json:"taskRuns"`
+}
+
+// runArchive writes the runs pruned by a pass of the run reaper to a gzip
+// compressed file of JSON lines, created in its directory on the first write.
+type runArchive struct {
+	dir string
+	f   *os.File
+	gz  *gzip.Writer
+	enc *json.Encoder
+}
+
+func newRunArchive(dir string) *runArchive {
+	return &runArchive{dir: dir}
+}
+
+func (a *runArchive) write(runs []*Run) error {
+	if a.f == nil {
+		if err := utils.EnsureDirAndMaxPerms(a.dir, 0o700); err != nil {
+			return err
+		}
+		name := filepath.Join(a.dir, fmt.Sprintf("pipeline_runs-%s.jsonl.gz", time.Now().UTC().Format("20060102T150405.000000000Z")))
+		f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return err
+		}
+		a.f = f
+		a.gz = gzip.NewWriter(f)
+		a.enc = json.NewEncoder(a.gz)
+	}
+	for _, run := range runs {
+		err := a.enc.Encode(archivedRun{
+			ID:             run.ID,
+			JobID:          run.PruningKey,
+			PipelineSpecID: run.PipelineSpecID,
+			State:          run.State,
+			Meta:           run.Meta,
+			Inputs:         run.Inputs,
+			Outputs:        run.Outputs,
+			AllErrors:      run.AllErrors,
+			FatalErrors:    run.FatalErrors,
+			CreatedAt:      run.CreatedAt,
+			FinishedAt:     run.FinishedAt,
+			TaskRuns:       run.PipelineTaskRuns,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// flush so that the runs are archived before they are deleted
+	if err := a.gz.Flush(); err != nil {
+		return err
+	}
+	return a.f.Sync()
+}
+
+// Close completes the archive file, if any runs were written.
+func (a *runArchive) Close() error {
+	if a.f == nil {
+		return nil
+	}
+	err := a.gz.Close()
+	if cerr := a.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
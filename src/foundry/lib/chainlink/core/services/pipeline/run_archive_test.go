@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestRunArchive(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "archive")
+	a := newRunArchive(dir)
+	require.NoError(t, a.Close())
+	_, err := os.Stat(dir)
+	require.True(t, os.IsNotExist(err), "nothing is archived without runs")
+
+	now := time.Now().UTC()
+	require.NoError(t, a.write([]*Run{
+		{ID: 1, PruningKey: 7, State: RunStatusCompleted, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+		{ID: 2, PruningKey: 7, State: RunStatusErrored, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+	}))
+	require.NoError(t, a.write([]*Run{{ID: 3, PruningKey: 8, State: RunStatusCompleted, CreatedAt: now}}))
+	require.NoError(t, a.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "pipeline_runs-*.jsonl.gz"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	var runs []archivedRun
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var run archivedRun
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &run))
+		runs = append(runs, run)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, runs, 3)
+	assert.Equal(t, int64(1), runs[0].ID)
+	assert.Equal(t, int32(7), runs[0].JobID)
+	assert.Equal(t, RunStatusErrored, runs[1].State)
+	assert.Equal(t, int32(8), runs[2].JobID)
+}
@@ -0,0 +1,193 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// ErrRunRejected is returned for runs rejected because their job exceeded its
+// run limits, with the limit in the message.
+var ErrRunRejected = errors.New("run rejected")
+
+var promPipelineRunsThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pipeline_runs_throttled",
+	Help: "Number of pipeline runs queued or rejected because their job exceeded its run limits",
+},
+	[]string{"job_id", "job_name", "limit", "action"},
+)
+
+// runLimits are the limits of the runs of a job, zero values mean no limit.
+type runLimits struct {
+	maxConcurrent uint32
+	maxPerMinute  uint32
+	drop          bool
+}
+
+func (l runLimits) unlimited() bool {
+	return l.maxConcurrent == 0 && l.maxPerMinute == 0
+}
+
+// runLimitsFor returns the limits of the runs of spec, where the limits of the
+// job override the defaults of cfg.
+func runLimitsFor(cfg Config, spec Spec) runLimits {
+	l := runLimits{
+		maxConcurrent: cfg.MaxConcurrentRuns(),
+		maxPerMinute:  cfg.MaxRunsPerMinute(),
+		drop:          cfg.RunLimitMode() == config.RunLimitModeDrop,
+	}
+	if spec.MaxConcurrentRuns != nil {
+		l.maxConcurrent = *spec.MaxConcurrentRuns
+	}
+	if spec.MaxRunsPerMinute != nil {
+		l.maxPerMinute = *spec.MaxRunsPerMinute
+	}
+	if spec.RunLimitMode != "" {
+		l.drop = spec.RunLimitMode == config.RunLimitModeDrop
+	}
+	return l
+}
+
+// rejectedRunInterval is the minimum interval between the rejected runs of a
+// job that are stored, the others are only counted by
+// pipeline_runs_throttled.
+const rejectedRunInterval = time.Minute
+
+// jobRunLimiter enforces the run limits of a job.
+type jobRunLimiter struct {
+	specID int32
+	limits runLimits
+	slots  chan struct{}
+	rate   *rate.Limiter
+
+	// lastStored is when the last rejected run was stored, and omitted the
+	// number of runs rejected since which were not.
+	lastStored time.Time
+	omitted    int
+}
+
+func newJobRunLimiter(specID int32, limits runLimits) *jobRunLimiter {
+	l := &jobRunLimiter{specID: specID, limits: limits}
+	if limits.maxConcurrent > 0 {
+		l.slots = make(chan struct{}, limits.maxConcurrent)
+	}
+	if limits.maxPerMinute > 0 {
+		l.rate = rate.NewLimiter(rate.Every(time.Minute/time.Duration(limits.maxPerMinute)), int(limits.maxPerMinute))
+	}
+	return l
+}
+
+// acquire admits a run, waiting until it is within the limits unless they drop
+// the runs exceeding them. The returned func releases the run once it
+// finished.
+func (l *jobRunLimiter) acquire(ctx context.Context, spec Spec) (func(), error) {
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			throttled(spec, "concurrency", l.limits.drop)
+			if l.limits.drop {
+				return nil, fmt.Errorf("%w: job reached its limit of %d concurrent runs", ErrRunRejected, l.limits.maxConcurrent)
+			}
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		release = func() { <-l.slots }
+	}
+
+	if l.rate != nil && !l.rate.Allow() {
+		throttled(spec, "rate", l.limits.drop)
+		if l.limits.drop {
+			release()
+			return nil, fmt.Errorf("%w: job reached its limit of %d runs per minute", ErrRunRejected, l.limits.maxPerMinute)
+		}
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+func throttled(spec Spec, limit string, drop bool) {
+	action := "queued"
+	if drop {
+		action = "dropped"
+	}
+	promPipelineRunsThrottled.WithLabelValues(strconv.Itoa(int(spec.JobID)), spec.JobName, limit, action).Inc()
+}
+
+// runLimiter enforces the run limits of each job.
+type runLimiter struct {
+	cfg Config
+
+	mu   sync.Mutex
+	jobs map[int32]*jobRunLimiter
+}
+
+func newRunLimiter(cfg Config) *runLimiter {
+	return &runLimiter{cfg: cfg, jobs: make(map[int32]*jobRunLimiter)}
+}
+
+// acquire admits a new run of spec, see jobRunLimiter.acquire. Runs which
+// don't belong to a job are not limited.
+func (r *runLimiter) acquire(ctx context.Context, spec Spec) (func(), error) {
+	if spec.JobID == 0 {
+		return func() {}, nil
+	}
+	limits := runLimitsFor(r.cfg, spec)
+
+	r.mu.Lock()
+	l, ok := r.jobs[spec.JobID]
+	if !ok || l.specID != spec.ID || l.limits != limits {
+		// the job was created or updated
+		l = newJobRunLimiter(spec.ID, limits)
+		r.jobs[spec.JobID] = l
+	}
+	r.mu.Unlock()
+
+	if limits.unlimited() {
+		return func() {}, nil
+	}
+	return l.acquire(ctx, spec)
+}
+
+// remove forgets the limiter of the job, the runs it admitted are released
+// as usual.
+func (r *runLimiter) remove(jobID int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.jobs, jobID)
+}
+
+// storeRejected reports whether a run of the job rejected at now should be
+// stored, at most one every rejectedRunInterval, with the number of rejected
+// runs which were not stored since the previous one.
+func (r *runLimiter) storeRejected(jobID int32, now time.Time) (store bool, omitted int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.jobs[jobID]
+	if !ok {
+		return true, 0
+	}
+	if now.Sub(l.lastStored) < rejectedRunInterval {
+		l.omitted++
+		return false, 0
+	}
+	omitted, l.omitted = l.omitted, 0
+	l.lastStored = now
+	return true, omitted
+}
@@ -15,6 +15,8 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -53,8 +55,20 @@ type Runner interface {
 	// This will persist the Spec in the DB if it doesn't have an ID.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, saveSuccessfulTaskRuns bool) (runID int64, results TaskRunResults, err error)
 
+	// ReplayRun re-executes a stored run in-memory, substituting the recorded results of tasks with external
+	// dependencies (http, bridge, ethcall, ...), and compares the recomputed results with the recorded ones.
+	ReplayRun(ctx context.Context, runID int64) (*ReplayResult, error)
+	// DryRun executes a new run in-memory without side effects: tasks listed in mockOutputs return the given
+	// results instead of being executed and ethtx tasks only record the transaction they would have sent.
+	DryRun(ctx context.Context, spec Spec, vars Vars, mockOutputs map[string]Result) (*DryRunResult, error)
+
 	OnRunFinished(func(*Run))
+	// ForgetJob drops the run limits kept for the job, once it was deleted
+	// or updated.
+	ForgetJob(jobID int32)
 	InitializePipeline(spec Spec) (*Pipeline, error)
+	// BridgeCircuitBreakers returns the circuit breakers of the bridges used by bridge tasks.
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
 }
 
 type runner struct {
@@ -70,6 +84,10 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *bridges.CircuitBreakers
+	bridgeRoundRobin       *bridges.RoundRobin
+	tracer                 trace.Tracer
+	limiter                *runLimiter
 
 	// test helper
 	runFinished func(*Run)
@@ -135,6 +153,10 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		circuitBreakers:        bridges.NewCircuitBreakers(),
+		bridgeRoundRobin:       bridges.NewRoundRobin(),
+		tracer:                 newTracer(nil),
+		limiter:                newRunLimiter(cfg),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -185,6 +207,10 @@ func (r *runner) Name() string {
 func (r *runner) HealthReport() map[string]error {
 	runnerHealth := map[string]error{r.Name(): r.Healthy()}
 
+	for name, err := range r.circuitBreakers.HealthReport() {
+		runnerHealth[r.Name()+"."+name] = err
+	}
+
 	service, isService := r.btORM.(services.HealthReporter)
 	if !isService {
 		return runnerHealth
@@ -195,6 +221,10 @@ func (r *runner) HealthReport() map[string]error {
 	return runnerHealth
 }
 
+func (r *runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return r.circuitBreakers
+}
+
 func (r *runner) destroy() {
 	err := r.runReaperWorker.Stop()
 	if err != nil {
@@ -245,16 +275,45 @@ func NewRun(spec Spec, vars Vars) *Run {
 		PruningKey:     spec.JobID,
 		PipelineSpec:   spec,
 		PipelineSpecID: spec.ID,
+		Meta:           runMeta(vars),
 		Inputs:         jsonserializable.JSONSerializable{Val: vars.vars, Valid: true},
 		Outputs:        jsonserializable.JSONSerializable{Val: nil, Valid: false},
 		CreatedAt:      time.Now(),
 	}
 }
 
+// runMeta returns the meta of a run, which records the tags of the run set by
+// $(jobRun.meta.tags) for the run retention policies.
+func runMeta(vars Vars) jsonserializable.JSONSerializable {
+	v, err := vars.Get("jobRun.meta.tags")
+	if err != nil {
+		return jsonserializable.JSONSerializable{}
+	}
+	var tags []string
+	switch v := v.(type) {
+	case []string:
+		tags = v
+	case []interface{}:
+		for _, tag := range v {
+			if s, ok := tag.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+	if len(tags) == 0 {
+		return jsonserializable.JSONSerializable{}
+	}
+	return jsonserializable.JSONSerializable{Val: map[string]interface{}{"tags": tags}, Valid: true}
+}
+
 func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) ForgetJob(jobID int32) {
+	r.limiter.remove(jobID)
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -291,6 +350,12 @@ func overtimeContext(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	release, err := r.limiter.acquire(ctx, spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -307,7 +372,6 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 		// assume if set that it has been pre-initialized
 		pipeline = spec.Pipeline
 	} else {
-		var err error
 		pipeline, err = r.InitializePipeline(spec)
 		if err != nil {
 			return nil, nil, err
@@ -330,6 +394,13 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		return
 	}
 
+	r.initializeTasks(pipeline, spec)
+
+	return pipeline, nil
+}
+
+// initializeTasks injects runner dependencies into the tasks of the given pipeline.
+func (r *runner) initializeTasks(pipeline *Pipeline, spec Spec) {
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.New()
@@ -349,6 +420,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.circuitBreakers
+			task.(*BridgeTask).roundRobin = r.bridgeRoundRobin
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -370,19 +443,39 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeForEach:
+			task.(*ForEachTask).runner = r
+			task.(*ForEachTask).spec = spec
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
+// run executes a run of the job of its spec, which is recorded in the metrics
+// and the traces of the job.
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+	return r.execute(ctx, pipeline, run, vars, true)
+}
+
+// runUnrecorded executes the pipeline like run, without recording it in the
+// metrics and the traces of the job. Replays and dry runs are not runs of the
+// job, and the iterations of foreach tasks are part of the run of their task.
+func (r *runner) runUnrecorded(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+	return r.execute(ctx, pipeline, run, vars, false)
+}
+
+func (r *runner) execute(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, record bool) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	if r.config.VerboseLogging() {
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
+	if record {
+		var span trace.Span
+		ctx, span = startRunSpan(ctx, r.tracer, run)
+		defer endRunSpan(span, run)
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -400,9 +493,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l, record)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if record {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -429,7 +524,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Set(float64(runTime))
+		if record {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -448,6 +545,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			FinishedAt:    result.FinishedAt,
 			task:          result.Task,
 		})
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, iterationTaskRuns(run, result, result.Task.DotID())...)
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
 			if run.PipelineTaskRuns[i].task.OutputIndex() == run.PipelineTaskRuns[j].task.OutputIndex() {
@@ -463,6 +561,10 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		var fatalErrors []null.String
 		var outputs []interface{}
 		for _, result := range run.PipelineTaskRuns {
+			// iteration errors are already reported by their foreach task
+			if result.IsIteration() {
+				continue
+			}
 			if result.Error.Valid {
 				errors = append(errors, result.Error)
 			}
@@ -479,7 +581,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Inc()
+			if record {
+				PromPipelineRunErrors.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -537,7 +641,35 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+// iterationTaskRuns flattens the task runs executed by each iteration of a
+// foreach task, and of the foreach tasks nested in them. Their dot IDs are
+// prefixed with the dot ID of their parent and the iteration index, e.g.
+// "fe.2.fetch" or "fe.2.inner.0.fetch".
+func iterationTaskRuns(run *Run, parent TaskRunResult, parentDotID string) []TaskRun {
+	var taskRuns []TaskRun
+	for i, iteration := range parent.Iterations() {
+		for _, result := range iteration {
+			dotID := fmt.Sprintf("%s.%d.%s", parentDotID, i, result.Task.DotID())
+			taskRuns = append(taskRuns, TaskRun{
+				ID:              result.ID,
+				PipelineRunID:   run.ID,
+				ParentTaskRunID: uuid.NullUUID{UUID: parent.ID, Valid: true},
+				Type:            result.Task.Type(),
+				Index:           result.Task.OutputIndex(),
+				Output:          result.Result.OutputDB(),
+				Error:           result.Result.ErrorDB(),
+				DotID:           dotID,
+				CreatedAt:       result.CreatedAt,
+				FinishedAt:      result.FinishedAt,
+				task:            result.Task,
+			})
+			taskRuns = append(taskRuns, iterationTaskRuns(run, result, dotID)...)
+		}
+	}
+	return taskRuns
+}
+
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger, record bool) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
@@ -564,7 +696,14 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	var span trace.Span
+	if record {
+		ctx, span = startTaskSpan(ctx, r.tracer, taskRun)
+	}
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	if record {
+		endTaskSpan(span, result, runInfo)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -617,6 +756,9 @@ func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 // ExecuteAndInsertFinishedRun executes a run in memory then inserts the finished run/task run records, returning the final result
 func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, saveSuccessfulTaskRuns bool) (runID int64, results TaskRunResults, err error) {
 	run, trrs, err := r.ExecuteRun(ctx, spec, vars)
+	if pkgerrors.Is(err, ErrRunRejected) && spec.ID != 0 {
+		r.insertRejectedRun(ctx, NewRun(spec, vars), err)
+	}
 	if err != nil {
 		return 0, trrs, pkgerrors.Wrapf(err, "error executing run for spec ID %v", spec.ID)
 	}
@@ -638,6 +780,18 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool, fn func(tx sqlutil.DataSource) error) (incomplete bool, err error) {
+	// resumed runs were admitted when they started
+	if run.ID == 0 {
+		release, err := r.limiter.acquire(ctx, run.PipelineSpec)
+		if err != nil {
+			if pkgerrors.Is(err, ErrRunRejected) {
+				r.insertRejectedRun(ctx, run, err)
+			}
+			return false, err
+		}
+		defer release()
+	}
+
 	pipeline, err := r.InitializePipeline(run.PipelineSpec)
 	if err != nil {
 		return false, err
@@ -645,6 +799,9 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if taskRun.IsIteration() {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
@@ -730,6 +887,26 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 	}
 }
 
+// insertRejectedRun stores run as errored with the reason it was rejected, so
+// that it is listed with the other runs of its job. A job exceeding its limits
+// can have many runs rejected, only one every rejectedRunInterval is stored.
+func (r *runner) insertRejectedRun(ctx context.Context, run *Run, reason error) {
+	store, omitted := r.limiter.storeRejected(run.PipelineSpec.JobID, time.Now())
+	if !store {
+		return
+	}
+	if omitted > 0 {
+		reason = fmt.Errorf("%w, %d more runs were rejected since the previous one", reason, omitted)
+	}
+	run.State = RunStatusErrored
+	run.FatalErrors = RunErrors{null.StringFrom(reason.Error())}
+	run.AllErrors = run.FatalErrors
+	run.FinishedAt = null.TimeFrom(time.Now())
+	if err := r.orm.InsertRun(ctx, run); err != nil {
+		r.lggr.Errorw("Failed to store rejected run", "jobID", run.JobID, "err", err)
+	}
+}
+
 func (r *runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	run, start, err := r.orm.UpdateTaskRunResult(ctx, taskID, Result{
 		Value: value,
@@ -776,13 +953,35 @@ func (r *runner) runReaper() {
 	ctx, cancel := r.chStop.CtxWithTimeout(r.config.ReaperInterval())
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.ReaperThreshold())
+	deleted, err := r.pruneRuns(ctx)
 	if err != nil {
 		r.lggr.Errorw("Pipeline run reaper failed", "err", err)
 		r.SvcErrBuffer.Append(err)
 	} else {
-		r.lggr.Debugw("Pipeline run reaper completed successfully")
+		r.lggr.Debugw("Pipeline run reaper completed successfully", "rowsDeleted", deleted)
+	}
+}
+
+// pruneRuns deletes the finished runs which the retention policy of their job
+// doesn't keep, archiving them if an archive path is configured. Jobs without
+// a policy keep their runs for the reaper threshold, up to MaxSuccessfulRuns
+// successful runs. It is the only path deleting finished runs.
+func (r *runner) pruneRuns(ctx context.Context) (deleted int64, err error) {
+	retentions, err := r.orm.FindJobRunRetentions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i, jr := range retentions {
+		retentions[i].RunRetention = r.config.RunRetention(jr.JobType).Merge(jr.RunRetention)
+	}
+
+	var archive func([]*Run) error
+	if path := r.config.RunArchivePath(); path != "" {
+		a := newRunArchive(path)
+		defer func() { err = multierr.Append(err, a.Close()) }()
+		archive = a.write
 	}
+	return r.orm.PruneRuns(ctx, retentions, r.config.ReaperThreshold(), archive)
 }
 
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
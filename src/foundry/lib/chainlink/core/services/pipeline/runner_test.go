@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -134,6 +138,21 @@ ds5 [type=http method="GET" url="%s" index=2]
 	require.Len(t, errorResults, 3)
 }
 
+func Test_NewRun_Tags(t *testing.T) {
+	t.Parallel()
+
+	run := pipeline.NewRun(pipeline.Spec{}, pipeline.NewVarsFrom(nil))
+	assert.False(t, run.Meta.Valid)
+
+	run = pipeline.NewRun(pipeline.Spec{}, pipeline.NewVarsFrom(map[string]interface{}{
+		"jobRun": map[string]interface{}{
+			"meta": map[string]interface{}{"tags": []interface{}{"audit", 1, "daily"}},
+		},
+	}))
+	require.True(t, run.Meta.Valid)
+	assert.Equal(t, map[string]interface{}{"tags": []string{"audit", "daily"}}, run.Meta.Val)
+}
+
 func Test_PipelineRunner_ExecuteEthAbiDecode(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewTestGeneralConfig(t)
@@ -644,6 +663,284 @@ a->b2;`,
 	assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_ForEach(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+
+	const spec = `
+fe [type=foreach items="$(vals)" parallelism=2 %s pipeline=<
+	d [type=divide input="$(numerator)" divisor="$(item)"];
+	m [type=multiply input="$(d)" times="$(index)"];
+	d -> m;
+>];
+`
+
+	t.Run("runs the sub-pipeline for each item", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"numerator": 12, "vals": []interface{}{1, 2, 3, 4}})
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: fmt.Sprintf(spec, "")}, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		values := result.Value.([]interface{})
+		require.Len(t, values, 4)
+		for i, want := range []string{"0", "6", "8", "9"} {
+			assert.Equal(t, want, values[i].(decimal.Decimal).String())
+		}
+
+		iterations := trrs[0].Iterations()
+		require.Len(t, iterations, 4)
+		for _, iteration := range iterations {
+			require.Len(t, iteration, 2)
+		}
+
+		// every iteration task run is stored under the foreach task run
+		require.Len(t, run.PipelineTaskRuns, 9)
+		parent := run.ByDotID("fe")
+		require.NotNil(t, parent)
+		assert.False(t, parent.IsIteration())
+		for i := 0; i < 4; i++ {
+			for _, dotID := range []string{"d", "m"} {
+				tr := run.ByDotID(fmt.Sprintf("fe.%d.%s", i, dotID))
+				require.NotNil(t, tr)
+				assert.True(t, tr.IsIteration())
+				assert.Equal(t, parent.ID, tr.ParentTaskRunID.UUID)
+			}
+		}
+		assert.Len(t, run.AllErrors, 0)
+	})
+
+	t.Run("fails when an iteration fails", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"numerator": 12, "vals": []interface{}{1, 0, 3}})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: fmt.Sprintf(spec, "")}, vars)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Error, pipeline.ErrTooManyErrors)
+		require.ErrorContains(t, result.Error, "iteration 1")
+	})
+
+	t.Run("tolerates allowed faults", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"numerator": 12, "vals": []interface{}{1, 0, 3}})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: fmt.Sprintf(spec, "allowedFaults=1")}, vars)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.NoError(t, result.Error)
+		values := result.Value.([]interface{})
+		require.Len(t, values, 3)
+		assert.Equal(t, "0", values[0].(decimal.Decimal).String())
+		assert.Nil(t, values[1])
+		assert.Equal(t, "8", values[2].(decimal.Decimal).String())
+	})
+
+	t.Run("flattens nested foreach tasks", func(t *testing.T) {
+		const nested = `
+fe [type=foreach items="$(rows)" pipeline=<
+	inner [type=foreach items="$(item)" pipeline=<m [type=multiply input="$(item)" times=2]>];
+>];
+`
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"rows": []interface{}{[]interface{}{1, 2}, []interface{}{3}}})
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: nested}, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+
+		require.Len(t, run.PipelineTaskRuns, 6)
+		for i, want := range []int{2, 1} {
+			inner := run.ByDotID(fmt.Sprintf("fe.%d.inner", i))
+			require.NotNil(t, inner)
+			assert.Equal(t, run.ByDotID("fe").ID, inner.ParentTaskRunID.UUID)
+			for j := 0; j < want; j++ {
+				tr := run.ByDotID(fmt.Sprintf("fe.%d.inner.%d.m", i, j))
+				require.NotNil(t, tr)
+				assert.True(t, tr.IsIteration())
+				assert.Equal(t, inner.ID, tr.ParentTaskRunID.UUID)
+			}
+		}
+	})
+
+	t.Run("empty items", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"numerator": 12, "vals": []interface{}{}})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: fmt.Sprintf(spec, "")}, vars)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.NoError(t, result.Error)
+		assert.Empty(t, result.Value)
+	})
+}
+
+func Test_PipelineRunner_DryRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+
+	// the http task points nowhere, it must be mocked
+	const spec = `
+ds1 [type=http method=GET url="http://unreachable.invalid"];
+parse [type=jsonparse path="data,result"];
+mult [type=multiply times=10];
+encode [type=ethabiencode abi="(uint256 value)" data=<{"value": $(mult)}>];
+submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode)" gasLimit=500000 evmChainID=0];
+ds1 -> parse -> mult -> encode -> submit;
+`
+	mockOutputs := map[string]pipeline.Result{
+		"ds1": {Value: `{"data":{"result":"42"}}`},
+	}
+
+	t.Run("runs with mocked tasks and records transactions", func(t *testing.T) {
+		result, err := r.DryRun(testutils.Context(t), pipeline.Spec{DotDagSource: spec}, pipeline.NewVarsFrom(nil), mockOutputs)
+		require.NoError(t, err)
+		require.Len(t, result.TaskRunResults, 5)
+		for _, trr := range result.TaskRunResults {
+			require.NoError(t, trr.Result.Error, trr.Task.DotID())
+			assert.True(t, trr.FinishedAt.Valid, trr.Task.DotID())
+			assert.False(t, trr.FinishedAt.Time.Before(trr.CreatedAt), trr.Task.DotID())
+		}
+
+		require.Len(t, result.Transactions, 1)
+		tx := result.Transactions[0]
+		assert.Equal(t, "submit", tx.DotID)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", tx.To)
+		assert.Equal(t, "0x00000000000000000000000000000000000000000000000000000000000001a4", tx.Data)
+		require.NotNil(t, tx.GasLimit)
+		assert.Equal(t, uint64(500000), *tx.GasLimit)
+		assert.NoError(t, result.Run.FatalErrors.ToError())
+	})
+
+	t.Run("mocked errors", func(t *testing.T) {
+		result, err := r.DryRun(testutils.Context(t), pipeline.Spec{DotDagSource: spec}, pipeline.NewVarsFrom(nil), map[string]pipeline.Result{
+			"ds1": {Error: errors.New("timeout")},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Transactions)
+		assert.True(t, result.Run.HasFatalErrors())
+	})
+
+	t.Run("rejects mocks for unknown tasks", func(t *testing.T) {
+		_, err := r.DryRun(testutils.Context(t), pipeline.Spec{DotDagSource: spec}, pipeline.NewVarsFrom(nil), map[string]pipeline.Result{
+			"nope": {Value: 1},
+		})
+		require.ErrorIs(t, err, pipeline.ErrDryRunUnknownTask)
+	})
+}
+
+func Test_PipelineRunner_DryRunBridgeSideEffects(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":{"result":"42"}}`)
+	}))
+	defer server.Close()
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil).Maybe()
+	// nothing was cached by the successful dry run, so the fallback reaches the database
+	btORM.On("GetCachedResponseWithFinished", mock.Anything, "fetch", int32(0), mock.Anything).Return(nil, time.Time{}, sql.ErrNoRows)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch [type=bridge name="%s" cacheTTL=60];
+parse [type=jsonparse path="data,result" data="$(fetch)"];
+fe [type=foreach items=<[1, 2]> pipeline=<inner [type=bridge name="%s"];>];
+`, bt.Name, bt.Name)}
+
+	result, err := r.DryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, err)
+	require.NoError(t, result.Run.FatalErrors.ToError())
+
+	failing.Store(true)
+	result, err = r.DryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, err)
+	assert.True(t, result.Run.HasFatalErrors())
+
+	_, ok := r.BridgeCircuitBreakers().State(bt.Name)
+	assert.False(t, ok, "dry runs must not use the circuit breakers of live runs")
+}
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+
+	// the http task points nowhere, replays must never execute it
+	const spec = `
+ds1 [type=http method=GET url="http://unreachable.invalid"];
+parse [type=jsonparse path="data,result"];
+mult [type=multiply times=10];
+ds1 -> parse -> mult;
+`
+	recordedRun := func(multOutput string) pipeline.Run {
+		return pipeline.Run{
+			ID:           42,
+			PipelineSpec: pipeline.Spec{ID: 1, JobID: 7, DotDagSource: spec},
+			Inputs:       jsonserializable.JSONSerializable{Val: map[string]interface{}{}, Valid: true},
+			Outputs:      jsonserializable.JSONSerializable{Val: []interface{}{multOutput}, Valid: true},
+			FinishedAt:   null.TimeFrom(time.Now()),
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{Type: pipeline.TaskTypeHTTP, DotID: "ds1", Output: jsonserializable.JSONSerializable{Val: `{"data":{"result":"10"}}`, Valid: true}},
+				{Type: pipeline.TaskTypeJSONParse, DotID: "parse", Output: jsonserializable.JSONSerializable{Val: "10", Valid: true}},
+				{Type: pipeline.TaskTypeMultiply, DotID: "mult", Output: jsonserializable.JSONSerializable{Val: multOutput, Valid: true}},
+			},
+		}
+	}
+
+	t.Run("recomputes pure tasks from recorded outputs", func(t *testing.T) {
+		orm.On("FindRun", mock.Anything, int64(42)).Return(recordedRun("100"), nil).Once()
+
+		result, err := r.ReplayRun(testutils.Context(t), 42)
+		require.NoError(t, err)
+		assert.Equal(t, int32(7), result.JobID)
+		assert.True(t, result.Matches)
+		require.Len(t, result.TaskRuns, 3)
+		for _, tr := range result.TaskRuns {
+			assert.True(t, tr.Matches, tr.DotID)
+			assert.Equal(t, tr.DotID == "ds1", tr.Recorded, tr.DotID)
+		}
+	})
+
+	t.Run("reports differences", func(t *testing.T) {
+		orm.On("FindRun", mock.Anything, int64(42)).Return(recordedRun("90"), nil).Once()
+
+		result, err := r.ReplayRun(testutils.Context(t), 42)
+		require.NoError(t, err)
+		assert.False(t, result.Matches)
+		for _, tr := range result.TaskRuns {
+			assert.Equal(t, tr.DotID != "mult", tr.Matches, tr.DotID)
+		}
+	})
+
+	t.Run("requires recorded external task runs", func(t *testing.T) {
+		run := recordedRun("100")
+		run.PipelineTaskRuns = run.PipelineTaskRuns[1:]
+		orm.On("FindRun", mock.Anything, int64(42)).Return(run, nil).Once()
+
+		_, err := r.ReplayRun(testutils.Context(t), 42)
+		require.ErrorIs(t, err, pipeline.ErrReplayMissingTaskRun)
+	})
+
+	t.Run("requires a finished run", func(t *testing.T) {
+		run := recordedRun("100")
+		run.FinishedAt = null.Time{}
+		orm.On("FindRun", mock.Anything, int64(42)).Return(run, nil).Once()
+
+		_, err := r.ReplayRun(testutils.Context(t), 42)
+		require.Error(t, err)
+	})
+}
+
 func Test_PipelineRunner_AsyncJob_Basic(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
@@ -1077,3 +1374,91 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_RunLimits(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+	limit := uint32(1)
+
+	t.Run("rejects runs exceeding the runs per minute and stores them", func(t *testing.T) {
+		spec := pipeline.Spec{ID: 1, JobID: 1, DotDagSource: `a [type=memo value=1]`, MaxRunsPerMinute: &limit, RunLimitMode: config.RunLimitModeDrop}
+		_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+
+		orm.On("InsertRun", mock.Anything, mock.MatchedBy(func(run *pipeline.Run) bool {
+			return run.State == pipeline.RunStatusErrored && run.FinishedAt.Valid &&
+				run.FatalErrors[0].String == "run rejected: job reached its limit of 1 runs per minute"
+		})).Return(nil).Once()
+		_, _, err = r.ExecuteAndInsertFinishedRun(ctx, spec, pipeline.NewVarsFrom(nil), false)
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+
+		// the next rejected runs of the minute are not stored
+		_, _, err = r.ExecuteAndInsertFinishedRun(ctx, spec, pipeline.NewVarsFrom(nil), false)
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+
+		// the limits of a deleted or updated job start over
+		r.ForgetJob(spec.JobID)
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+
+		// runs of other jobs are not limited
+		spec.JobID = 2
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+	})
+
+	var inflight, maxInflight atomic.Int32
+	started := make(chan struct{}, 3)
+	unblock := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := inflight.Add(1)
+		if n > maxInflight.Load() {
+			maxInflight.Store(n)
+		}
+		started <- struct{}{}
+		<-unblock
+		inflight.Add(-1)
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer s.Close()
+	dag := fmt.Sprintf(`a [type=http method=GET url="%s"]`, s.URL)
+
+	t.Run("rejects runs exceeding the concurrent runs", func(t *testing.T) {
+		spec := pipeline.Spec{JobID: 3, DotDagSource: dag, MaxConcurrentRuns: &limit, RunLimitMode: config.RunLimitModeDrop}
+		done := make(chan error)
+		go func() {
+			_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			done <- err
+		}()
+		<-started
+
+		_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+		require.ErrorContains(t, err, "limit of 1 concurrent runs")
+
+		unblock <- struct{}{}
+		require.NoError(t, <-done)
+	})
+
+	t.Run("queues runs exceeding the concurrent runs", func(t *testing.T) {
+		maxInflight.Store(0)
+		spec := pipeline.Spec{JobID: 4, DotDagSource: dag, MaxConcurrentRuns: &limit}
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+				assert.NoError(t, err)
+			}()
+		}
+		for i := 0; i < 3; i++ {
+			<-started
+			unblock <- struct{}{}
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), maxInflight.Load())
+	})
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/eautils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // NOTE: These metrics generate a new label per bridge, this should be safe
@@ -38,6 +39,18 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeURLLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_url_latency_seconds",
+		Help: "Bridge latency in seconds scoped by name, response status code and URL",
+	},
+		[]string{"name", "status_code_group", "url"},
+	)
+	promBridgeURLErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_url_errors_total",
+		Help: "Bridge error count scoped by name and URL",
+	},
+		[]string{"name", "url"},
+	)
 	promBridgeCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "bridge_cache_hits_total",
 		Help: "Bridge cache hits count scoped by name",
@@ -50,6 +63,18 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeCircuitBreakerOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_circuit_breaker_open",
+		Help: "Whether the circuit breaker of a bridge is open (1) or half-open (0.5), scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_rejections_total",
+		Help: "Bridge requests short-circuited by an open circuit breaker, scoped by name",
+	},
+		[]string{"name"},
+	)
 )
 
 // Return types:
@@ -64,12 +89,15 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
-
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	Credentials       string `json:"credentials"`
+
+	specId          int32
+	orm             bridges.ORM
+	config          Config
+	bridgeConfig    BridgeConfig
+	httpClient      *http.Client
+	circuitBreakers *bridges.CircuitBreakers
+	roundRobin      *bridges.RoundRobin
 }
 
 type BridgeTelemetry struct {
@@ -108,6 +136,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		credentials       StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -115,6 +144,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&credentials, From(NonemptyString(t.Credentials), "")), "credentials"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -124,14 +154,26 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
+	signer, err := newRequestSigner(t.config, string(credentials))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.getBridgeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 
+	// cacheTTL should not exceed stalenessCap.
+	cacheDuration := time.Duration(cacheTTL) * time.Second
+	if cacheDuration > stalenessCap {
+		lggr.Warnf("bridge task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
+		cacheDuration = stalenessCap
+	}
+
 	var metaMap MapParam
 
 	meta, _ := vars.Get("jobRun.meta")
@@ -171,23 +213,31 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 	logger.Sugared(lggr).Tracew("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"url", bt.URL.String(),
+		"fallbackURLs", len(bt.FallbackURLs),
+		"urlStrategy", bt.URLStrategy,
 	)
 
+	// the breaker is consulted right before sending, so that every allowed
+	// request is recorded
+	var breaker *bridges.CircuitBreaker
+	if t.circuitBreakers != nil {
+		breaker = t.circuitBreakers.Get(bt)
+		err = breaker.Allow()
+		setCircuitBreakerGauge(t.Name, breaker)
+		if err != nil {
+			promBridgeCircuitBreakerRejections.WithLabelValues(t.Name).Inc()
+			return t.shortCircuit(overtimeCtx, lggr, cacheDuration, err), runInfo
+		}
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	// cacheTTL should not exceed stalenessCap.
-	cacheDuration := time.Duration(cacheTTL) * time.Second
-	if cacheDuration > stalenessCap {
-		lggr.Warnf("bridge task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
-		cacheDuration = stalenessCap
-	}
-
 	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	resp := t.sendRequest(requestCtx, lggr, bt, reqHeaders, requestData, signer)
+	url, responseBytes, statusCode, headers, start, finish, err := resp.url, resp.responseBytes, resp.statusCode, resp.headers, resp.start, resp.finish, resp.err
 	elapsed := finish.Sub(start)
-	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
 	defer func() {
 		telemetryCh := GetTelemetryCh(ctx)
@@ -218,9 +268,22 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}()
 
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+	if breaker != nil {
+		// client errors mean the adapter is up, only errors that might go
+		// away by themselves count towards opening the circuit. Requests
+		// cancelled with the run say nothing about the adapter.
+		if ctx.Err() != nil {
+			breaker.Release()
+		} else if isRetryableHTTPError(statusCode, err) {
+			breakerErr := err
+			if breakerErr == nil {
+				breakerErr = errors.Errorf("status code %d", statusCode)
+			}
+			breaker.Record(breakerErr)
+		} else {
+			breaker.Record(nil)
+		}
+		setCircuitBreakerGauge(t.Name, breaker)
 	}
 
 	if err != nil || statusCode != http.StatusOK {
@@ -228,7 +291,6 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 			err = adapterErr
 		}
 
-		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
 			lggr.Debugw("Bridge task: request failed",
 				"response", string(responseBytes),
@@ -298,12 +360,188 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+func (t *BridgeTask) getBridgeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// shortCircuit returns the cached response of the task, or the circuit
+// breaker error if there is none, without contacting the bridge.
+func (t *BridgeTask) shortCircuit(ctx context.Context, lggr logger.Logger, cacheDuration time.Duration, breakerErr error) Result {
+	if cacheDuration == 0 {
+		return Result{Error: breakerErr}
+	}
+	responseBytes, err := t.orm.GetCachedResponse(ctx, t.dotID, t.specId, cacheDuration)
+	if err != nil {
+		promBridgeCacheErrors.WithLabelValues(t.Name).Inc()
+		if !errors.Is(err, sql.ErrNoRows) {
+			lggr.Warnw("Bridge task: cache fallback failed", "err", err.Error())
+		}
+		return Result{Error: breakerErr}
+	}
+	promBridgeCacheHits.WithLabelValues(t.Name).Inc()
+	lggr.Debugw("Bridge task: circuit open, falling back to cache",
+		"response", string(responseBytes),
+		"reason", breakerErr.Error(),
+	)
+	return Result{Value: string(responseBytes)}
+}
+
+// bridgeResponse is the outcome of a request to one of the URLs of a bridge.
+type bridgeResponse struct {
+	url           URLParam
+	responseBytes []byte
+	statusCode    int
+	headers       http.Header
+	start         time.Time
+	finish        time.Time
+	err           error
+}
+
+func (r bridgeResponse) ok() bool {
+	return r.err == nil && r.statusCode == http.StatusOK
+}
+
+// sendRequest sends the request to the URLs of the bridge according to the
+// bridge's URL strategy.
+func (t *BridgeTask) sendRequest(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders []string, requestData MapParam, signer *requestSigner) bridgeResponse {
+	urls := bt.URLs()
+	switch bt.URLStrategy {
+	case bridges.URLStrategyHedged:
+		return t.raceRequests(ctx, lggr, urls, bt.HedgeDelay.Duration(), reqHeaders, requestData, signer)
+	case bridges.URLStrategyFastest:
+		return t.raceRequests(ctx, lggr, urls, 0, reqHeaders, requestData, signer)
+	case bridges.URLStrategyRoundRobin:
+		if t.roundRobin != nil {
+			first := t.roundRobin.Next(bt.Name, len(urls))
+			urls = append(append([]models.WebURL{}, urls[first:]...), urls[:first]...)
+		}
+		return t.fallbackRequests(ctx, lggr, urls, reqHeaders, requestData, signer)
+	case bridges.URLStrategyFallback:
+	}
+	return t.fallbackRequests(ctx, lggr, urls, reqHeaders, requestData, signer)
+}
+
+// fallbackRequests sends the request to the URLs in order, until one responds
+// successfully or fails with an error that is not retryable.
+func (t *BridgeTask) fallbackRequests(ctx context.Context, lggr logger.Logger, urls []models.WebURL, reqHeaders []string, requestData MapParam, signer *requestSigner) (resp bridgeResponse) {
+	for i, u := range urls {
+		resp = t.requestURL(ctx, lggr, u, reqHeaders, requestData, signer)
+		if resp.ok() || !isRetryableHTTPError(resp.statusCode, resp.err) || ctx.Err() != nil {
+			return resp
+		}
+		if i < len(urls)-1 {
+			lggr.Debugw("Bridge task: request failed, trying next URL",
+				"url", resp.url.String(),
+				"status_code", resp.statusCode,
+				"error", resp.err,
+			)
+		}
+	}
+	return resp
+}
+
+// raceRequests sends the request to the first URL, and to the next URL every
+// time delay passes without a response or a request fails. A delay of 0 sends
+// the request to all URLs at once. The first successful response wins and
+// cancels the other requests.
+func (t *BridgeTask) raceRequests(ctx context.Context, lggr logger.Logger, urls []models.WebURL, delay time.Duration, reqHeaders []string, requestData MapParam, signer *requestSigner) bridgeResponse {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan bridgeResponse, len(urls))
+	next, pending := 0, 0
+	send := func() {
+		u := urls[next]
+		next++
+		pending++
+		go func() {
+			responses <- t.requestURL(ctx, lggr, u, reqHeaders, requestData, signer)
+		}()
+	}
+
+	send()
+	for delay == 0 && next < len(urls) {
+		send()
+	}
+
+	var last bridgeResponse
+	for pending > 0 {
+		var hedge <-chan time.Time
+		if next < len(urls) {
+			hedge = time.After(delay)
+		}
+		select {
+		case resp := <-responses:
+			pending--
+			if resp.ok() {
+				return resp
+			}
+			last = resp
+			if next < len(urls) {
+				// no need to wait for the delay once a request has failed
+				send()
+			}
+		case <-hedge:
+			lggr.Debugw("Bridge task: no response within hedge delay, sending hedged request",
+				"url", urls[next].String(),
+				"hedgeDelay", delay,
+			)
+			send()
+		}
+	}
+	return last
+}
+
+// requestURL sends the request to a single URL of the bridge and records its
+// latency and errors.
+func (t *BridgeTask) requestURL(ctx context.Context, lggr logger.Logger, u models.WebURL, reqHeaders []string, requestData MapParam, signer *requestSigner) bridgeResponse {
+	resp := bridgeResponse{url: URLParam(u)}
+	resp.responseBytes, resp.statusCode, resp.headers, resp.start, resp.finish, resp.err = makeHTTPRequest(ctx, lggr, "POST", resp.url, reqHeaders, requestData, t.httpClient, signer, t.config.DefaultHTTPLimit())
+	if resp.err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		// cancelled because another URL responded first
+		return resp
+	}
+
+	label := bridgeURLLabel(resp.url)
+	latency := resp.finish.Sub(resp.start).Seconds()
+	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(resp.statusCode)).Set(latency)
+	promBridgeURLLatency.WithLabelValues(t.Name, statusCodeGroup(resp.statusCode), label).Set(latency)
+
+	// check for external adapter response object status
+	if code, ok := eautils.BestEffortExtractEAStatus(resp.responseBytes); ok {
+		resp.statusCode = code
+	}
+	if !resp.ok() {
+		promBridgeErrors.WithLabelValues(t.Name).Inc()
+		promBridgeURLErrors.WithLabelValues(t.Name, label).Inc()
+	}
+	return resp
+}
+
+// bridgeURLLabel strips credentials and query parameters, which might contain
+// API keys, from a bridge URL used as metric label.
+func bridgeURLLabel(u URLParam) string {
+	stripped := url.URL(u)
+	stripped.User = nil
+	stripped.RawQuery = ""
+	stripped.Fragment = ""
+	return stripped.String()
+}
+
+func setCircuitBreakerGauge(name string, breaker *bridges.CircuitBreaker) {
+	var value float64
+	switch breaker.State().State {
+	case bridges.CircuitOpen:
+		value = 1
+	case bridges.CircuitHalfOpen:
+		value = 0.5
+	case bridges.CircuitClosed:
 	}
-	return URLParam(bt.URL), nil
+	promBridgeCircuitBreakerOpen.WithLabelValues(name).Set(value)
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
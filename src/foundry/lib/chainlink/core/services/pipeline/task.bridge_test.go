@@ -882,6 +882,269 @@ func TestBridgeTask_ErrorMessage(t *testing.T) {
 	require.Nil(t, result.Value)
 }
 
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+	bridge.CircuitBreakerFailureThreshold = 2
+	bridge.CircuitBreakerCoolDown = *models.NewInterval(time.Hour)
+	require.NoError(t, orm.CreateBridgeType(testutils.Context(t), bridge))
+
+	task := pipeline.BridgeTask{
+		Name:        bridge.Name.String(),
+		RequestData: ethUSDPairing,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+	circuitBreakers := bridges.NewCircuitBreakers()
+	task.HelperSetCircuitBreakers(circuitBreakers)
+
+	for i := 0; i < 2; i++ {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.NotErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+		assert.True(t, runInfo.IsRetryable)
+	}
+
+	state, ok := circuitBreakers.State(bridge.Name)
+	require.True(t, ok)
+	assert.Equal(t, bridges.CircuitOpen, state.State)
+	assert.Equal(t, uint32(2), state.ConsecutiveFailures)
+
+	// the open circuit fails fast without contacting the bridge
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+	assert.False(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(2), requests.Load())
+
+	health := circuitBreakers.HealthReport()
+	require.Contains(t, health, "BridgeCircuitBreaker."+bridge.Name.String())
+	assert.Error(t, health["BridgeCircuitBreaker."+bridge.Name.String()])
+}
+
+func TestBridgeTask_CircuitBreaker_CancelledProbe(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	probing := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			close(probing)
+			<-r.Context().Done()
+		default:
+			_, _ = w.Write([]byte(`{"data": {"result": 1}}`))
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+	bridge.CircuitBreakerFailureThreshold = 1
+	bridge.CircuitBreakerCoolDown = *models.NewInterval(50 * time.Millisecond)
+	require.NoError(t, orm.CreateBridgeType(testutils.Context(t), bridge))
+
+	task := pipeline.BridgeTask{
+		Name:        bridge.Name.String(),
+		RequestData: ethUSDPairing,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+	circuitBreakers := bridges.NewCircuitBreakers()
+	task.HelperSetCircuitBreakers(circuitBreakers)
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	state, ok := circuitBreakers.State(bridge.Name)
+	require.True(t, ok)
+	require.Equal(t, bridges.CircuitOpen, state.State)
+	time.Sleep(2 * bridge.CircuitBreakerCoolDown.Duration())
+
+	// the probe is cancelled with its run, which is not a failure of the bridge
+	ctx, cancel := context.WithCancel(testutils.Context(t))
+	go func() {
+		<-probing
+		cancel()
+	}()
+	result, _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	state, _ = circuitBreakers.State(bridge.Name)
+	assert.Equal(t, bridges.CircuitHalfOpen, state.State)
+	assert.Equal(t, uint32(1), state.ConsecutiveFailures)
+
+	// and the next request probes the bridge
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(3), requests.Load())
+	state, _ = circuitBreakers.State(bridge.Name)
+	assert.Equal(t, bridges.CircuitClosed, state.State)
+}
+
+func TestBridgeTask_URLStrategies(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := bridges.NewORM(db)
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	newServer := func(t *testing.T, status int, delay time.Duration, body string, requests *atomic.Int32) models.WebURL {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		u, err := url.ParseRequestURI(server.URL)
+		require.NoError(t, err)
+		return models.WebURL(*u)
+	}
+
+	newTask := func(t *testing.T, primary models.WebURL, strategy bridges.URLStrategy, hedgeDelay time.Duration, fallbackURLs ...models.WebURL) *pipeline.BridgeTask {
+		_, bridge := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: primary.String()})
+		bridge.FallbackURLs = fallbackURLs
+		bridge.URLStrategy = strategy
+		bridge.HedgeDelay = *models.NewInterval(hedgeDelay)
+		require.NoError(t, orm.CreateBridgeType(testutils.Context(t), bridge))
+
+		task := &pipeline.BridgeTask{
+			Name:        bridge.Name.String(),
+			RequestData: ethUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetRoundRobin(bridges.NewRoundRobin())
+		return task
+	}
+
+	run := func(t *testing.T, task *pipeline.BridgeTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("fallback moves on after a retryable error", func(t *testing.T) {
+		var primaryRequests, backupRequests atomic.Int32
+		primary := newServer(t, http.StatusServiceUnavailable, 0, "", &primaryRequests)
+		backup := newServer(t, http.StatusOK, 0, `{"data":{"result":"backup"}}`, &backupRequests)
+		task := newTask(t, primary, bridges.URLStrategyFallback, 0, backup)
+
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"data":{"result":"backup"}}`, result.Value)
+		assert.Equal(t, int32(1), primaryRequests.Load())
+		assert.Equal(t, int32(1), backupRequests.Load())
+	})
+
+	t.Run("fallback stops at a client error", func(t *testing.T) {
+		var primaryRequests, backupRequests atomic.Int32
+		primary := newServer(t, http.StatusBadRequest, 0, "", &primaryRequests)
+		backup := newServer(t, http.StatusOK, 0, `{}`, &backupRequests)
+		task := newTask(t, primary, bridges.URLStrategyFallback, 0, backup)
+
+		result := run(t, task)
+		require.Error(t, result.Error)
+		assert.Equal(t, int32(1), primaryRequests.Load())
+		assert.Equal(t, int32(0), backupRequests.Load())
+	})
+
+	t.Run("round-robin rotates the first URL", func(t *testing.T) {
+		var firstRequests, secondRequests atomic.Int32
+		first := newServer(t, http.StatusOK, 0, `"first"`, &firstRequests)
+		second := newServer(t, http.StatusOK, 0, `"second"`, &secondRequests)
+		task := newTask(t, first, bridges.URLStrategyRoundRobin, 0, second)
+
+		for _, expected := range []string{`"first"`, `"second"`, `"first"`} {
+			result := run(t, task)
+			require.NoError(t, result.Error)
+			assert.Equal(t, expected, result.Value)
+		}
+		assert.Equal(t, int32(2), firstRequests.Load())
+		assert.Equal(t, int32(1), secondRequests.Load())
+	})
+
+	t.Run("hedged request wins over a slow primary", func(t *testing.T) {
+		var primaryRequests, backupRequests atomic.Int32
+		primary := newServer(t, http.StatusOK, 10*time.Second, `"primary"`, &primaryRequests)
+		backup := newServer(t, http.StatusOK, 0, `"backup"`, &backupRequests)
+		task := newTask(t, primary, bridges.URLStrategyHedged, 50*time.Millisecond, backup)
+
+		start := time.Now()
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `"backup"`, result.Value)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Equal(t, int32(1), primaryRequests.Load())
+		assert.Equal(t, int32(1), backupRequests.Load())
+	})
+
+	t.Run("hedged request is not sent if the primary responds in time", func(t *testing.T) {
+		var primaryRequests, backupRequests atomic.Int32
+		primary := newServer(t, http.StatusOK, 0, `"primary"`, &primaryRequests)
+		backup := newServer(t, http.StatusOK, 0, `"backup"`, &backupRequests)
+		task := newTask(t, primary, bridges.URLStrategyHedged, 5*time.Second, backup)
+
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `"primary"`, result.Value)
+		assert.Equal(t, int32(0), backupRequests.Load())
+	})
+
+	t.Run("fastest response wins", func(t *testing.T) {
+		var slowRequests, fastRequests atomic.Int32
+		slow := newServer(t, http.StatusOK, 10*time.Second, `"slow"`, &slowRequests)
+		fast := newServer(t, http.StatusOK, 0, `"fast"`, &fastRequests)
+		task := newTask(t, slow, bridges.URLStrategyFastest, 0, fast)
+
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `"fast"`, result.Value)
+		assert.Equal(t, int32(1), fastRequests.Load())
+	})
+
+	t.Run("fastest returns the last error if all URLs fail", func(t *testing.T) {
+		var requests atomic.Int32
+		first := newServer(t, http.StatusBadGateway, 0, "", &requests)
+		second := newServer(t, http.StatusServiceUnavailable, 0, "", &requests)
+		task := newTask(t, first, bridges.URLStrategyFastest, 0, second)
+
+		result := run(t, task)
+		require.Error(t, result.Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
+
 func TestBridgeTask_OnlyErrorMessage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,218 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// ForEachItemKey is the variable holding the current item inside a foreach sub-pipeline
+	ForEachItemKey = "item"
+	// ForEachIndexKey is the variable holding the index of the current item inside a foreach sub-pipeline
+	ForEachIndexKey = "index"
+)
+
+// ForEachTask runs the DOT sub-pipeline given in `pipeline` once for every
+// element of `items`. Each iteration is scheduled like a regular pipeline
+// run, so retries, timeouts and failEarly of the sub-pipeline tasks apply per
+// iteration. Inside the sub-pipeline the current element and its index are
+// available as $(item) and $(index), along with all variables of the parent
+// run.
+//
+// The output of an iteration is the output of the sub-pipeline's terminal
+// task, or an array of outputs if it has several terminal tasks. At most
+// `parallelism` iterations (default 1) run concurrently. If more than
+// `allowedFaults` iterations fail (default 0), the task fails.
+//
+// Return types:
+//
+//	[]interface{}
+type ForEachTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Items         string `json:"items"`
+	Pipeline      string `json:"pipeline"`
+	Parallelism   string `json:"parallelism"`
+	AllowedFaults string `json:"allowedFaults"`
+
+	runner *runner
+	spec   Spec
+	// prepareIteration, if set, is applied to the tasks of every iteration
+	prepareIteration func(*Pipeline)
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+// source returns the DOT source of the sub-pipeline. Angle brackets quoting
+// a sub-pipeline that itself contains angle-bracketed attributes are not
+// removed by the graph parser, so they are stripped here.
+func (t *ForEachTask) source() string {
+	src := strings.TrimSpace(t.Pipeline)
+	if strings.HasPrefix(src, "<") && strings.HasSuffix(src, ">") {
+		src = src[1 : len(src)-1]
+	}
+	return src
+}
+
+// validate checks that the sub-pipeline can be executed as part of a foreach task.
+func (t *ForEachTask) validate() error {
+	p, err := Parse(t.source())
+	if err != nil {
+		return errors.Wrap(err, "foreach sub-pipeline")
+	}
+	if p.RequiresPreInsert() {
+		return errors.New("foreach sub-pipeline cannot contain async tasks")
+	}
+	for _, task := range p.Tasks {
+		if task.DotID() == ForEachItemKey || task.DotID() == ForEachIndexKey {
+			return errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name inside a foreach sub-pipeline", task.DotID())
+		}
+	}
+	return nil
+}
+
+func (t *ForEachTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		items              SliceParam
+		parallelism        Uint64Param
+		maybeAllowedFaults MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Items, vars), JSONWithVarExprs(t.Items, vars, false), Input(inputs, 0))), "items"),
+		errors.Wrap(ResolveParam(&parallelism, From(NonemptyString(t.Parallelism), 1)), "parallelism"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if parallelism == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "parallelism must be greater than 0")}, runInfo
+	}
+	allowedFaults, _ := maybeAllowedFaults.Uint64()
+
+	if t.runner == nil {
+		return Result{Error: errors.New("foreach task was not initialized by a pipeline runner")}, runInfo
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		values     = make([]interface{}, len(items))
+		errs       = make([]error, len(items))
+		iterations = make([]TaskRunResults, len(items))
+		faults     uint64
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		sem        = make(chan struct{}, parallelism)
+	)
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			mu.Lock()
+			errs[i] = ErrCancelled
+			faults++
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, item interface{}) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			trrs, value, err := t.runIteration(ctx, vars, i, item)
+			values[i], errs[i], iterations[i] = value, err, trrs
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				faults++
+				if faults > allowedFaults {
+					// no point in running the remaining iterations
+					cancel()
+				}
+			}
+		}(i, item)
+	}
+	wg.Wait()
+
+	runInfo.Iterations = iterations
+
+	if faults > allowedFaults {
+		var combined error
+		for i, err := range errs {
+			if err != nil {
+				combined = multierr.Append(combined, errors.Wrapf(err, "iteration %d", i))
+			}
+		}
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "number of failed iterations %v > number allowed faults %v: %v", faults, allowedFaults, combined)}, runInfo
+	}
+	return Result{Value: values}, runInfo
+}
+
+// runIteration executes the sub-pipeline for a single item.
+func (t *ForEachTask) runIteration(ctx context.Context, vars Vars, index int, item interface{}) (TaskRunResults, interface{}, error) {
+	// every iteration gets freshly parsed tasks so that task run IDs are unique
+	p, err := Parse(t.source())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "foreach sub-pipeline")
+	}
+	t.runner.initializeTasks(p, t.spec)
+	if t.prepareIteration != nil {
+		t.prepareIteration(p)
+	}
+
+	iterationVars := vars.Copy()
+	err = multierr.Combine(
+		iterationVars.Set(ForEachItemKey, item),
+		iterationVars.Set(ForEachIndexKey, index),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := &Run{
+		State:          RunStatusRunning,
+		JobID:          t.spec.JobID,
+		PipelineSpec:   t.spec,
+		PipelineSpecID: t.spec.ID,
+		CreatedAt:      time.Now(),
+	}
+	trrs := t.runner.runUnrecorded(ctx, p, run, iterationVars)
+	for i := range trrs {
+		if trrs[i].ID == uuid.Nil {
+			trrs[i].ID = uuid.New()
+		}
+	}
+
+	if run.FailSilently {
+		return trrs, nil, ErrCancelled
+	}
+	final := trrs.FinalResult()
+	if final.HasFatalErrors() {
+		return trrs, nil, errors.Wrap(multierr.Combine(final.FatalErrors...), "foreach sub-pipeline")
+	}
+	if len(final.Values) == 1 {
+		return trrs, final.Values[0], nil
+	}
+	return trrs, final.Values, nil
+}
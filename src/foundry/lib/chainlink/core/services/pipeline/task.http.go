@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -25,6 +26,19 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	Credentials                    string
+
+	// Pagination, when set, follows the next page links or cursors of the
+	// response, and Stream reads a streaming response, see
+	// task.http_paginated.go. The task then returns the items of all pages as
+	// a JSON array.
+	Pagination  string
+	NextPath    string
+	CursorParam string
+	ItemsPath   string
+	MaxPages    string
+	MaxSize     string
+	Stream      string
 
 	config                 Config
 	httpClient             *http.Client
@@ -46,8 +60,33 @@ var (
 	},
 		[]string{"pipeline_task_spec_id"},
 	)
+	promHTTPPageFetchTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_http_page_fetch_time",
+		Help:    "Time taken to fetch each page or streamed record of a paginated HTTP request, in milliseconds",
+		Buckets: []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000},
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPPageBodySize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_http_page_body_size",
+		Help:    "Size (in bytes) of each page or streamed record of a paginated HTTP response",
+		Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPPages = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_http_pages",
+		Help: "Number of pages or streamed records read by a paginated HTTP request",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
 )
 
+func observeHTTPPage(dotID string, elapsed time.Duration, size int) {
+	promHTTPPageFetchTime.WithLabelValues(dotID).Observe(float64(elapsed.Milliseconds()))
+	promHTTPPageBodySize.WithLabelValues(dotID).Observe(float64(size))
+}
+
 func (t *HTTPTask) Type() TaskType {
 	return TaskTypeHTTP
 }
@@ -64,6 +103,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		credentials                    StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -74,6 +114,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&credentials, From(NonemptyString(t.Credentials), "")), "credentials"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -83,6 +124,19 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
+	var pagination httpPagination
+	if t.isPaginated() {
+		pagination, err = t.resolvePagination(vars)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	signer, err := newRequestSigner(t.config, string(credentials))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -93,6 +147,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"method", method,
 		"reqHeaders", reqHeaders,
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
+		"credentials", credentials,
 	)
 
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
@@ -104,7 +159,11 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	if t.isPaginated() {
+		return t.runPaginated(requestCtx, lggr, pagination, method, url, reqHeaders, requestData, client, signer)
+	}
+
+	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, signer, t.config.DefaultHTTPLimit())
 	elapsed := finish.Sub(start).Milliseconds()
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
@@ -129,3 +188,48 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	// value instead.
 	return Result{Value: string(responseBytes)}, runInfo
 }
+
+// runPaginated fetches all pages, or reads the streaming response, and
+// returns their items as a JSON array.
+func (t *HTTPTask) runPaginated(ctx context.Context, lggr logger.Logger, p httpPagination, method StringParam, url URLParam, reqHeaders []string, requestData MapParam, client *http.Client, signer *requestSigner) (Result, RunInfo) {
+	var (
+		items      []interface{}
+		pages      int
+		statusCode int
+		err        error
+	)
+	start := time.Now()
+	if p.stream != "" {
+		items, pages, statusCode, err = t.readStream(ctx, lggr, p, method, url, reqHeaders, requestData, client, signer)
+	} else {
+		items, pages, statusCode, err = t.fetchPages(ctx, lggr, p, method, url, reqHeaders, requestData, client, signer)
+	}
+	elapsed := time.Since(start).Milliseconds()
+	if err != nil {
+		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
+			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec`)
+		}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+	}
+
+	if items == nil {
+		items = []interface{}{}
+	}
+	responseBytes, err := json.Marshal(items)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	lggr.Debugw("HTTP task got paginated response",
+		"pages", pages,
+		"items", len(items),
+		"url", url.String(),
+		"dotID", t.DotID(),
+	)
+
+	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	promHTTPPages.WithLabelValues(t.DotID()).Set(float64(pages))
+
+	return Result{Value: string(responseBytes)}, RunInfo{}
+}
@@ -0,0 +1,370 @@
+package pipeline
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+// Pagination modes of the http task.
+const (
+	// HTTPPaginationLink follows the rel="next" URL of the Link response
+	// header (RFC 8288).
+	HTTPPaginationLink = "link"
+	// HTTPPaginationNext follows the URL found at nextPath in the response.
+	HTTPPaginationNext = "next"
+	// HTTPPaginationCursor repeats the request with the cursor found at
+	// nextPath in the response set as the cursorParam query parameter.
+	HTTPPaginationCursor = "cursor"
+)
+
+// Streaming response formats of the http task.
+const (
+	// HTTPStreamNDJSON reads one JSON value per line.
+	HTTPStreamNDJSON = "ndjson"
+	// HTTPStreamSSE reads the data of server-sent events.
+	HTTPStreamSSE = "sse"
+)
+
+const defaultHTTPMaxPages = 10
+
+var linkNextRegexp = regexp.MustCompile(`<([^>]*)>\s*;[^,]*\brel="?next"?`)
+
+// httpPagination holds the resolved parameters of a paginated or streaming
+// http task.
+type httpPagination struct {
+	mode        string
+	stream      string
+	nextPath    JSONPathParam
+	cursorParam string
+	itemsPath   JSONPathParam
+	maxPages    int
+	maxSize     int64
+}
+
+// isPaginated returns true if the task follows pagination or reads a
+// streaming response, instead of returning a single response body.
+func (t *HTTPTask) isPaginated() bool {
+	return t.Pagination != "" || t.Stream != ""
+}
+
+func (t *HTTPTask) resolvePagination(vars Vars) (p httpPagination, err error) {
+	var (
+		mode        StringParam
+		stream      StringParam
+		cursorParam StringParam
+		maxPages    Uint64Param
+		maxSize     Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&mode, From(NonemptyString(t.Pagination), "")), "pagination"),
+		errors.Wrap(ResolveParam(&stream, From(NonemptyString(t.Stream), "")), "stream"),
+		errors.Wrap(ResolveParam(&p.nextPath, From(VarExpr(t.NextPath, vars), t.NextPath)), "nextPath"),
+		errors.Wrap(ResolveParam(&cursorParam, From(NonemptyString(t.CursorParam), "cursor")), "cursorParam"),
+		errors.Wrap(ResolveParam(&p.itemsPath, From(VarExpr(t.ItemsPath, vars), t.ItemsPath)), "itemsPath"),
+		errors.Wrap(ResolveParam(&maxPages, From(NonemptyString(t.MaxPages), defaultHTTPMaxPages)), "maxPages"),
+		errors.Wrap(ResolveParam(&maxSize, From(NonemptyString(t.MaxSize), t.config.DefaultHTTPLimit())), "maxSize"),
+	)
+	if err != nil {
+		return p, err
+	}
+
+	p.mode, p.stream, p.cursorParam = string(mode), string(stream), string(cursorParam)
+	p.maxPages, p.maxSize = int(maxPages), int64(maxSize)
+	switch p.mode {
+	case "", HTTPPaginationLink:
+	case HTTPPaginationNext, HTTPPaginationCursor:
+		if len(p.nextPath) == 0 {
+			return p, errors.Wrapf(ErrBadInput, "nextPath is required for %s pagination", p.mode)
+		}
+	default:
+		return p, errors.Wrapf(ErrBadInput, "pagination must be one of %s, %s or %s, got %q", HTTPPaginationLink, HTTPPaginationNext, HTTPPaginationCursor, p.mode)
+	}
+	switch p.stream {
+	case "", HTTPStreamNDJSON, HTTPStreamSSE:
+	default:
+		return p, errors.Wrapf(ErrBadInput, "stream must be %s or %s, got %q", HTTPStreamNDJSON, HTTPStreamSSE, p.stream)
+	}
+	if p.mode != "" && p.stream != "" {
+		return p, errors.Wrap(ErrBadInput, "pagination and stream cannot be combined")
+	}
+	if p.maxPages == 0 || p.maxSize == 0 {
+		return p, errors.Wrap(ErrBadInput, "maxPages and maxSize must be positive")
+	}
+	return p, nil
+}
+
+// fetchPages requests the pages of a paginated response, until there is no
+// next page or maxPages or maxSize is reached, and returns the items of all
+// pages. Each page counts towards maxSize with the size of its body.
+func (t *HTTPTask) fetchPages(ctx context.Context, lggr logger.Logger, p httpPagination, method StringParam, firstURL URLParam, reqHeaders []string, requestData MapParam, client *http.Client, signer *requestSigner) (items []interface{}, pages int, statusCode int, err error) {
+	pageURL := firstURL
+	remaining := p.maxSize
+	for pages < p.maxPages {
+		var (
+			body    []byte
+			headers http.Header
+			start   time.Time
+			finish  time.Time
+			page    interface{}
+			next    *URLParam
+		)
+		body, statusCode, headers, start, finish, err = makeHTTPRequest(ctx, lggr, method, pageURL, reqHeaders, requestData, client, signer, remaining)
+		if err != nil {
+			return nil, pages, statusCode, errors.Wrapf(err, "page %d", pages+1)
+		}
+		pages++
+		remaining -= int64(len(body))
+		observeHTTPPage(t.DotID(), finish.Sub(start), len(body))
+
+		page, err = decodeJSON(body)
+		if err != nil {
+			return nil, pages, statusCode, errors.Wrapf(err, "page %d: failed to decode response", pages)
+		}
+		items, err = appendItems(items, page, p.itemsPath)
+		if err != nil {
+			return nil, pages, statusCode, errors.Wrapf(err, "page %d", pages)
+		}
+
+		next, err = nextPageURL(p, firstURL, pageURL, page, headers)
+		if err != nil {
+			return nil, pages, statusCode, errors.Wrapf(err, "page %d", pages)
+		}
+		if next == nil {
+			return items, pages, statusCode, nil
+		}
+		if remaining <= 0 {
+			lggr.Debugw("HTTP task: maxSize reached, not following next page", "pages", pages, "maxSize", p.maxSize)
+			return items, pages, statusCode, nil
+		}
+		pageURL = *next
+	}
+	lggr.Debugw("HTTP task: maxPages reached, not following next page", "pages", pages)
+	return items, pages, statusCode, nil
+}
+
+// nextPageURL returns the URL of the page after the current one, or nil if
+// it is the last page. The next page must be on the same origin as the first
+// one, as it is requested with the same client, headers and credentials.
+func nextPageURL(p httpPagination, firstURL, currentURL URLParam, page interface{}, headers http.Header) (*URLParam, error) {
+	var next string
+	switch p.mode {
+	case HTTPPaginationLink:
+		for _, link := range headers.Values("Link") {
+			if m := linkNextRegexp.FindStringSubmatch(link); m != nil {
+				next = m[1]
+				break
+			}
+		}
+	case HTTPPaginationNext, HTTPPaginationCursor:
+		val, found := lookupJSONPath(page, p.nextPath)
+		if !found || val == nil {
+			return nil, nil
+		}
+		switch v := val.(type) {
+		case string:
+			next = v
+		case json.Number:
+			next = v.String()
+		default:
+			return nil, errors.Wrapf(ErrBadInput, "expected %s at nextPath to be a string, got %T", p.mode, val)
+		}
+	}
+	if next == "" {
+		return nil, nil
+	}
+
+	current := url.URL(currentURL)
+	var u *url.URL
+	if p.mode == HTTPPaginationCursor {
+		u = new(url.URL)
+		*u = url.URL(firstURL)
+		query := u.Query()
+		query.Set(p.cursorParam, next)
+		u.RawQuery = query.Encode()
+	} else {
+		ref, err := url.Parse(next)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "invalid next page URL: %v", err)
+		}
+		// relative links are resolved against the current page
+		u = current.ResolveReference(ref)
+	}
+	if u.String() == current.String() {
+		return nil, errors.Errorf("next page URL %s is the same as the current page", u.String())
+	}
+	first := url.URL(firstURL)
+	if !strings.EqualFold(u.Scheme, first.Scheme) || !strings.EqualFold(u.Host, first.Host) {
+		return nil, errors.Wrapf(ErrBadInput, "next page URL %s is not on the same origin as %s", u.Redacted(), first.Redacted())
+	}
+	nextURL := URLParam(*u)
+	return &nextURL, nil
+}
+
+// readStream reads the records of a NDJSON or SSE response, until the
+// response ends or maxPages records are read, and returns their items. Each
+// record counts as a page. Reading more than maxSize bytes is an error.
+func (t *HTTPTask) readStream(ctx context.Context, lggr logger.Logger, p httpPagination, method StringParam, u URLParam, reqHeaders []string, requestData MapParam, client *http.Client, signer *requestSigner) (items []interface{}, records int, statusCode int, err error) {
+	request, client, err := newHTTPRequest(ctx, method, u, reqHeaders, requestData, client, signer)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if p.stream == HTTPStreamSSE {
+		request.Header.Set("Accept", "text/event-stream")
+	} else {
+		request.Header.Set("Accept", "application/x-ndjson")
+	}
+
+	httpRequest := clhttp.HTTPRequest{
+		Client:  client,
+		Request: request,
+		Config:  clhttp.HTTPRequestConfig{SizeLimit: p.maxSize},
+		Logger:  logger.Sugared(lggr).Named("HTTPRequest"),
+	}
+	body, statusCode, _, err := httpRequest.SendRequestReader()
+	if err != nil {
+		if ctx.Err() != nil {
+			err = errors.New("http request timed out or interrupted")
+		}
+		return nil, 0, statusCode, errors.Wrap(err, "error making http request")
+	}
+	defer logger.Sugared(lggr).ErrorIfFn(body.Close, "Error closing stream response body")
+
+	if statusCode >= 400 {
+		responseBytes, _ := io.ReadAll(body)
+		return nil, 0, statusCode, errors.Errorf("got error from %s: (status code %v) %s", u.String(), statusCode, bestEffortExtractError(responseBytes))
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), int(min(p.maxSize, int64(^uint(0)>>1))))
+	last := time.Now()
+	record := func(data []byte) error {
+		value, err := decodeJSON(data)
+		if err != nil {
+			if p.stream != HTTPStreamSSE {
+				return errors.Wrapf(err, "record %d: failed to decode NDJSON line", records+1)
+			}
+			// SSE events don't have to carry JSON
+			value = string(data)
+		}
+		records++
+		now := time.Now()
+		observeHTTPPage(t.DotID(), now.Sub(last), len(data))
+		last = now
+		items, err = appendItems(items, value, p.itemsPath)
+		return errors.Wrapf(err, "record %d", records)
+	}
+
+	var event [][]byte
+	for records < p.maxPages && scanner.Scan() {
+		line := scanner.Bytes()
+		if p.stream == HTTPStreamNDJSON {
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			if err = record(line); err != nil {
+				return nil, records, statusCode, err
+			}
+			continue
+		}
+
+		switch {
+		case len(line) == 0:
+			// a blank line dispatches the event
+			if len(event) > 0 {
+				if err = record(bytes.Join(event, []byte("\n"))); err != nil {
+					return nil, records, statusCode, err
+				}
+				event = nil
+			}
+		case bytes.HasPrefix(line, []byte("data:")):
+			data := bytes.TrimPrefix(bytes.TrimPrefix(line, []byte("data:")), []byte(" "))
+			event = append(event, append([]byte{}, data...))
+		default:
+			// comments and the event, id and retry fields are ignored
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			err = errors.New("http request timed out or interrupted")
+		}
+		return nil, records, statusCode, errors.Wrapf(err, "failed to read stream after %d records", records)
+	}
+	if len(event) > 0 && records < p.maxPages {
+		// the stream ended without a blank line after the last event
+		if err = record(bytes.Join(event, []byte("\n"))); err != nil {
+			return nil, records, statusCode, err
+		}
+	}
+	return items, records, statusCode, nil
+}
+
+// appendItems appends the items found at itemsPath in value, or value itself
+// if itemsPath is empty.
+func appendItems(items []interface{}, value interface{}, itemsPath JSONPathParam) ([]interface{}, error) {
+	if len(itemsPath) == 0 {
+		return append(items, value), nil
+	}
+	found, exists := lookupJSONPath(value, itemsPath)
+	if !exists {
+		return nil, errors.Wrapf(ErrKeypathNotFound, `could not resolve itemsPath ["%v"]`, strings.Join(itemsPath, `","`))
+	}
+	if arr, isArr := found.([]interface{}); isArr {
+		return append(items, arr...), nil
+	}
+	return append(items, found), nil
+}
+
+// lookupJSONPath returns the value at path in a decoded JSON value.
+func lookupJSONPath(value interface{}, path []string) (interface{}, bool) {
+	for _, part := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var exists bool
+			if value, exists = v[part]; !exists {
+				return nil, false
+			}
+		case []interface{}:
+			index, ok := big.NewInt(0).SetString(part, 10)
+			if !ok || !index.IsInt64() {
+				return nil, false
+			}
+			i := int(index.Int64())
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// decodeJSON decodes data keeping numbers as json.Number, so they are encoded
+// back unchanged in the task output.
+func decodeJSON(data []byte) (interface{}, error) {
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	err := d.Decode(&decoded)
+	return decoded, err
+}
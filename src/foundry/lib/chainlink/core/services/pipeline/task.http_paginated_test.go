@@ -0,0 +1,232 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestHTTPTask_Pagination(t *testing.T) {
+	t.Parallel()
+
+	// pages serves three pages of two items each
+	pages := func(t *testing.T, writePage func(w http.ResponseWriter, r *http.Request, page int)) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := 1
+			if p := r.URL.Query().Get("page"); p != "" {
+				var err error
+				page, err = strconv.Atoi(p)
+				assert.NoError(t, err)
+			}
+			writePage(w, r, page)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	items := func(page int) string {
+		return fmt.Sprintf(`[{"id": %d}, {"id": %d}]`, 2*page-1, 2*page)
+	}
+	run := func(t *testing.T, task pipeline.HTTPTask) pipeline.Result {
+		task.BaseTask = pipeline.NewBaseTask(0, "http", nil, nil, 0)
+		if task.Method == "" {
+			task.Method = "GET"
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(configtest.NewTestGeneralConfig(t).JobPipeline(), c, c)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+	allItems := `[{"id":1},{"id":2},{"id":3},{"id":4},{"id":5},{"id":6}]`
+
+	t.Run("Link header", func(t *testing.T) {
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			if page < 3 {
+				w.Header().Add("Link", fmt.Sprintf(`<?page=1>; rel="first", </items?page=%d>; rel="next"`, page+1))
+			}
+			_, _ = w.Write([]byte(items(page)))
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL + "/items", Pagination: "link"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, allItems, result.Value.(string))
+	})
+
+	t.Run("next URL with itemsPath", func(t *testing.T) {
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			next := "null"
+			if page < 3 {
+				next = fmt.Sprintf(`"/items?page=%d"`, page+1)
+			}
+			_, _ = fmt.Fprintf(w, `{"data": {"items": %s}, "links": {"next": %s}}`, items(page), next)
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL + "/items", Pagination: "next", NextPath: "links,next", ItemsPath: "data,items"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, allItems, result.Value.(string))
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, _ int) {
+			assert.Equal(t, "usd", r.URL.Query().Get("quote"))
+			page := 1
+			if cursor := r.URL.Query().Get("after"); cursor != "" {
+				page, _ = strconv.Atoi(cursor[len("c"):])
+			}
+			cursor := ""
+			if page < 3 {
+				cursor = fmt.Sprintf("c%d", page+1)
+			}
+			_, _ = fmt.Fprintf(w, `{"items": %s, "cursor": %q}`, items(page), cursor)
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL + "/items?quote=usd", Pagination: "cursor", NextPath: "cursor", CursorParam: "after", ItemsPath: "items"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, allItems, result.Value.(string))
+	})
+
+	t.Run("stops at maxPages", func(t *testing.T) {
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			w.Header().Add("Link", fmt.Sprintf(`<?page=%d>; rel="next"`, page+1))
+			_, _ = w.Write([]byte(items(page)))
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL, Pagination: "link", MaxPages: "2"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `[[{"id":1},{"id":2}],[{"id":3},{"id":4}]]`, result.Value.(string))
+	})
+
+	t.Run("fails on page error", func(t *testing.T) {
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			if page == 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte(`{"error": "upstream down"}`))
+				return
+			}
+			w.Header().Add("Link", fmt.Sprintf(`<?page=%d>; rel="next"`, page+1))
+			_, _ = w.Write([]byte(items(page)))
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL, Pagination: "link"})
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "page 2")
+		assert.Contains(t, result.Error.Error(), "upstream down")
+	})
+
+	t.Run("fails on cross-origin next page", func(t *testing.T) {
+		var otherRequests atomic.Int32
+		other := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			otherRequests.Add(1)
+			_, _ = w.Write([]byte(items(page)))
+		})
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			assert.Equal(t, "secret", r.Header.Get("Authorization"))
+			w.Header().Add("Link", fmt.Sprintf(`<%s/items?page=%d>; rel="next"`, other.URL, page+1))
+			_, _ = w.Write([]byte(items(page)))
+		})
+
+		result := run(t, pipeline.HTTPTask{URL: server.URL + "/items", Pagination: "link", Headers: `["Authorization", "secret"]`})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		assert.Contains(t, result.Error.Error(), "not on the same origin")
+		assert.Zero(t, otherRequests.Load())
+	})
+
+	t.Run("fails on next page on the loopback address", func(t *testing.T) {
+		var loopbackRequests atomic.Int32
+		server := pages(t, func(w http.ResponseWriter, r *http.Request, page int) {
+			host, port, err := net.SplitHostPort(r.Host)
+			assert.NoError(t, err)
+			if host == "127.0.0.1" {
+				loopbackRequests.Add(1)
+			}
+			next := fmt.Sprintf("http://127.0.0.1:%s/items?page=%d", port, page+1)
+			_, _ = fmt.Fprintf(w, `{"items": %s, "next": %q}`, items(page), next)
+		})
+		serverURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+		result := run(t, pipeline.HTTPTask{URL: serverURL + "/items", Pagination: "next", NextPath: "next", ItemsPath: "items"})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		assert.Contains(t, result.Error.Error(), "not on the same origin")
+		assert.Zero(t, loopbackRequests.Load())
+	})
+
+	t.Run("fails on invalid parameters", func(t *testing.T) {
+		result := run(t, pipeline.HTTPTask{URL: "http://example.com", Pagination: "cursor"})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+
+		result = run(t, pipeline.HTTPTask{URL: "http://example.com", Pagination: "offset"})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+
+		result = run(t, pipeline.HTTPTask{URL: "http://example.com", Pagination: "link", Stream: "ndjson"})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
+
+func TestHTTPTask_Stream(t *testing.T) {
+	t.Parallel()
+
+	stream := func(t *testing.T, contentType, body string) string {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return server.URL
+	}
+	run := func(t *testing.T, task pipeline.HTTPTask) pipeline.Result {
+		task.BaseTask = pipeline.NewBaseTask(0, "http", nil, nil, 0)
+		task.Method = "GET"
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(configtest.NewTestGeneralConfig(t).JobPipeline(), c, c)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("NDJSON", func(t *testing.T) {
+		url := stream(t, "application/x-ndjson", "{\"price\": 1.5}\n\n{\"price\": 2}\n{\"price\": 2.5}\n")
+
+		result := run(t, pipeline.HTTPTask{URL: url, Stream: "ndjson"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `[{"price":1.5},{"price":2},{"price":2.5}]`, result.Value.(string))
+
+		result = run(t, pipeline.HTTPTask{URL: url, Stream: "ndjson", ItemsPath: "price", MaxPages: "2"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `[1.5,2]`, result.Value.(string))
+	})
+
+	t.Run("NDJSON invalid line", func(t *testing.T) {
+		url := stream(t, "application/x-ndjson", "{\"price\": 1.5}\nnot json\n")
+
+		result := run(t, pipeline.HTTPTask{URL: url, Stream: "ndjson"})
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "record 2")
+	})
+
+	t.Run("SSE", func(t *testing.T) {
+		url := stream(t, "text/event-stream", ": keep-alive\n\nevent: price\nid: 1\ndata: {\"price\": 1.5}\n\ndata: multi\ndata: line\n\ndata: {\"price\": 2}")
+
+		result := run(t, pipeline.HTTPTask{URL: url, Stream: "sse"})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `[{"price":1.5},"multi\nline",{"price":2}]`, result.Value.(string))
+	})
+
+	t.Run("exceeds maxSize", func(t *testing.T) {
+		url := stream(t, "application/x-ndjson", "{\"price\": 1.5}\n{\"price\": 2}\n")
+
+		result := run(t, pipeline.HTTPTask{URL: url, Stream: "ndjson", MaxSize: "20"})
+		require.Error(t, result.Error)
+	})
+}
@@ -1,14 +1,28 @@
 package pipeline_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
@@ -17,6 +31,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -24,6 +39,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -412,3 +428,228 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Credentials(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(t *testing.T, creds toml.HTTPCredentials) pipeline.Config {
+		return configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			s.JobPipeline.HTTPCredentials = map[string]toml.HTTPCredentials{"provider": creds}
+		}).JobPipeline()
+	}
+	newServer := func(t *testing.T, requests chan<- *http.Request, bodies chan<- []byte) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			requests <- r
+			bodies <- body
+			_, err = w.Write([]byte(`{"result": 1}`))
+			assert.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("HMAC", func(t *testing.T) {
+		requests, bodies := make(chan *http.Request, 1), make(chan []byte, 1)
+		server := newServer(t, requests, bodies)
+
+		key := models.Secret("hmac-key")
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "POST",
+			URL:         server.URL + "/price?pair=ETH-USD",
+			RequestData: ethUSDPairing,
+			Credentials: "provider",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: []string{"127.0.0.1"}, HMAC: &toml.HTTPCredentialsHMAC{Key: &key, KeyID: ptr("key-1")}}), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"result": 1}`, result.Value)
+		assert.NotContains(t, result.Value, "hmac-key")
+
+		r, body := <-requests, <-bodies
+		timestamp := r.Header.Get("X-Timestamp")
+		require.NotEmpty(t, timestamp)
+		mac := hmac.New(sha256.New, []byte("hmac-key"))
+		mac.Write([]byte(timestamp + "\nPOST\n/price?pair=ETH-USD\n"))
+		mac.Write(body)
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get("X-Signature"))
+		assert.Equal(t, "key-1", r.Header.Get("X-Key-ID"))
+	})
+
+	t.Run("JWT", func(t *testing.T) {
+		requests, bodies := make(chan *http.Request, 1), make(chan []byte, 1)
+		server := newServer(t, requests, bodies)
+
+		key := models.Secret("jwt-key")
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "GET",
+			URL:         server.URL,
+			Credentials: "provider",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: []string{"127.0.0.1"}, JWT: &toml.HTTPCredentialsJWT{
+			Key:      &key,
+			KeyID:    ptr("kid-1"),
+			Issuer:   ptr("chainlink-node"),
+			Audience: ptr(server.URL),
+		}}), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+
+		r := <-requests
+		<-bodies
+		raw, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		require.True(t, found)
+		var claims jwt.RegisteredClaims
+		token, err := jwt.ParseWithClaims(raw, &claims, func(*jwt.Token) (interface{}, error) { return []byte("jwt-key"), nil },
+			jwt.WithValidMethods([]string{"HS256"}), jwt.WithIssuer("chainlink-node"), jwt.WithAudience(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, "kid-1", token.Header["kid"])
+		assert.NotEmpty(t, claims.ID)
+		assert.Equal(t, time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		certPEM, keyPEM := newClientCertificate(t)
+		clientCAs := x509.NewCertPool()
+		require.True(t, clientCAs.AppendCertsFromPEM(certPEM))
+
+		requests := make(chan *http.Request, 1)
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests <- r
+			_, err := w.Write([]byte(`{"result": 1}`))
+			assert.NoError(t, err)
+		}))
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+		server.StartTLS()
+		t.Cleanup(server.Close)
+		caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "GET",
+			URL:         server.URL,
+			Credentials: "provider",
+		}
+		key := models.Secret(keyPEM)
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: []string{"127.0.0.1"}, TLS: &toml.HTTPCredentialsTLS{
+			CertPEM: ptr(string(certPEM)),
+			KeyPEM:  &key,
+			CAPEM:   &caPEM,
+		}}), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		r := <-requests
+		require.Len(t, r.TLS.PeerCertificates, 1)
+
+		// the same task without credentials is rejected by the server
+		task.Credentials = ""
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	})
+
+	t.Run("host not allowed", func(t *testing.T) {
+		requests, bodies := make(chan *http.Request, 1), make(chan []byte, 1)
+		server := newServer(t, requests, bodies)
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		key := models.Secret("hmac-key")
+		for _, allowedHosts := range [][]string{
+			{"prices.example.com"},
+			{"127.0.0.2"},
+			{"127.0.0.1:1"},
+		} {
+			task := pipeline.HTTPTask{
+				BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+				Method:      "GET",
+				URL:         server.URL,
+				Credentials: "provider",
+			}
+			c := clhttptest.NewTestLocalOnlyHTTPClient()
+			task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: allowedHosts, HMAC: &toml.HTTPCredentialsHMAC{Key: &key}}), c, c)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.ErrorIs(t, result.Error, pipeline.ErrCredentialsHostNotAllowed, allowedHosts)
+		}
+		assert.Empty(t, requests)
+
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "GET",
+			URL:         server.URL,
+			Credentials: "provider",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: []string{serverURL.Host}, HMAC: &toml.HTTPCredentialsHMAC{Key: &key}}), c, c)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		<-requests
+	})
+
+	t.Run("redirect to host not allowed", func(t *testing.T) {
+		requests, bodies := make(chan *http.Request, 1), make(chan []byte, 1)
+		other := newServer(t, requests, bodies)
+		server := httptest.NewServer(http.RedirectHandler(other.URL, http.StatusFound))
+		t.Cleanup(server.Close)
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		key := models.Secret("hmac-key")
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "GET",
+			URL:         server.URL,
+			Credentials: "provider",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(newConfig(t, toml.HTTPCredentials{AllowedHosts: []string{serverURL.Host}, HMAC: &toml.HTTPCredentialsHMAC{Key: &key}}), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrCredentialsHostNotAllowed)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("unknown credentials", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "GET",
+			URL:         "http://example.com",
+			Credentials: "unknown",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(configtest.NewTestGeneralConfig(t).JobPipeline(), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrUnknownCredentials)
+	})
+}
+
+func newClientCertificate(t *testing.T) (certPEM []byte, keyPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
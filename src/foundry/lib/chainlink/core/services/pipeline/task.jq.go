@@ -0,0 +1,169 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/itchyny/gojq"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+var (
+	ErrJQNoResults       = errors.New("jq query produced no results")
+	ErrJQMultipleResults = errors.New("jq query produced more than one result")
+)
+
+// JQTask evaluates a jq expression (https://jqlang.github.io/jq/manual/)
+// against its input, or against the value given in `data`.
+//
+// The query must produce exactly one result, unless `allowMultiple` is
+// enabled, in which case all results are returned as an array. If `lax` is
+// enabled, a query that produces no results returns nil instead of an error.
+//
+// Return types:
+//
+//	int64
+//	float64
+//	*big.Int
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JQTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Query         string `json:"query"`
+	Data          string `json:"data"`
+	Lax           string `json:"lax"`
+	AllowMultiple string `json:"allowMultiple"`
+}
+
+var _ Task = (*JQTask)(nil)
+
+func (t *JQTask) Type() TaskType {
+	return TaskTypeJQ
+}
+
+func (t *JQTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query         StringParam
+		data          jqDataParam
+		lax           BoolParam
+		allowMultiple BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+		errors.Wrap(ResolveParam(&allowMultiple, From(NonemptyString(t.AllowMultiple), false)), "allowMultiple"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	parsed, err := gojq.Parse(string(query))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid jq query: %v", err)}, runInfo
+	}
+	// Never expose the node's environment variables through $ENV or env.
+	code, err := gojq.Compile(parsed, gojq.WithEnvironLoader(func() []string { return nil }))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid jq query: %v", err)}, runInfo
+	}
+
+	var results []interface{}
+	iter := code.RunWithContext(ctx, data.value)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, isErr := v.(error); isErr {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				break
+			}
+			return Result{Error: errors.Wrap(err, "jq query")}, runInfo
+		}
+		results = append(results, v)
+	}
+
+	var value interface{}
+	switch {
+	case bool(allowMultiple):
+		if results == nil {
+			results = []interface{}{}
+		}
+		value = results
+	case len(results) == 0:
+		if !bool(lax) {
+			return Result{Error: errors.Wrapf(ErrJQNoResults, "query %q", string(query))}, runInfo
+		}
+	case len(results) > 1:
+		return Result{Error: errors.Wrapf(ErrJQMultipleResults, "query %q produced %d results", string(query), len(results))}, runInfo
+	default:
+		value = results[0]
+	}
+
+	value, err = reinterpretJQValue(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// jqDataParam holds the JSON document a jq query runs against. Strings and
+// byte slices are parsed as JSON; any other value is re-encoded so that the
+// query operates on a private copy with the same types jsonparse would see.
+type jqDataParam struct {
+	value interface{}
+}
+
+func (p *jqDataParam) UnmarshalPipelineParam(val interface{}) error {
+	var raw []byte
+	switch v := val.(type) {
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(ErrBadInput, "cannot encode %T as JSON: %v", val, err)
+		}
+		raw = b
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&p.value); err != nil {
+		return errors.Wrapf(ErrBadInput, "invalid JSON: %v", err)
+	}
+	return nil
+}
+
+// reinterpretJQValue converts gojq's native number types (int, float64,
+// *big.Int) into the representation produced by the jsonparse task.
+func reinterpretJQValue(v interface{}) (interface{}, error) {
+	b, err := gojq.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return jsonserializable.ReinterpretJSONNumbers(decoded)
+}
@@ -0,0 +1,335 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJQTask(t *testing.T) {
+	t.Parallel()
+
+	const prices = `{"data":[{"symbol":"BTC","price":"65000.5"},{"symbol":"ETH","price":"3100.25"},{"symbol":"LINK","price":"14"}]}`
+
+	tests := []struct {
+		name              string
+		query             string
+		data              string
+		lax               string
+		allowMultiple     string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"simple path",
+			".data[0].symbol",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"BTC",
+			nil,
+			"",
+		},
+		{
+			"select by field",
+			`.data[] | select(.symbol == "ETH") | .price`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"3100.25",
+			nil,
+			"",
+		},
+		{
+			"arithmetic",
+			`.data[] | select(.symbol == "LINK") | .price | tonumber * 2`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			int64(28),
+			nil,
+			"",
+		},
+		{
+			"float result",
+			`.a / .b`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"a": 3, "b": 2}`}},
+			1.5,
+			nil,
+			"",
+		},
+		{
+			"large int result",
+			`.some_id`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"some_id":1564679049192120321}`}},
+			int64(1564679049192120321),
+			nil,
+			"",
+		},
+		{
+			"big int result",
+			`.n`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"n":115792089237316195423570985008687907853269984665640564039457584007913129639935}`}},
+			func() *big.Int {
+				n, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+				return n
+			}(),
+			nil,
+			"",
+		},
+		{
+			"array projection",
+			`[.data[].symbol]`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"BTC", "ETH", "LINK"},
+			nil,
+			"",
+		},
+		{
+			"object construction",
+			`{sym: .data[1].symbol}`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			map[string]interface{}{"sym": "ETH"},
+			nil,
+			"",
+		},
+		{
+			"map input",
+			`.foo.bar`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: map[string]interface{}{"foo": map[string]interface{}{"bar": 42}}}},
+			int64(42),
+			nil,
+			"",
+		},
+		{
+			"variable data",
+			`.data[2].price`,
+			"$(foo.bar)",
+			"",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"bar": prices},
+			}),
+			[]pipeline.Result{},
+			"14",
+			nil,
+			"",
+		},
+		{
+			"variable query",
+			"$(foo.query)",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"query": ".data | length"},
+			}),
+			[]pipeline.Result{{Value: prices}},
+			int64(3),
+			nil,
+			"",
+		},
+		{
+			"multiple results",
+			`.data[].symbol`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrJQMultipleResults,
+			"3 results",
+		},
+		{
+			"multiple results with allowMultiple=true",
+			`.data[].symbol`,
+			"",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"BTC", "ETH", "LINK"},
+			nil,
+			"",
+		},
+		{
+			"no results",
+			`.data[] | select(.symbol == "DOGE")`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrJQNoResults,
+			"",
+		},
+		{
+			"no results with lax=true",
+			`.data[] | select(.symbol == "DOGE")`,
+			"",
+			"true",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"no results with allowMultiple=true",
+			`.data[] | select(.symbol == "DOGE")`,
+			"",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{},
+			nil,
+			"",
+		},
+		{
+			"environment is not exposed",
+			`$ENV | length`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{}`}},
+			int64(0),
+			nil,
+			"",
+		},
+		{
+			"runtime error",
+			`.data | keys | .[0] | ascii_downcase`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"jq query",
+		},
+		{
+			"invalid query",
+			`.data[`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid jq query",
+		},
+		{
+			"invalid JSON",
+			`.`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+			"data",
+		},
+		{
+			"missing query",
+			"",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"query",
+		},
+		{
+			"malformed 'lax' param",
+			`.`,
+			"",
+			"sergey",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"lax",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JQTask{
+				BaseTask:      pipeline.NewBaseTask(0, "jq", nil, nil, 0),
+				Query:         test.query,
+				Data:          test.data,
+				Lax:           test.lax,
+				AllowMultiple: test.allowMultiple,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil || test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				if test.wantErrorCause != nil {
+					require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				}
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
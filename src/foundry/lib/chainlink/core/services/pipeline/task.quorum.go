@@ -0,0 +1,88 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+// Return types:
+//
+//	map[string]interface{}{
+//	    "result": decimal.Decimal
+//	    "agreeing": int
+//	    "discarded": []interface{} of map[string]interface{}{"index", "value", "reason"}
+//	}
+type QuorumTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	// MinAgreeing is the minimum number of values that must lie within
+	// Tolerance of the median of all values.
+	MinAgreeing string `json:"minAgreeing"`
+	// Tolerance is the maximum deviation from the median in percent, e.g.
+	// 0.5 for 0.5%.
+	Tolerance string `json:"tolerance"`
+}
+
+var _ Task = (*QuorumTask)(nil)
+
+func (t *QuorumTask) Type() TaskType {
+	return TaskTypeQuorum
+}
+
+func (t *QuorumTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		minAgreeing        Uint64Param
+		tolerance          DecimalParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&minAgreeing, From(VarExpr(t.MinAgreeing, vars), NonemptyString(t.MinAgreeing))), "minAgreeing"),
+		errors.Wrap(ResolveParam(&tolerance, From(VarExpr(t.Tolerance, vars), NonemptyString(t.Tolerance))), "tolerance"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if minAgreeing == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "minAgreeing must be positive")}, runInfo
+	} else if tolerance.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "tolerance must not be negative, got %v", tolerance.Decimal())}, runInfo
+	}
+
+	values, discarded, err := resolveAggregationInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	median := sortedMedian(sortDecimals(inputDecimals(values)))
+	maxDeviation := median.Abs().Mul(tolerance.Decimal()).Div(decimal.NewFromInt(100))
+
+	var agreeing []decimal.Decimal
+	for _, val := range values {
+		if val.value.Sub(median).Abs().GreaterThan(maxDeviation) {
+			discarded.add(val.index, val.value, DiscardReasonOutsideTolerance)
+		} else {
+			agreeing = append(agreeing, val.value)
+		}
+	}
+	if uint64(len(agreeing)) < uint64(minAgreeing) {
+		return Result{Error: errors.Wrapf(ErrQuorumNotReached, "%v of %v values within %v%% of median %v, need %v", len(agreeing), len(values), tolerance.Decimal(), median, minAgreeing)}, runInfo
+	}
+
+	return Result{Value: map[string]interface{}{
+		"result":    sortedMedian(sortDecimals(agreeing)),
+		"agreeing":  len(agreeing),
+		"discarded": []interface{}(discarded),
+	}}, runInfo
+}
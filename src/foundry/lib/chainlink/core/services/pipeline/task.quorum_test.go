@@ -0,0 +1,60 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestQuorumTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputs         []pipeline.Result
+		minAgreeing    string
+		tolerance      string
+		want           string
+		wantAgreeing   int
+		wantDiscarded  map[int]string
+		wantErrorCause error
+	}{
+		{"all sources agree", decimalResults(t, "100", "100.2", "99.9"), "3", "0.5", "100", 3, map[int]string{}, nil},
+		{"disagreeing source is discarded", decimalResults(t, "100", "100.2", "99.9", "110"), "3", "0.5", "100", 3, map[int]string{3: "outside tolerance"}, nil},
+		{"tolerance is inclusive", decimalResults(t, "99", "100", "101"), "3", "1", "100", 3, map[int]string{}, nil},
+		{"quorum not reached", decimalResults(t, "100", "105", "110"), "2", "1", "", 0, nil, pipeline.ErrQuorumNotReached},
+		{"errored inputs are discarded", append(decimalResults(t, "100", "100"), pipeline.Result{Error: errors.New("boom")}), "2", "0", "100", 2, map[int]string{2: "error"}, nil},
+		{"zero minAgreeing", decimalResults(t, "100"), "0", "1", "", 0, nil, pipeline.ErrBadInput},
+		{"negative tolerance", decimalResults(t, "100"), "1", "-1", "", 0, nil, pipeline.ErrBadInput},
+		{"no values", nil, "1", "1", "", 0, nil, pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.QuorumTask{
+				BaseTask:    pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				MinAgreeing: test.minAgreeing,
+				Tolerance:   test.tolerance,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(output.Error))
+				return
+			}
+			require.NoError(t, output.Error)
+			value := output.Value.(map[string]interface{})
+			assert.Equal(t, test.want, value["result"].(decimal.Decimal).String())
+			assert.Equal(t, test.wantAgreeing, value["agreeing"])
+			assert.Equal(t, test.wantDiscarded, discardedIndexes(t, output.Value))
+		})
+	}
+}
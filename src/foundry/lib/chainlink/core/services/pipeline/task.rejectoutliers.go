@@ -0,0 +1,121 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Outlier detection methods supported by the rejectoutliers task.
+const (
+	// OutlierMethodMAD discards values further than threshold times the
+	// median absolute deviation away from the median.
+	OutlierMethodMAD = "mad"
+	// OutlierMethodIQR discards values further than threshold times the
+	// interquartile range below the first or above the third quartile.
+	OutlierMethodIQR = "iqr"
+)
+
+const (
+	defaultMADThreshold = "3"
+	defaultIQRThreshold = "1.5"
+)
+
+// Return types:
+//
+//	map[string]interface{}{
+//	    "values": []interface{} of decimal.Decimal, in input order
+//	    "discarded": []interface{} of map[string]interface{}{"index", "value", "reason"}
+//	}
+type RejectOutliersTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	// Method is either "mad" (default) or "iqr".
+	Method string `json:"method"`
+	// Threshold defaults to 3 for "mad" and 1.5 for "iqr".
+	Threshold string `json:"threshold"`
+}
+
+var _ Task = (*RejectOutliersTask)(nil)
+
+func (t *RejectOutliersTask) Type() TaskType {
+	return TaskTypeRejectOutliers
+}
+
+func (t *RejectOutliersTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		method             StringParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), OutlierMethodMAD)), "method"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var threshold DecimalParam
+	switch method {
+	case OutlierMethodMAD:
+		err = ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultMADThreshold))
+	case OutlierMethodIQR:
+		err = ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultIQRThreshold))
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "method must be %q or %q, got %q", OutlierMethodMAD, OutlierMethodIQR, method)}, runInfo
+	}
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "threshold")}, runInfo
+	} else if threshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold must not be negative, got %v", threshold.Decimal())}, runInfo
+	}
+
+	values, discarded, err := resolveAggregationInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sorted := make([]aggregationInput, len(values))
+	copy(sorted, values)
+	sortAggregationInputs(sorted)
+	sortedDecimals := inputDecimals(sorted)
+
+	var lower, upper decimal.Decimal
+	if method == OutlierMethodMAD {
+		median := sortedMedian(sortedDecimals)
+		deviations := make([]decimal.Decimal, len(sortedDecimals))
+		for i, val := range sortedDecimals {
+			deviations[i] = val.Sub(median).Abs()
+		}
+		mad := sortedMedian(sortDecimals(deviations))
+		lower = median.Sub(mad.Mul(threshold.Decimal()))
+		upper = median.Add(mad.Mul(threshold.Decimal()))
+	} else {
+		q1 := sortedQuantile(sortedDecimals, decimal.NewFromFloat(0.25))
+		q3 := sortedQuantile(sortedDecimals, decimal.NewFromFloat(0.75))
+		iqr := q3.Sub(q1)
+		lower = q1.Sub(iqr.Mul(threshold.Decimal()))
+		upper = q3.Add(iqr.Mul(threshold.Decimal()))
+	}
+
+	var kept []aggregationInput
+	for _, val := range values {
+		if val.value.LessThan(lower) || val.value.GreaterThan(upper) {
+			discarded.add(val.index, val.value, DiscardReasonOutlier)
+		} else {
+			kept = append(kept, val)
+		}
+	}
+
+	return Result{Value: map[string]interface{}{
+		"values":    outputValues(kept),
+		"discarded": []interface{}(discarded),
+	}}, runInfo
+}
@@ -0,0 +1,63 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRejectOutliersTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputs         []pipeline.Result
+		method         string
+		threshold      string
+		want           []string
+		wantDiscarded  map[int]string
+		wantErrorCause error
+	}{
+		{"mad keeps close values", decimalResults(t, "100", "101", "99", "100.5"), "", "", []string{"100", "101", "99", "100.5"}, map[int]string{}, nil},
+		{"mad rejects outlier", decimalResults(t, "100", "101", "99", "100.5", "150"), "mad", "", []string{"100", "101", "99", "100.5"}, map[int]string{4: "outlier"}, nil},
+		{"mad with zero deviation", decimalResults(t, "100", "100", "100", "100.01"), "mad", "", []string{"100", "100", "100"}, map[int]string{3: "outlier"}, nil},
+		{"mad threshold", decimalResults(t, "10", "11", "12", "13", "20"), "mad", "1", []string{"11", "12", "13"}, map[int]string{0: "outlier", 4: "outlier"}, nil},
+		{"iqr rejects outliers on both sides", decimalResults(t, "1", "10", "11", "12", "13", "30"), "iqr", "", []string{"10", "11", "12", "13"}, map[int]string{0: "outlier", 5: "outlier"}, nil},
+		{"iqr keeps values within fences", decimalResults(t, "10", "11", "12", "13"), "iqr", "", []string{"10", "11", "12", "13"}, map[int]string{}, nil},
+		{"errored inputs are discarded", append(decimalResults(t, "1", "1"), pipeline.Result{Error: errors.New("boom")}), "mad", "", []string{"1", "1"}, map[int]string{2: "error"}, nil},
+		{"unknown method", decimalResults(t, "1"), "zscore", "", nil, nil, pipeline.ErrBadInput},
+		{"negative threshold", decimalResults(t, "1"), "iqr", "-1", nil, nil, pipeline.ErrBadInput},
+		{"no values", nil, "mad", "", nil, nil, pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.RejectOutliersTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Method:    test.method,
+				Threshold: test.threshold,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(output.Error))
+				return
+			}
+			require.NoError(t, output.Error)
+			var kept []string
+			for _, v := range output.Value.(map[string]interface{})["values"].([]interface{}) {
+				kept = append(kept, v.(decimal.Decimal).String())
+			}
+			assert.Equal(t, test.want, kept)
+			assert.Equal(t, test.wantDiscarded, discardedIndexes(t, output.Value))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Return types:
+//
+//	map[string]interface{}{
+//	    "result": decimal.Decimal
+//	    "discarded": []interface{} of map[string]interface{}{"index", "value", "reason"}
+//	}
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	// Trim is the fraction of values discarded from each end, e.g. 0.1
+	// discards the lowest and the highest 10% of the values.
+	Trim      string `json:"trim"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		valuesAndErrs      SliceParam
+		trim               DecimalParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim))), "trim"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim must be at least 0 and less than 0.5, got %v", trim.Decimal())}, runInfo
+	}
+
+	values, discarded, err := resolveAggregationInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sortAggregationInputs(values)
+	k := int(trim.Decimal().Mul(decimal.NewFromInt(int64(len(values)))).IntPart())
+	for _, val := range values[:k] {
+		discarded.add(val.index, val.value, DiscardReasonTrimmed)
+	}
+	for _, val := range values[len(values)-k:] {
+		discarded.add(val.index, val.value, DiscardReasonTrimmed)
+	}
+	values = values[k : len(values)-k]
+
+	total := decimal.NewFromInt(0)
+	for _, val := range values {
+		total = total.Add(val.value)
+	}
+	numValues := decimal.NewFromInt(int64(len(values)))
+
+	var mean decimal.Decimal
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		mean = total.DivRound(numValues, precision)
+	} else {
+		mean = total.Div(numValues)
+	}
+	return Result{Value: map[string]interface{}{
+		"result":    mean,
+		"discarded": []interface{}(discarded),
+	}}, runInfo
+}
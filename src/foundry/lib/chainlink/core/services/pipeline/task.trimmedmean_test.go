@@ -0,0 +1,94 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// decimalResults builds successful task inputs from the given numbers.
+func decimalResults(t *testing.T, values ...string) []pipeline.Result {
+	results := make([]pipeline.Result, len(values))
+	for i, v := range values {
+		results[i] = pipeline.Result{Value: mustDecimal(t, v)}
+	}
+	return results
+}
+
+// discardedIndexes returns the index and reason of every discarded input
+// reported in the output of an aggregation task.
+func discardedIndexes(t *testing.T, output interface{}) map[int]string {
+	discarded := make(map[int]string)
+	for _, d := range output.(map[string]interface{})["discarded"].([]interface{}) {
+		m := d.(map[string]interface{})
+		discarded[m["index"].(int)] = m["reason"].(string)
+	}
+	return discarded
+}
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputs         []pipeline.Result
+		trim           string
+		precision      string
+		want           string
+		wantDiscarded  map[int]string
+		wantErrorCause error
+	}{
+		{"no trimming", decimalResults(t, "1", "2", "6"), "0", "", "3", map[int]string{}, nil},
+		{"trims both ends", decimalResults(t, "100", "1", "2", "3", "-50"), "0.2", "", "2", map[int]string{0: "trimmed", 4: "trimmed"}, nil},
+		{"rounds trimmed count down", decimalResults(t, "1", "2", "3", "4"), "0.2", "", "2.5", map[int]string{}, nil},
+		{"precision", decimalResults(t, "1", "1", "2", "9"), "0.25", "2", "1.5", map[int]string{0: "trimmed", 3: "trimmed"}, nil},
+		{"errored inputs are discarded", append(decimalResults(t, "1", "2", "3"), pipeline.Result{Error: errors.New("boom")}), "0", "", "2", map[int]string{3: "error"}, nil},
+		{"trim too large", decimalResults(t, "1", "2"), "0.5", "", "", nil, pipeline.ErrBadInput},
+		{"negative trim", decimalResults(t, "1", "2"), "-0.1", "", "", nil, pipeline.ErrBadInput},
+		{"no values", nil, "0.1", "", "", nil, pipeline.ErrWrongInputCardinality},
+		{"too many errors", []pipeline.Result{{Error: errors.New("boom")}}, "0.1", "", "", nil, pipeline.ErrTooManyErrors},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:      test.trim,
+				Precision: test.precision,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(output.Error))
+				return
+			}
+			require.NoError(t, output.Error)
+			result := output.Value.(map[string]interface{})["result"].(decimal.Decimal)
+			assert.Equal(t, test.want, result.String())
+			assert.Equal(t, test.wantDiscarded, discardedIndexes(t, output.Value))
+		})
+	}
+
+	t.Run("with vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo": map[string]interface{}{"bar": []interface{}{"1", "2", "3", "1000"}, "trim": "0.25"},
+		})
+		task := pipeline.TrimmedMeanTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(foo.bar)",
+			Trim:     "$(foo.trim)",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, output.Error)
+		assert.Equal(t, "2.5", output.Value.(map[string]interface{})["result"].(decimal.Decimal).String())
+		assert.Equal(t, map[int]string{0: "trimmed", 3: "trimmed"}, discardedIndexes(t, output.Value))
+	})
+}
@@ -0,0 +1,98 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Return types:
+//
+//	map[string]interface{}{
+//	    "result": decimal.Decimal
+//	    "discarded": []interface{} of map[string]interface{}{"index", "value", "reason"}
+//	}
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	// Weights holds a non-negative weight for every value, in the same order.
+	Weights string `json:"weights"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "got %v weights for %v values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+	for i, weight := range weights {
+		if weight.IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weight %v of value %v is negative", weight, i)}, runInfo
+		}
+	}
+
+	values, discarded, err := resolveAggregationInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	total := decimal.NewFromInt(0)
+	for _, val := range values {
+		total = total.Add(weights[val.index])
+	}
+	if !total.IsPositive() {
+		return Result{Error: errors.Wrap(ErrBadInput, "weights of the non-errored values must not all be zero")}, runInfo
+	}
+
+	// the weighted median is the value at which the cumulative weight reaches
+	// half of the total weight; if it is reached exactly, the median lies
+	// halfway between that value and the next value with a positive weight
+	sortAggregationInputs(values)
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.NewFromInt(0)
+	var median decimal.Decimal
+	for i, val := range values {
+		cumulative = cumulative.Add(weights[val.index])
+		if cumulative.LessThan(half) {
+			continue
+		}
+		median = val.value
+		if cumulative.Equal(half) {
+			for _, next := range values[i+1:] {
+				if weights[next.index].IsPositive() {
+					median = median.Add(next.value).Div(decimal.NewFromInt(2))
+					break
+				}
+			}
+		}
+		break
+	}
+
+	return Result{Value: map[string]interface{}{
+		"result":    median,
+		"discarded": []interface{}(discarded),
+	}}, runInfo
+}
@@ -0,0 +1,70 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputs         []pipeline.Result
+		weights        string
+		want           string
+		wantDiscarded  map[int]string
+		wantErrorCause error
+	}{
+		{"equal weights", decimalResults(t, "3", "1", "2"), "[1, 1, 1]", "2", map[int]string{}, nil},
+		{"heavy source dominates", decimalResults(t, "1", "2", "3"), "[1, 1, 5]", "3", map[int]string{}, nil},
+		{"halfway between two values", decimalResults(t, "1", "2", "3", "4"), "[1, 1, 1, 1]", "2.5", map[int]string{}, nil},
+		{"skips zero weights when averaging", decimalResults(t, "1", "2", "3"), "[1, 0, 1]", "2", map[int]string{}, nil},
+		{"decimal weights", decimalResults(t, "10.5", "11.25", "12"), `["0.2", "0.4", "0.4"]`, "11.25", map[int]string{}, nil},
+		{"errored inputs are discarded with their weight", append(decimalResults(t, "1", "2"), pipeline.Result{Error: errors.New("boom")}), "[1, 1, 10]", "1.5", map[int]string{2: "error"}, nil},
+		{"wrong number of weights", decimalResults(t, "1", "2"), "[1]", "", nil, pipeline.ErrBadInput},
+		{"negative weight", decimalResults(t, "1", "2"), "[1, -1]", "", nil, pipeline.ErrBadInput},
+		{"zero total weight", decimalResults(t, "1", "2"), "[0, 0]", "", nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.WeightedMedianTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:  test.weights,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(output.Error))
+				return
+			}
+			require.NoError(t, output.Error)
+			result := output.Value.(map[string]interface{})["result"].(decimal.Decimal)
+			assert.Equal(t, test.want, result.String())
+			assert.Equal(t, test.wantDiscarded, discardedIndexes(t, output.Value))
+		})
+	}
+
+	t.Run("weights from vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"weights": map[string]interface{}{"a": 1, "b": 4},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Weights:  "[$(weights.a), $(weights.b)]",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, decimalResults(t, "100", "200"))
+		require.NoError(t, output.Error)
+		assert.Equal(t, "200", output.Value.(map[string]interface{})["result"].(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+
+// traceContextPropagator injects the W3C traceparent and tracestate headers
+// into the requests of http and bridge tasks, so external adapters can join
+// the trace of the run.
+var traceContextPropagator = propagation.TraceContext{}
+
+func newTracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
+// startRunSpan starts the root span of a pipeline run, the spans of its tasks
+// are children of it.
+func startRunSpan(ctx context.Context, tracer trace.Tracer, run *Run) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "pipeline.run", trace.WithAttributes(
+		attribute.Int64("pipeline.run.id", run.ID),
+		attribute.Int64("pipeline.spec.id", int64(run.PipelineSpecID)),
+		attribute.Int64("job.id", int64(run.PipelineSpec.JobID)),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+		attribute.String("job.type", run.PipelineSpec.JobType),
+	))
+}
+
+// endRunSpan records the outcome of the run and ends its span.
+func endRunSpan(span trace.Span, run *Run) {
+	span.SetAttributes(
+		attribute.String("pipeline.run.state", string(run.State)),
+		attribute.Bool("pipeline.run.pending", run.Pending),
+	)
+	if run.HasFatalErrors() {
+		span.SetStatus(codes.Error, run.FatalErrors.ToError().Error())
+	}
+	span.End()
+}
+
+// startTaskSpan starts the span of a task run.
+func startTaskSpan(ctx context.Context, tracer trace.Tracer, taskRun *memoryTaskRun) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("task.type", string(taskRun.task.Type())),
+		attribute.String("task.dot_id", taskRun.task.DotID()),
+		attribute.Int64("task.retries", int64(taskRun.attempts)),
+	}
+	if bridgeTask, ok := taskRun.task.(*BridgeTask); ok {
+		attrs = append(attrs, attribute.String("task.bridge_name", bridgeTask.Name))
+	}
+	return tracer.Start(ctx, "pipeline.task."+string(taskRun.task.Type()), trace.WithAttributes(attrs...))
+}
+
+// endTaskSpan records the outcome of the task run and ends its span.
+func endTaskSpan(span trace.Span, result Result, runInfo RunInfo) {
+	span.SetAttributes(
+		attribute.Bool("task.pending", runInfo.IsPending),
+		attribute.Bool("task.retryable", runInfo.IsRetryable),
+	)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+	}
+	span.End()
+}
+
+// injectTraceContext sets the W3C trace context headers of the span in ctx on
+// the request headers.
+func injectTraceContext(ctx context.Context, header http.Header) {
+	traceContextPropagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
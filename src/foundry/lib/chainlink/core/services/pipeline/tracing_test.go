@@ -0,0 +1,156 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu             sync.Mutex
+		receivedTraces = map[string]trace.SpanContext{}
+	)
+	newServer := func(name string, status int) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(r.Header))
+			mu.Lock()
+			receivedTraces[name] = trace.SpanContextFromContext(ctx)
+			mu.Unlock()
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"data": {"result": 10}}`))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	bridgeServer := newServer("ds1", http.StatusOK)
+	httpServer := newServer("ds2", http.StatusInternalServerError)
+
+	bridgeURL, err := url.Parse(bridgeServer.URL)
+	require.NoError(t, err)
+	bt := bridges.BridgeType{Name: bridges.MustParseBridgeName("tracing"), URL: models.WebURL(*bridgeURL)}
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", mock.Anything, bt.Name).Return(bt, nil)
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(nil, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+	exporter := tracetest.NewInMemoryExporter()
+	r.HelperSetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	spec := pipeline.Spec{
+		JobID:   42,
+		JobName: "traced",
+		JobType: "webhook",
+		DotDagSource: fmt.Sprintf(`
+ds1 [type=bridge name="%s"];
+ds1_parse [type=jsonparse path="data,result"];
+ds2 [type=http method=GET url="%s"];
+ds1 -> ds1_parse;
+`, bt.Name, httpServer.URL),
+	}
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.True(t, run.HasFatalErrors())
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	require.Len(t, spans, 4)
+
+	root, ok := spans["pipeline.run"]
+	require.True(t, ok)
+	assert.False(t, root.Parent.IsValid())
+	assert.Contains(t, root.Attributes, attribute.Int64("job.id", 42))
+	assert.Contains(t, root.Attributes, attribute.String("job.name", "traced"))
+	assert.Contains(t, root.Attributes, attribute.String("pipeline.run.state", string(pipeline.RunStatusErrored)))
+	assert.Equal(t, codes.Error, root.Status.Code)
+
+	taskSpans := map[string]tracetest.SpanStub{}
+	for name, span := range spans {
+		if name == "pipeline.run" {
+			continue
+		}
+		assert.Equal(t, root.SpanContext.TraceID(), span.SpanContext.TraceID(), name)
+		assert.Equal(t, root.SpanContext.SpanID(), span.Parent.SpanID(), name)
+		for _, attr := range span.Attributes {
+			if attr.Key == "task.dot_id" {
+				taskSpans[attr.Value.AsString()] = span
+			}
+		}
+	}
+	require.Len(t, taskSpans, 3)
+
+	assert.Equal(t, "pipeline.task.bridge", taskSpans["ds1"].Name)
+	assert.Contains(t, taskSpans["ds1"].Attributes, attribute.String("task.bridge_name", "tracing"))
+	assert.Contains(t, taskSpans["ds1"].Attributes, attribute.Int64("task.retries", 0))
+	assert.Equal(t, codes.Unset, taskSpans["ds1"].Status.Code)
+	assert.Equal(t, "pipeline.task.jsonparse", taskSpans["ds1_parse"].Name)
+	assert.Equal(t, "pipeline.task.http", taskSpans["ds2"].Name)
+	assert.Equal(t, codes.Error, taskSpans["ds2"].Status.Code)
+	require.Len(t, taskSpans["ds2"].Events, 1)
+	assert.Equal(t, "exception", taskSpans["ds2"].Events[0].Name)
+
+	// the external adapter and the http server joined the trace of the run
+	mu.Lock()
+	defer mu.Unlock()
+	for _, dotID := range []string{"ds1", "ds2"} {
+		received, ok := receivedTraces[dotID]
+		require.True(t, ok, dotID)
+		assert.Equal(t, root.SpanContext.TraceID(), received.TraceID(), dotID)
+		assert.Equal(t, taskSpans[dotID].SpanContext.SpanID(), received.SpanID(), dotID)
+	}
+}
+
+func Test_PipelineRunner_DryRunIsNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(nil, bridgesMocks.NewORM(t), cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+	exporter := tracetest.NewInMemoryExporter()
+	r.HelperSetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	spec := pipeline.Spec{
+		JobID:   4242,
+		JobName: "dry run",
+		DotDagSource: `
+fe [type=foreach items="[1, 2]" pipeline=<fail [type=fail msg="uh oh"]>];
+`,
+	}
+	errorsBefore := promtestutil.ToFloat64(pipeline.PromPipelineRunErrors.WithLabelValues("4242", "dry run"))
+	result, err := r.DryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, err)
+	require.True(t, result.Run.HasFatalErrors())
+
+	// neither the dry run nor the iterations of its foreach task are runs of the job
+	assert.Empty(t, exporter.GetSpans())
+	assert.Equal(t, errorsBefore, promtestutil.ToFloat64(pipeline.PromPipelineRunErrors.WithLabelValues("4242", "dry run")))
+}
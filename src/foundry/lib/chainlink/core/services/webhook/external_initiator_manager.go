@@ -1,18 +1,22 @@
 package webhook
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -22,6 +26,7 @@ import (
 type ExternalInitiatorManager interface {
 	Notify(ctx context.Context, webhookSpecID int32) error
 	DeleteJob(ctx context.Context, webhookSpecID int32) error
+	NotifyRunFinished(ctx context.Context, ei bridges.ExternalInitiator, jobID uuid.UUID, runID int64) error
 	FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error)
 }
 
@@ -30,27 +35,78 @@ type HTTPClient interface {
 }
 
 type externalInitiatorManager struct {
+	services.StateMachine
 	ds         sqlutil.DataSource
 	httpclient HTTPClient
+	lggr       logger.Logger
+	stopCh     services.StopChan
+	wgDone     sync.WaitGroup
 }
 
 var _ ExternalInitiatorManager = (*externalInitiatorManager)(nil)
+var _ services.ServiceCtx = (*externalInitiatorManager)(nil)
 
 // NewExternalInitiatorManager returns the concrete externalInitiatorManager
-func NewExternalInitiatorManager(ds sqlutil.DataSource, httpclient HTTPClient) *externalInitiatorManager {
+func NewExternalInitiatorManager(ds sqlutil.DataSource, httpclient HTTPClient, lggr logger.Logger) *externalInitiatorManager {
 	return &externalInitiatorManager{
 		ds:         ds,
 		httpclient: httpclient,
+		lggr:       lggr.Named("ExternalInitiatorManager"),
+		stopCh:     make(services.StopChan),
+	}
+}
+
+// Start starts retrying the notifications of the outbox.
+func (m *externalInitiatorManager) Start(context.Context) error {
+	return m.StartOnce("ExternalInitiatorManager", func() error {
+		m.wgDone.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *externalInitiatorManager) Close() error {
+	return m.StopOnce("ExternalInitiatorManager", func() error {
+		close(m.stopCh)
+		m.wgDone.Wait()
+		return nil
+	})
+}
+
+func (m *externalInitiatorManager) Name() string { return m.lggr.Name() }
+
+func (m *externalInitiatorManager) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+func (m *externalInitiatorManager) run() {
+	defer m.wgDone.Done()
+	ctx, cancel := m.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			if err := m.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+				m.lggr.Errorw("Failed to deliver external initiator notifications", "err", err)
+			}
+		}
 	}
 }
 
 // Notify sends a POST notification to the External Initiator
-// responsible for initiating the Job Spec.
+// responsible for initiating the Job Spec. Failed notifications are retried
+// from the outbox.
 func (m *externalInitiatorManager) Notify(ctx context.Context, webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(ctx, webhookSpecID)
 	if err != nil {
 		return err
 	}
+	var notifications []Notification
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
 		if ei.URL == nil {
@@ -65,27 +121,51 @@ func (m *externalInitiatorManager) Notify(ctx context.Context, webhookSpecID int
 		if err != nil {
 			return errors.Wrap(err, "new Job Spec notification")
 		}
-		req, err := newNotifyHTTPRequest(ctx, buf, ei)
-		if err != nil {
-			return errors.Wrap(err, "creating notify HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrap(err, "could not notify '%s' (%s)")
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%d: %s'", ei.Name, ei.URL, resp.StatusCode, resp.Status)
-		}
+		notifications = append(notifications, Notification{
+			ExternalInitiatorID: ei.ID,
+			Kind:                NotificationJobCreated,
+			Method:              http.MethodPost,
+			URL:                 ei.URL.String(),
+			Body:                buf,
+		})
 	}
-	return nil
+	return m.enqueueAndDeliver(ctx, notifications)
+}
+
+// NotifyRunFinished sends a POST notification with the outcome of a run to
+// the External Initiator which triggered it, once the run has finished.
+func (m *externalInitiatorManager) NotifyRunFinished(ctx context.Context, ei bridges.ExternalInitiator, jobID uuid.UUID, runID int64) error {
+	if ei.URL == nil {
+		return nil
+	}
+	return m.enqueueAndDeliver(ctx, []Notification{{
+		ExternalInitiatorID: ei.ID,
+		Kind:                NotificationRunFinished,
+		Method:              http.MethodPost,
+		URL:                 fmt.Sprintf("%s/%s/runs", ei.URL.String(), jobID),
+		PipelineRunID:       null.IntFrom(runID),
+	}})
+}
+
+// enqueueAndDeliver stores the notifications in the outbox and makes their
+// first attempt right away.
+func (m *externalInitiatorManager) enqueueAndDeliver(ctx context.Context, notifications []Notification) (err error) {
+	if len(notifications) == 0 {
+		return nil
+	}
+	notifications, err = m.enqueue(ctx, notifications)
+	if err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		err = multierr.Append(err, m.deliver(ctx, n))
+	}
+	return errors.Wrap(err, "failed notifications will be retried")
 }
 
 func (m *externalInitiatorManager) Load(ctx context.Context, webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
 	err = sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *externalInitiatorManager {
-		return NewExternalInitiatorManager(ds, m.httpclient)
+		return NewExternalInitiatorManager(ds, m.httpclient, m.lggr)
 	}, m.ds, nil, func(tx *externalInitiatorManager) error {
 		if err = tx.ds.GetContext(ctx, &jobID, "SELECT external_job_id FROM jobs WHERE webhook_spec_id = $1", webhookSpecID); err != nil {
 			if err = errors.Wrapf(err, "failed to load job ID from job for webhook spec with ID %d", webhookSpecID); err != nil {
@@ -137,28 +217,20 @@ func (m *externalInitiatorManager) DeleteJob(ctx context.Context, webhookSpecID
 	if err != nil {
 		return err
 	}
+	var notifications []Notification
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
 		if ei.URL == nil {
 			continue
 		}
-
-		req, err := newDeleteJobFromExternalInitiatorHTTPRequest(ctx, ei, jobID)
-		if err != nil {
-			return errors.Wrap(err, "creating delete HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrapf(err, "could not delete job from remote external initiator at %s", req.URL)
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" delete '%s' (%s) received bad response '%d: %s'", ei.Name, ei.URL, resp.StatusCode, resp.Status)
-		}
+		notifications = append(notifications, Notification{
+			ExternalInitiatorID: ei.ID,
+			Kind:                NotificationJobDeleted,
+			Method:              http.MethodDelete,
+			URL:                 fmt.Sprintf("%s/%s", ei.URL.String(), jobID),
+		})
 	}
-	return nil
+	return m.enqueueAndDeliver(ctx, notifications)
 }
 
 func (m *externalInitiatorManager) FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error) {
@@ -174,26 +246,6 @@ type JobSpecNotice struct {
 	Params models.JSON `json:"params,omitempty"`
 }
 
-func newNotifyHTTPRequest(ctx context.Context, buf []byte, ei bridges.ExternalInitiator) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ei.URL.String(), bytes.NewBuffer(buf))
-	if err != nil {
-		return nil, err
-	}
-	setHeaders(req, ei)
-	return req, nil
-}
-
-func newDeleteJobFromExternalInitiatorHTTPRequest(ctx context.Context, ei bridges.ExternalInitiator, jobID uuid.UUID) (*http.Request, error) {
-	url := fmt.Sprintf("%s/%s", ei.URL.String(), jobID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		return nil, err
-	}
-	setHeaders(req, ei)
-	return req, nil
-}
-
 func setHeaders(req *http.Request, ei bridges.ExternalInitiator) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set(static.ExternalInitiatorAccessKeyHeader, ei.OutgoingToken)
@@ -206,6 +258,9 @@ var _ ExternalInitiatorManager = (*NullExternalInitiatorManager)(nil)
 
 func (NullExternalInitiatorManager) Notify(context.Context, int32) error    { return nil }
 func (NullExternalInitiatorManager) DeleteJob(context.Context, int32) error { return nil }
+func (NullExternalInitiatorManager) NotifyRunFinished(context.Context, bridges.ExternalInitiator, uuid.UUID, int64) error {
+	return nil
+}
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,7 +18,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	_ "github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 )
@@ -38,7 +41,7 @@ func Test_ExternalInitiatorManager_Load(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiBar.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiFoo.ID, webhookSpecOneEI.ID, `{"ei": "foo", "name": "webhookSpecOneEI"}`)
 
-	eim := webhook.NewExternalInitiatorManager(db, nil)
+	eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t))
 
 	eiWebhookSpecs, jobID, err := eim.Load(ctx, webhookSpecNoEIs.ID)
 	require.NoError(t, err)
@@ -77,7 +80,7 @@ func Test_ExternalInitiatorManager_Notify(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiNoURL.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 
 	client := webhookmocks.NewHTTPClient(t)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
 
 	// Does nothing with no EI
 	require.NoError(t, eim.Notify(ctx, webhookSpecNoEIs.ID))
@@ -116,7 +119,7 @@ func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiNoURL.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 
 	client := webhookmocks.NewHTTPClient(t)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
 
 	// Does nothing with no EI
 	require.NoError(t, eim.DeleteJob(ctx, webhookSpecNoEIs.ID))
@@ -127,3 +130,132 @@ func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
 	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
 	require.NoError(t, eim.DeleteJob(ctx, webhookSpecTwoEIs.ID))
 }
+
+func Test_ExternalInitiatorManager_Retries(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	borm := bridges.NewORM(db)
+
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec.ID, `{}`)
+
+	client := webhookmocks.NewHTTPClient(t)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+
+	client.On("Do", mock.Anything).Once().Return(&http.Response{StatusCode: 503, Status: "503 Service Unavailable", Body: io.NopCloser(strings.NewReader("down for maintenance"))}, nil)
+	require.Error(t, eim.Notify(ctx, webhookSpec.ID))
+
+	var notifications []webhook.Notification
+	require.NoError(t, db.Select(&notifications, `SELECT * FROM external_initiator_notifications`))
+	require.Len(t, notifications, 1)
+	assert.Equal(t, webhook.NotificationJobCreated, notifications[0].Kind)
+	assert.Equal(t, int64(1), notifications[0].Attempts)
+	assert.True(t, notifications[0].NextAttemptAt.After(time.Now()))
+	assert.Contains(t, notifications[0].LastError.String, "down for maintenance")
+
+	unhealthy, err := borm.FindExternalInitiatorByName(ctx, ei.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.ExternalInitiatorHealthUnhealthy, unhealthy.Health())
+	assert.Equal(t, int64(1), unhealthy.ConsecutiveFailures)
+	assert.Contains(t, unhealthy.LastError.String, "503")
+
+	// not due yet
+	require.NoError(t, eim.DeliverPending(ctx))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 1)
+
+	pgtest.MustExec(t, db, `UPDATE external_initiator_notifications SET next_attempt_at = now()`)
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
+		return r.Method == "POST" && r.URL.String() == ei.URL.String() && r.Header["X-Chainlink-Ea-Accesskey"][0] == "token"
+	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.DeliverPending(ctx))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 0)
+
+	healthy, err := borm.FindExternalInitiatorByName(ctx, ei.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.ExternalInitiatorHealthHealthy, healthy.Health())
+	assert.Zero(t, healthy.ConsecutiveFailures)
+	assert.True(t, healthy.LastSuccessAt.Valid)
+}
+
+func Test_ExternalInitiatorManager_DeliversOnce(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	borm := bridges.NewORM(db)
+
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec.ID, `{}`)
+
+	client := webhookmocks.NewHTTPClient(t)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+
+	// the outbox is polled while the first attempt is in flight
+	client.On("Do", mock.Anything).Once().Run(func(mock.Arguments) {
+		require.NoError(t, eim.DeliverPending(ctx))
+	}).Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.Notify(ctx, webhookSpec.ID))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 0)
+
+	// a due notification is claimed by the delivery polling it
+	client.On("Do", mock.Anything).Once().Return(&http.Response{StatusCode: 503, Status: "503 Service Unavailable", Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.Error(t, eim.Notify(ctx, webhookSpec.ID))
+	pgtest.MustExec(t, db, `UPDATE external_initiator_notifications SET next_attempt_at = now()`)
+	client.On("Do", mock.Anything).Once().Run(func(mock.Arguments) {
+		require.NoError(t, eim.DeliverPending(ctx))
+	}).Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.DeliverPending(ctx))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 0)
+}
+
+func Test_ExternalInitiatorManager_NotifyRunFinished(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	borm := bridges.NewORM(db)
+
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	client := webhookmocks.NewHTTPClient(t)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+
+	// Does nothing for external initiators without a URL
+	require.NoError(t, eim.NotifyRunFinished(ctx, bridges.ExternalInitiator{ID: ei.ID}, jb.ExternalJobID, 1))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 0)
+
+	// Waits for the run to finish
+	running := cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusRunning, jb.ID)
+	require.NoError(t, eim.NotifyRunFinished(ctx, ei, jb.ExternalJobID, running.ID))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 1)
+	pgtest.MustExec(t, db, `DELETE FROM external_initiator_notifications`)
+
+	completed := cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
+		body, err := r.GetBody()
+		require.NoError(t, err)
+		b, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		assert.Equal(t, jb.ExternalJobID.String(), gjson.GetBytes(b, "jobId").Str)
+		assert.Equal(t, completed.ID, gjson.GetBytes(b, "runId").Int())
+		assert.Equal(t, "completed", gjson.GetBytes(b, "status").Str)
+		assert.Equal(t, "foo", gjson.GetBytes(b, "outputs").Str)
+
+		expectedURL := fmt.Sprintf("%s/%s/runs", ei.URL.String(), jb.ExternalJobID.String())
+		return r.Method == "POST" && r.URL.String() == expectedURL && r.Header["X-Chainlink-Ea-Secret"][0] == "secret"
+	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.NotifyRunFinished(ctx, ei, jb.ExternalJobID, completed.ID))
+	cltest.AssertCount(t, db, "external_initiator_notifications", 0)
+}
@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const (
+	// outboxPollInterval is how often due notifications are delivered.
+	outboxPollInterval = 5 * time.Second
+	// outboxBatchSize is the maximum number of notifications delivered per
+	// poll.
+	outboxBatchSize = 100
+	// outboxRetryBaseDelay is the delay before the first retry, it doubles
+	// with each failed attempt.
+	outboxRetryBaseDelay = 5 * time.Second
+	// outboxRetryMaxDelay caps the delay between retries.
+	outboxRetryMaxDelay = time.Hour
+	// outboxMaxAttempts is the number of attempts after which a notification
+	// is dropped.
+	outboxMaxAttempts = 20
+	// outboxClaimTimeout is how long a notification being delivered is hidden
+	// from other deliveries. It covers the delivery of a whole batch, and a
+	// notification whose delivery was interrupted is retried once it expires.
+	outboxClaimTimeout = 10 * time.Minute
+	// maxErrorResponseSize bounds the response body recorded as the error of
+	// a failed notification.
+	maxErrorResponseSize = 512
+)
+
+// NotificationKind is the kind of a notification to an external initiator.
+type NotificationKind string
+
+const (
+	// NotificationJobCreated notifies the external initiator of a new job.
+	NotificationJobCreated NotificationKind = "job_created"
+	// NotificationJobDeleted notifies the external initiator of a deleted job.
+	NotificationJobDeleted NotificationKind = "job_deleted"
+	// NotificationRunFinished notifies the external initiator of the outcome of
+	// a run it triggered.
+	NotificationRunFinished NotificationKind = "run_finished"
+)
+
+// Notification is a request to an external initiator in the outbox. It is
+// retried with an exponential backoff until the external initiator accepts
+// it, or outboxMaxAttempts is reached.
+type Notification struct {
+	ID                  int64
+	ExternalInitiatorID int64
+	Kind                NotificationKind
+	Method              string
+	URL                 string
+	Body                []byte
+	// PipelineRunID is the run of a NotificationRunFinished, whose body is
+	// only built once the run has finished.
+	PipelineRunID null.Int
+	Attempts      int64
+	NextAttemptAt time.Time
+	LastError     null.String
+	CreatedAt     time.Time
+}
+
+// RunStatusNotice is sent to the External Initiator when a run it triggered
+// finishes.
+type RunStatusNotice struct {
+	JobID      uuid.UUID                         `json:"jobId"`
+	RunID      int64                             `json:"runId"`
+	Status     pipeline.RunStatus                `json:"status"`
+	Outputs    jsonserializable.JSONSerializable `json:"outputs"`
+	Errors     pipeline.RunErrors                `json:"errors"`
+	FinishedAt null.Time                         `json:"finishedAt"`
+}
+
+// retryDelay returns the delay before the next attempt of a notification
+// which failed attempts times.
+func retryDelay(attempts int64) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := int64(1); i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMaxDelay)
+}
+
+// enqueue stores the notifications in the outbox, claimed by the caller for
+// their first attempt.
+func (m *externalInitiatorManager) enqueue(ctx context.Context, notifications []Notification) ([]Notification, error) {
+	for i := range notifications {
+		n := &notifications[i]
+		err := m.ds.GetContext(ctx, n, `
+INSERT INTO external_initiator_notifications (external_initiator_id, kind, method, url, body, pipeline_run_id, next_attempt_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+RETURNING *`, n.ExternalInitiatorID, n.Kind, n.Method, n.URL, n.Body, n.PipelineRunID, time.Now().Add(outboxClaimTimeout))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to enqueue %s notification", n.Kind)
+		}
+	}
+	return notifications, nil
+}
+
+// DeliverPending attempts to deliver the due notifications of the outbox. They
+// are claimed first, so that concurrent deliveries never send them twice.
+func (m *externalInitiatorManager) DeliverPending(ctx context.Context) error {
+	var notifications []Notification
+	if err := m.ds.SelectContext(ctx, &notifications, `
+UPDATE external_initiator_notifications SET next_attempt_at = $2
+WHERE id IN (
+	SELECT id FROM external_initiator_notifications WHERE next_attempt_at <= now()
+	ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED
+)
+RETURNING *`, outboxBatchSize, time.Now().Add(outboxClaimTimeout)); err != nil {
+		return errors.Wrap(err, "failed to claim due notifications")
+	}
+	slices.SortFunc(notifications, func(a, b Notification) int { return cmp.Compare(a.ID, b.ID) })
+	for _, n := range notifications {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := m.deliver(ctx, n); err != nil {
+			m.lggr.Warnw("Failed to notify external initiator", "kind", n.Kind, "url", n.URL, "attempts", n.Attempts+1, "err", err)
+		}
+	}
+	return nil
+}
+
+// deliver makes one attempt at sending the notification, and records its
+// outcome on the outbox and the health of the external initiator.
+func (m *externalInitiatorManager) deliver(ctx context.Context, n Notification) error {
+	var ei bridges.ExternalInitiator
+	if err := m.ds.GetContext(ctx, &ei, `SELECT * FROM external_initiators WHERE id = $1`, n.ExternalInitiatorID); err != nil {
+		return errors.Wrapf(err, "failed to load external initiator %d", n.ExternalInitiatorID)
+	}
+
+	body := n.Body
+	if n.Kind == NotificationRunFinished {
+		var finished bool
+		var err error
+		body, finished, err = m.runStatusNotice(ctx, n)
+		if errors.Is(err, sql.ErrNoRows) {
+			// the run was reaped before it finished, there is nothing to report
+			return m.deleteNotification(ctx, n.ID)
+		} else if err != nil {
+			return err
+		}
+		if !finished {
+			_, err = m.ds.ExecContext(ctx, `UPDATE external_initiator_notifications SET next_attempt_at = $2 WHERE id = $1`,
+				n.ID, time.Now().Add(outboxPollInterval))
+			return err
+		}
+	}
+
+	sendErr := m.send(ctx, ei, n.Method, n.URL, body)
+	if sendErr == nil {
+		if _, err := m.ds.ExecContext(ctx, `UPDATE external_initiators SET last_success_at = now(), consecutive_failures = 0 WHERE id = $1`, ei.ID); err != nil {
+			return errors.Wrap(err, "failed to record external initiator success")
+		}
+		return m.deleteNotification(ctx, n.ID)
+	}
+
+	if _, err := m.ds.ExecContext(ctx, `UPDATE external_initiators SET last_failure_at = now(), consecutive_failures = consecutive_failures + 1, last_error = $2 WHERE id = $1`,
+		ei.ID, sendErr.Error()); err != nil {
+		return errors.Wrap(err, "failed to record external initiator failure")
+	}
+	attempts := n.Attempts + 1
+	if attempts >= outboxMaxAttempts {
+		m.lggr.Errorw("Dropping external initiator notification after too many attempts", "kind", n.Kind, "url", n.URL, "attempts", attempts, "err", sendErr)
+		if err := m.deleteNotification(ctx, n.ID); err != nil {
+			return err
+		}
+		return sendErr
+	}
+	if _, err := m.ds.ExecContext(ctx, `UPDATE external_initiator_notifications SET attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $1`,
+		n.ID, attempts, time.Now().Add(retryDelay(attempts)), sendErr.Error()); err != nil {
+		return errors.Wrap(err, "failed to reschedule notification")
+	}
+	return sendErr
+}
+
+// runStatusNotice returns the body of a NotificationRunFinished, and whether
+// the run has finished.
+func (m *externalInitiatorManager) runStatusNotice(ctx context.Context, n Notification) ([]byte, bool, error) {
+	var run struct {
+		ExternalJobID uuid.UUID
+		State         pipeline.RunStatus
+		Outputs       jsonserializable.JSONSerializable
+		FatalErrors   pipeline.RunErrors
+		FinishedAt    null.Time
+	}
+	if err := m.ds.GetContext(ctx, &run, `
+SELECT jobs.external_job_id, pipeline_runs.state, pipeline_runs.outputs, pipeline_runs.fatal_errors, pipeline_runs.finished_at
+FROM pipeline_runs JOIN jobs ON jobs.id = pipeline_runs.pruning_key
+WHERE pipeline_runs.id = $1`, n.PipelineRunID); err != nil {
+		return nil, false, err
+	}
+	if !run.State.Finished() {
+		return nil, false, nil
+	}
+	body, err := json.Marshal(RunStatusNotice{
+		JobID:      run.ExternalJobID,
+		RunID:      n.PipelineRunID.Int64,
+		Status:     run.State,
+		Outputs:    run.Outputs,
+		Errors:     run.FatalErrors,
+		FinishedAt: run.FinishedAt,
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "new run status notification")
+	}
+	return body, true, nil
+}
+
+func (m *externalInitiatorManager) send(ctx context.Context, ei bridges.ExternalInitiator, method, url string, body []byte) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return errors.Wrap(err, "creating notification HTTP request")
+	}
+	setHeaders(req, ei)
+	resp, err := m.httpclient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not notify '%s' (%s)", ei.Name, url)
+	}
+	defer resp.Body.Close()
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorResponseSize))
+		return fmt.Errorf("notify '%s' (%s) received bad response '%d: %s': %s", ei.Name, url, resp.StatusCode, resp.Status, b)
+	}
+	return nil
+}
+
+func (m *externalInitiatorManager) deleteNotification(ctx context.Context, id int64) error {
+	_, err := m.ds.ExecContext(ctx, `DELETE FROM external_initiator_notifications WHERE id = $1`, id)
+	return errors.Wrap(err, "failed to delete notification")
+}
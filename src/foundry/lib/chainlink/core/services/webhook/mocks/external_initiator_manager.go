@@ -8,6 +8,8 @@ import (
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -174,6 +176,55 @@ func (_c *ExternalInitiatorManager_Notify_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// NotifyRunFinished provides a mock function with given fields: ctx, ei, jobID, runID
+func (_m *ExternalInitiatorManager) NotifyRunFinished(ctx context.Context, ei bridges.ExternalInitiator, jobID uuid.UUID, runID int64) error {
+	ret := _m.Called(ctx, ei, jobID, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyRunFinished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bridges.ExternalInitiator, uuid.UUID, int64) error); ok {
+		r0 = rf(ctx, ei, jobID, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExternalInitiatorManager_NotifyRunFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyRunFinished'
+type ExternalInitiatorManager_NotifyRunFinished_Call struct {
+	*mock.Call
+}
+
+// NotifyRunFinished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ei bridges.ExternalInitiator
+//   - jobID uuid.UUID
+//   - runID int64
+func (_e *ExternalInitiatorManager_Expecter) NotifyRunFinished(ctx interface{}, ei interface{}, jobID interface{}, runID interface{}) *ExternalInitiatorManager_NotifyRunFinished_Call {
+	return &ExternalInitiatorManager_NotifyRunFinished_Call{Call: _e.mock.On("NotifyRunFinished", ctx, ei, jobID, runID)}
+}
+
+func (_c *ExternalInitiatorManager_NotifyRunFinished_Call) Run(run func(ctx context.Context, ei bridges.ExternalInitiator, jobID uuid.UUID, runID int64)) *ExternalInitiatorManager_NotifyRunFinished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bridges.ExternalInitiator), args[2].(uuid.UUID), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_NotifyRunFinished_Call) Return(_a0 error) *ExternalInitiatorManager_NotifyRunFinished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_NotifyRunFinished_Call) RunAndReturn(run func(context.Context, bridges.ExternalInitiator, uuid.UUID, int64) error) *ExternalInitiatorManager_NotifyRunFinished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewExternalInitiatorManager creates a new instance of ExternalInitiatorManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewExternalInitiatorManager(t interface {
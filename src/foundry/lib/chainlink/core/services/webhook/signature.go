@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+var (
+	// ErrSignatureExpired is returned for requests signed outside the
+	// tolerance of the job.
+	ErrSignatureExpired = errors.New("signature timestamp is outside the tolerance")
+	// ErrSignatureReplayed is returned for requests reusing a nonce.
+	ErrSignatureReplayed = errors.New("signature nonce has already been used")
+	// ErrSignatureInvalid is returned for requests with a signature which does
+	// not match the body.
+	ErrSignatureInvalid = errors.New("invalid signature")
+)
+
+// Signature holds the signature headers of a request triggering a webhook job.
+type Signature struct {
+	// Signature is the hex encoded signature of SignedPayload.
+	Signature string
+	// Timestamp is the unix time in seconds the request was signed at.
+	Timestamp string
+	// Nonce is unique to each request.
+	Nonce string
+}
+
+// SignedPayload returns the message callers sign:
+// "<timestamp>.<nonce>.<body>".
+func SignedPayload(timestamp, nonce string, body []byte) []byte {
+	payload := make([]byte, 0, len(timestamp)+len(nonce)+len(body)+2)
+	payload = append(payload, timestamp...)
+	payload = append(payload, '.')
+	payload = append(payload, nonce...)
+	payload = append(payload, '.')
+	return append(payload, body...)
+}
+
+var _ Authorizer = &signatureAuthorizer{}
+
+type signatureAuthorizer struct {
+	ds        sqlutil.DataSource
+	signature Signature
+	body      []byte
+}
+
+// NewSignatureAuthorizer returns an Authorizer of requests signed with the
+// key of the webhook job. A request is authorized once; its nonce is stored
+// until the timestamp leaves the tolerance of the job, so it cannot be
+// replayed.
+func NewSignatureAuthorizer(ds sqlutil.DataSource, signature Signature, body []byte) *signatureAuthorizer {
+	return &signatureAuthorizer{ds, signature, body}
+}
+
+func (sa *signatureAuthorizer) CanRun(ctx context.Context, _ AuthorizerConfig, jobUUID uuid.UUID) (bool, error) {
+	var spec job.WebhookSpec
+	err := sa.ds.GetContext(ctx, &spec, `
+SELECT webhook_specs.* FROM webhook_specs
+JOIN jobs ON jobs.webhook_spec_id = webhook_specs.id
+WHERE jobs.external_job_id = $1`, jobUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if spec.SignatureAlgorithm == job.WebhookSignatureNone {
+		return false, nil
+	}
+
+	if err = sa.verify(spec); err != nil {
+		return false, err
+	}
+
+	// the nonces of a job are only unique among the unexpired ones
+	ts, _ := strconv.ParseInt(sa.signature.Timestamp, 10, 64)
+	expiresAt := time.Unix(ts, 0).Add(spec.Tolerance())
+	if _, err = sa.ds.ExecContext(ctx, `DELETE FROM webhook_nonces WHERE webhook_spec_id = $1 AND expires_at < $2`, spec.ID, time.Now()); err != nil {
+		return false, errors.Wrap(err, "failed to prune webhook nonces")
+	}
+	res, err := sa.ds.ExecContext(ctx, `
+INSERT INTO webhook_nonces (webhook_spec_id, nonce, expires_at) VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING`, spec.ID, sa.signature.Nonce, expiresAt)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to store webhook nonce")
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return false, err
+	} else if rows == 0 {
+		return false, ErrSignatureReplayed
+	}
+	return true, nil
+}
+
+// verify checks the timestamp and the signature of the request.
+func (sa *signatureAuthorizer) verify(spec job.WebhookSpec) error {
+	if sa.signature.Nonce == "" {
+		return errors.Wrap(ErrSignatureInvalid, "missing nonce")
+	}
+	ts, err := strconv.ParseInt(sa.signature.Timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(ErrSignatureInvalid, "timestamp must be a unix time in seconds")
+	}
+	if skew := time.Now().Sub(time.Unix(ts, 0)).Abs(); skew > spec.Tolerance() {
+		return ErrSignatureExpired
+	}
+	signature, err := hex.DecodeString(sa.signature.Signature)
+	if err != nil {
+		return errors.Wrap(ErrSignatureInvalid, "signature must be hex encoded")
+	}
+	payload := SignedPayload(sa.signature.Timestamp, sa.signature.Nonce, sa.body)
+
+	switch spec.SignatureAlgorithm {
+	case job.WebhookSignatureHMACSHA256:
+		mac := hmac.New(sha256.New, []byte(spec.SignatureKey))
+		mac.Write(payload)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrSignatureInvalid
+		}
+	case job.WebhookSignatureEd25519:
+		publicKey, err := hex.DecodeString(string(spec.SignatureKey))
+		if err != nil || len(publicKey) != ed25519.PublicKeySize {
+			return errors.New("job has an invalid ed25519 public key")
+		}
+		if !ed25519.Verify(publicKey, payload, signature) {
+			return ErrSignatureInvalid
+		}
+	default:
+		return errors.Errorf("unknown signature algorithm %q", spec.SignatureAlgorithm)
+	}
+	return nil
+}
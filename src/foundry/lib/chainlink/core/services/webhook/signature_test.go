@@ -0,0 +1,126 @@
+package webhook_test
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+func Test_SignatureAuthorizer(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	secret := "0123456789abcdef0123456789abcdef"
+	hmacJob, hmacSpec := cltest.MustInsertWebhookSpec(t, db)
+	_, err := db.Exec(`UPDATE webhook_specs SET signature_algorithm = 'hmac-sha256', signature_key = $1 WHERE id = $2`, secret, hmacSpec.ID)
+	require.NoError(t, err)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519Job, ed25519Spec := cltest.MustInsertWebhookSpec(t, db)
+	_, err = db.Exec(`UPDATE webhook_specs SET signature_algorithm = 'ed25519', signature_key = $1, signature_tolerance = $2 WHERE id = $3`,
+		hex.EncodeToString(publicKey), time.Minute, ed25519Spec.ID)
+	require.NoError(t, err)
+
+	unsignedJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	body := []byte(`{"data": {"result": 42}}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	signHMAC := func(timestamp, nonce string, body []byte) webhook.Signature {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(webhook.SignedPayload(timestamp, nonce, body))
+		return webhook.Signature{Signature: hex.EncodeToString(mac.Sum(nil)), Timestamp: timestamp, Nonce: nonce}
+	}
+	signEd25519 := func(timestamp, nonce string, body []byte) webhook.Signature {
+		signature := ed25519.Sign(privateKey, webhook.SignedPayload(timestamp, nonce, body))
+		return webhook.Signature{Signature: hex.EncodeToString(signature), Timestamp: timestamp, Nonce: nonce}
+	}
+
+	t.Run("authorizes valid hmac-sha256 signatures once", func(t *testing.T) {
+		sig := signHMAC(now, "hmac-once", body)
+
+		can, err := webhook.NewSignatureAuthorizer(db, sig, body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+
+		_, err = webhook.NewSignatureAuthorizer(db, sig, body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureReplayed)
+	})
+
+	t.Run("authorizes valid ed25519 signatures", func(t *testing.T) {
+		can, err := webhook.NewSignatureAuthorizer(db, signEd25519(now, "ed25519", body), body).CanRun(ctx, nil, ed25519Job.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+	})
+
+	t.Run("rejects tampered bodies", func(t *testing.T) {
+		sig := signHMAC(now, "tampered", body)
+
+		_, err := webhook.NewSignatureAuthorizer(db, sig, []byte(`{"data": {"result": 43}}`)).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureInvalid)
+	})
+
+	t.Run("rejects signatures with another key", func(t *testing.T) {
+		_, err := webhook.NewSignatureAuthorizer(db, signHMAC(now, "other-key", body), body).CanRun(ctx, nil, ed25519Job.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureInvalid)
+	})
+
+	t.Run("rejects stale timestamps", func(t *testing.T) {
+		stale := strconv.FormatInt(time.Now().Add(-2*time.Minute).Unix(), 10)
+
+		_, err := webhook.NewSignatureAuthorizer(db, signEd25519(stale, "stale", body), body).CanRun(ctx, nil, ed25519Job.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureExpired)
+
+		// within the default tolerance of 5 minutes
+		can, err := webhook.NewSignatureAuthorizer(db, signHMAC(stale, "stale", body), body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+	})
+
+	t.Run("rejects malformed signatures", func(t *testing.T) {
+		sig := signHMAC(now, "", body)
+		_, err := webhook.NewSignatureAuthorizer(db, sig, body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureInvalid)
+
+		sig = signHMAC("yesterday", "malformed", body)
+		_, err = webhook.NewSignatureAuthorizer(db, sig, body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.ErrorIs(t, err, webhook.ErrSignatureInvalid)
+	})
+
+	t.Run("never authorizes unsigned or missing jobs", func(t *testing.T) {
+		can, err := webhook.NewSignatureAuthorizer(db, signHMAC(now, "unsigned", body), body).CanRun(ctx, nil, unsignedJob.ExternalJobID)
+		require.NoError(t, err)
+		assert.False(t, can)
+
+		can, err = webhook.NewSignatureAuthorizer(db, signHMAC(now, "missing", body), body).CanRun(ctx, nil, uuid.New())
+		require.NoError(t, err)
+		assert.False(t, can)
+	})
+
+	t.Run("prunes expired nonces", func(t *testing.T) {
+		_, err := db.Exec(`INSERT INTO webhook_nonces (webhook_spec_id, nonce, expires_at) VALUES ($1, 'expired', $2)`, hmacSpec.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		can, err := webhook.NewSignatureAuthorizer(db, signHMAC(now, "pruning", body), body).CanRun(ctx, nil, hmacJob.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+
+		var count int
+		require.NoError(t, db.Get(&count, `SELECT count(*) FROM webhook_nonces WHERE nonce = 'expired'`))
+		assert.Zero(t, count)
+	})
+}
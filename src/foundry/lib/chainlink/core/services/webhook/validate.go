@@ -2,6 +2,8 @@ package webhook
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/hex"
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -11,6 +13,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
+// minHMACKeyLength is the minimum length of the shared secrets of signed
+// webhook jobs.
+const minHMACKeyLength = 32
+
 type TOMLWebhookSpecExternalInitiator struct {
 	Name string      `toml:"name"`
 	Spec models.JSON `toml:"spec"`
@@ -18,6 +24,9 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	SignatureAlgorithm string                             `toml:"signatureAlgorithm"`
+	SignatureKey       string                             `toml:"signatureKey"`
+	SignatureTolerance models.Interval                    `toml:"signatureTolerance"`
 }
 
 func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -55,13 +64,38 @@ func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitia
 		externalInitiatorWebhookSpecs = append(externalInitiatorWebhookSpecs, eiWS)
 	}
 
+	algorithm, algErr := job.ParseWebhookSignatureAlgorithm(tomlSpec.SignatureAlgorithm)
+	err = multierr.Combine(err, algErr, validateSignatureKey(algorithm, tomlSpec.SignatureKey))
+
 	if err != nil {
 		return jb, err
 	}
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		SignatureAlgorithm:            algorithm,
+		SignatureKey:                  models.Secret(tomlSpec.SignatureKey),
+		SignatureTolerance:            tomlSpec.SignatureTolerance,
 	}
 
 	return jb, nil
 }
+
+func validateSignatureKey(algorithm job.WebhookSignatureAlgorithm, key string) error {
+	switch algorithm {
+	case job.WebhookSignatureNone:
+		if key != "" {
+			return errors.New("signatureKey requires a signatureAlgorithm")
+		}
+	case job.WebhookSignatureHMACSHA256:
+		if len(key) < minHMACKeyLength {
+			return errors.Errorf("signatureKey must be at least %d characters long for %s", minHMACKeyLength, algorithm)
+		}
+	case job.WebhookSignatureEd25519:
+		publicKey, err := hex.DecodeString(key)
+		if err != nil || len(publicKey) != ed25519.PublicKeySize {
+			return errors.Errorf("signatureKey must be a hex encoded %d byte public key for %s", ed25519.PublicKeySize, algorithm)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
@@ -144,6 +145,54 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with signature",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureAlgorithm = "ed25519"
+            signatureKey       = "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29"
+            signatureTolerance = "1m"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.WebhookSignatureEd25519, s.WebhookSpec.SignatureAlgorithm)
+				assert.Equal(t, "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29", string(s.WebhookSpec.SignatureKey))
+				assert.Equal(t, time.Minute, s.WebhookSpec.Tolerance())
+			},
+		},
+		{
+			name: "with invalid signature settings",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureAlgorithm = "hmac-sha256"
+            signatureKey       = "too short"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "signatureKey must be at least 32 characters long for hmac-sha256")
+			},
+		},
+		{
+			name: "with unknown signature algorithm",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureAlgorithm = "rsa"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, `unknown signature algorithm "rsa", must be one of hmac-sha256 or ed25519`)
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
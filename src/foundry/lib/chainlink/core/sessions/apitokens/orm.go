@@ -0,0 +1,99 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ORM manages the named API tokens of local users.
+type ORM interface {
+	// CreateToken creates the token of the user, name, permissions and expiry of t and
+	// returns its access key and secret, which are only stored hashed.
+	CreateToken(ctx context.Context, t *Token) (*auth.Token, error)
+	// ListTokens returns the tokens of the user with email by name, or of every user if email is empty.
+	ListTokens(ctx context.Context, email string) ([]Token, error)
+	// RevokeToken deletes the token name of the user with email, or returns sql.ErrNoRows.
+	RevokeToken(ctx context.Context, email, name string) error
+	// FindUserByToken returns the token with the access key and its user.
+	FindUserByToken(ctx context.Context, accessKey string) (sessions.User, Token, error)
+	// MarkUsed records the last use of the token with id from ip.
+	MarkUsed(ctx context.Context, id int64, ip string) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) CreateToken(ctx context.Context, t *Token) (*auth.Token, error) {
+	if err := t.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash token secret: %w", err)
+	}
+	err = o.ds.GetContext(ctx, t, `INSERT INTO api_tokens (user_email, name, token_key, token_salt, token_hashed_secret, permissions, expires_at, created_at)
+SELECT email, $2, $3, $4, $5, $6, $7, now() FROM users WHERE lower(email) = lower($1) RETURNING *`,
+		t.UserEmail, t.Name, token.AccessKey, salt, hashedSecret, t.Permissions, t.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("user %q not found", t.UserEmail)
+	} else if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (o *orm) ListTokens(ctx context.Context, email string) ([]Token, error) {
+	tokens := []Token{}
+	err := o.ds.SelectContext(ctx, &tokens,
+		"SELECT * FROM api_tokens WHERE $1 = '' OR lower(user_email) = lower($1) ORDER BY user_email, name", email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (o *orm) RevokeToken(ctx context.Context, email, name string) error {
+	result, err := o.ds.ExecContext(ctx, "DELETE FROM api_tokens WHERE lower(user_email) = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) FindUserByToken(ctx context.Context, accessKey string) (user sessions.User, token Token, err error) {
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if err := tx.GetContext(ctx, &token, "SELECT * FROM api_tokens WHERE token_key = $1", accessKey); err != nil {
+			return err
+		}
+		return tx.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1", token.UserEmail)
+	})
+	return
+}
+
+func (o *orm) MarkUsed(ctx context.Context, id int64, ip string) error {
+	_, err := o.ds.ExecContext(ctx, "UPDATE api_tokens SET last_used_at = now(), last_used_ip = $2 WHERE id = $1", id, ip)
+	return err
+}
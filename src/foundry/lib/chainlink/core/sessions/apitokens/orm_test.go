@@ -0,0 +1,132 @@
+package apitokens_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+)
+
+func setupORM(t *testing.T) (apitokens.ORM, sessions.User, sessions.User) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	users := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{})
+	user1 := cltest.MustRandomUser(t)
+	user2 := cltest.MustRandomUser(t)
+	require.NoError(t, users.CreateUser(ctx, &user1))
+	require.NoError(t, users.CreateUser(ctx, &user2))
+
+	return apitokens.NewORM(db), user1, user2
+}
+
+func TestORM_CreateToken(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, user, _ := setupORM(t)
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	token := apitokens.Token{
+		UserEmail:   user.Email,
+		Name:        "ci",
+		Permissions: []string{"runs.create"},
+		ExpiresAt:   null.TimeFrom(expiresAt),
+	}
+	secret, err := orm.CreateToken(ctx, &token)
+	require.NoError(t, err)
+	assert.NotZero(t, token.ID)
+	assert.Equal(t, secret.AccessKey, token.TokenKey)
+	assert.NotEqual(t, secret.Secret, token.TokenHashedSecret)
+	assert.True(t, expiresAt.Equal(token.ExpiresAt.Time))
+	assert.False(t, token.LastUsedAt.Valid)
+
+	ok, err := token.Authenticate(secret)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = orm.CreateToken(ctx, &apitokens.Token{UserEmail: user.Email, Name: "ci"})
+	require.ErrorContains(t, err, "duplicate key")
+	_, err = orm.CreateToken(ctx, &apitokens.Token{UserEmail: "nobody@chain.link", Name: "ci"})
+	require.ErrorContains(t, err, "not found")
+	_, err = orm.CreateToken(ctx, &apitokens.Token{UserEmail: user.Email, Name: "bad", Permissions: []string{"keys.explode"}})
+	require.ErrorContains(t, err, "unknown permission")
+
+	unscoped := apitokens.Token{UserEmail: user.Email, Name: "unscoped"}
+	_, err = orm.CreateToken(ctx, &unscoped)
+	require.NoError(t, err)
+	assert.Nil(t, unscoped.Permissions)
+	assert.False(t, unscoped.ExpiresAt.Valid)
+}
+
+func TestORM_FindUserByToken(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, user, _ := setupORM(t)
+
+	token := apitokens.Token{UserEmail: user.Email, Name: "ci"}
+	secret, err := orm.CreateToken(ctx, &token)
+	require.NoError(t, err)
+
+	found, foundToken, err := orm.FindUserByToken(ctx, secret.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	assert.Equal(t, token.ID, foundToken.ID)
+
+	_, _, err = orm.FindUserByToken(ctx, "unknown")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.MarkUsed(ctx, token.ID, "10.0.0.1"))
+	tokens, err := orm.ListTokens(ctx, user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.True(t, tokens[0].LastUsedAt.Valid)
+	assert.Equal(t, "10.0.0.1", tokens[0].LastUsedIP.String)
+}
+
+func TestORM_ListAndRevokeTokens(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, user1, user2 := setupORM(t)
+
+	for _, tt := range []struct {
+		email, name string
+	}{{user1.Email, "deployer"}, {user1.Email, "ci"}, {user2.Email, "ci"}} {
+		_, err := orm.CreateToken(ctx, &apitokens.Token{UserEmail: tt.email, Name: tt.name})
+		require.NoError(t, err)
+	}
+
+	tokens, err := orm.ListTokens(ctx, user1.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ci", tokens[0].Name)
+	assert.Equal(t, "deployer", tokens[1].Name)
+
+	tokens, err = orm.ListTokens(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, tokens, 3)
+
+	require.NoError(t, orm.RevokeToken(ctx, user1.Email, "ci"))
+	require.ErrorIs(t, orm.RevokeToken(ctx, user1.Email, "ci"), sql.ErrNoRows)
+
+	tokens, err = orm.ListTokens(ctx, user2.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1, "tokens of other users are kept")
+
+	tokens, err = orm.ListTokens(ctx, user1.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "deployer", tokens[0].Name)
+}
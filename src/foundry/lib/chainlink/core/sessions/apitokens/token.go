@@ -0,0 +1,68 @@
+package apitokens
+
+import (
+	"crypto/subtle"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// Token is a named API token of a user. A user may have one token per
+// automation system, each with its own expiry and permission scope.
+type Token struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	// Permissions scope the token to a subset of the permissions of the user. Nil
+	// grants the token every permission of the user.
+	Permissions pq.StringArray
+	ExpiresAt   null.Time
+	LastUsedAt  null.Time
+	LastUsedIP  null.String `db:"last_used_ip"`
+	CreatedAt   time.Time
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// Validate checks the name, scope and expiry of a new token.
+func (t Token) Validate(now time.Time) error {
+	if !nameRegexp.MatchString(t.Name) {
+		return errors.New("token name must start with a letter or digit, and contain at most 64 letters, digits, '_', '.' and '-'")
+	}
+	if t.ExpiresAt.Valid && !t.ExpiresAt.Time.After(now) {
+		return errors.New("token expiry must be in the future")
+	}
+	_, err := t.Scope()
+	return err
+}
+
+// Scope parses the permissions the token is scoped to, nil if it isn't scoped.
+func (t Token) Scope() (rbac.Permissions, error) {
+	if t.Permissions == nil {
+		return nil, nil
+	}
+	return rbac.ParsePermissions(t.Permissions)
+}
+
+// Expired returns whether the token can't be used anymore at now.
+func (t Token) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !t.ExpiresAt.Time.After(now)
+}
+
+// Authenticate returns whether the secret of token matches the hashed secret of t.
+func (t Token) Authenticate(token *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
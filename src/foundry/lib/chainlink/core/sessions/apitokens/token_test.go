@@ -0,0 +1,87 @@
+package apitokens_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestToken_Validate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	for _, tt := range []struct {
+		name    string
+		token   apitokens.Token
+		wantErr string
+	}{
+		{"unscoped", apitokens.Token{Name: "ci"}, ""},
+		{"scoped", apitokens.Token{Name: "deployer.prod-1", Permissions: []string{"jobs.create:type=webhook"}}, ""},
+		{"empty scope", apitokens.Token{Name: "reader", Permissions: []string{}}, ""},
+		{"expiring", apitokens.Token{Name: "ci", ExpiresAt: null.TimeFrom(now.Add(time.Hour))}, ""},
+		{"empty name", apitokens.Token{}, "token name"},
+		{"invalid name", apitokens.Token{Name: "my token"}, "token name"},
+		{"expired", apitokens.Token{Name: "ci", ExpiresAt: null.TimeFrom(now.Add(-time.Hour))}, "in the future"},
+		{"invalid scope", apitokens.Token{Name: "ci", Permissions: []string{"keys.explode"}}, "unknown permission"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.token.Validate(now)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestToken_Scope(t *testing.T) {
+	t.Parallel()
+
+	scope, err := apitokens.Token{}.Scope()
+	require.NoError(t, err)
+	assert.Nil(t, scope)
+
+	scope, err = apitokens.Token{Permissions: []string{}}.Scope()
+	require.NoError(t, err)
+	assert.NotNil(t, scope)
+	assert.False(t, scope.Allows(rbac.RunsCreate, nil))
+
+	scope, err = apitokens.Token{Permissions: []string{"runs.create"}}.Scope()
+	require.NoError(t, err)
+	assert.True(t, scope.Allows(rbac.RunsCreate, nil))
+	assert.False(t, scope.Allows(rbac.JobsCreate, nil))
+}
+
+func TestToken_Expired(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	assert.False(t, apitokens.Token{}.Expired(now))
+	assert.False(t, apitokens.Token{ExpiresAt: null.TimeFrom(now.Add(time.Second))}.Expired(now))
+	assert.True(t, apitokens.Token{ExpiresAt: null.TimeFrom(now)}.Expired(now))
+}
+
+func TestToken_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	secret := auth.NewToken()
+	hashedSecret, err := auth.HashedSecret(secret, "salt")
+	require.NoError(t, err)
+	token := apitokens.Token{TokenKey: secret.AccessKey, TokenSalt: "salt", TokenHashedSecret: hashedSecret}
+
+	ok, err := token.Authenticate(secret)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = token.Authenticate(&auth.Token{AccessKey: secret.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
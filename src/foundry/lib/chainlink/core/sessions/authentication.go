@@ -15,6 +15,7 @@ type AuthenticationProviderName string
 const (
 	LocalAuth AuthenticationProviderName = "local"
 	LDAPAuth  AuthenticationProviderName = "ldap"
+	OIDCAuth  AuthenticationProviderName = "oidc"
 )
 
 // ErrUserSessionExpired defines the error triggered when the user session has expired
@@ -38,10 +39,15 @@ type BasicAdminUsersORM interface {
 }
 
 // AuthenticationProvider is an interface that abstracts the required application calls to a user management backend
-// Currently localauth (users table DB) or LDAP server (readonly)
+// Currently localauth (users table DB), LDAP server (readonly) or OIDC issuer (readonly)
 type AuthenticationProvider interface {
 	FindUser(ctx context.Context, email string) (User, error)
 	FindUserByAPIToken(ctx context.Context, apiToken string) (User, error)
+	// FindUserByBearerToken returns the user of an access token issued by an identity provider.
+	FindUserByBearerToken(ctx context.Context, token string) (User, error)
+	// AuthorizationURL returns the URL of the identity provider to redirect a user to for logging in with the
+	// authorization code flow. The code returned to the redirect URL is passed to CreateSession with codeVerifier.
+	AuthorizationURL(ctx context.Context, state, codeVerifier string) (string, error)
 	ListUsers(ctx context.Context) ([]User, error)
 	AuthorizedUserWithSession(ctx context.Context, sessionID string) (User, error)
 	DeleteUser(ctx context.Context, email string) error
@@ -234,6 +234,16 @@ func (l *ldapAuthenticator) FindUserByAPIToken(ctx context.Context, apiToken str
 	}, nil
 }
 
+// FindUserByBearerToken is not supported, LDAP users authenticate with API tokens
+func (l *ldapAuthenticator) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// AuthorizationURL is not supported, LDAP users log in with the credentials of the directory
+func (l *ldapAuthenticator) AuthorizationURL(ctx context.Context, state, codeVerifier string) (string, error) {
+	return "", sessions.ErrNotSupported
+}
+
 // ListUsers will load and return all active users in applicable LDAP groups, extended with local admin users as well
 func (l *ldapAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, error) {
 	// For each defined role/group, query for the list of group members to gather the full list of possible users
@@ -51,6 +51,16 @@ func (o *orm) FindUserByAPIToken(ctx context.Context, apiToken string) (user ses
 	return
 }
 
+// FindUserByBearerToken is not supported, local users authenticate with API tokens.
+func (o *orm) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// AuthorizationURL is not supported, local users log in with their password.
+func (o *orm) AuthorizationURL(ctx context.Context, state, codeVerifier string) (string, error) {
+	return "", sessions.ErrNotSupported
+}
+
 func (o *orm) findUser(ctx context.Context, email string) (user sessions.User, err error) {
 	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
 	err = o.ds.GetContext(ctx, &user, sql, email)
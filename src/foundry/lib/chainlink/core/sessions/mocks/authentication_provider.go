@@ -26,6 +26,64 @@ func (_m *AuthenticationProvider) EXPECT() *AuthenticationProvider_Expecter {
 	return &AuthenticationProvider_Expecter{mock: &_m.Mock}
 }
 
+// AuthorizationURL provides a mock function with given fields: ctx, state, codeVerifier
+func (_m *AuthenticationProvider) AuthorizationURL(ctx context.Context, state string, codeVerifier string) (string, error) {
+	ret := _m.Called(ctx, state, codeVerifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthorizationURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, state, codeVerifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, state, codeVerifier)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, state, codeVerifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_AuthorizationURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthorizationURL'
+type AuthenticationProvider_AuthorizationURL_Call struct {
+	*mock.Call
+}
+
+// AuthorizationURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state string
+//   - codeVerifier string
+func (_e *AuthenticationProvider_Expecter) AuthorizationURL(ctx interface{}, state interface{}, codeVerifier interface{}) *AuthenticationProvider_AuthorizationURL_Call {
+	return &AuthenticationProvider_AuthorizationURL_Call{Call: _e.mock.On("AuthorizationURL", ctx, state, codeVerifier)}
+}
+
+func (_c *AuthenticationProvider_AuthorizationURL_Call) Run(run func(ctx context.Context, state string, codeVerifier string)) *AuthenticationProvider_AuthorizationURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_AuthorizationURL_Call) Return(_a0 string, _a1 error) *AuthenticationProvider_AuthorizationURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_AuthorizationURL_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *AuthenticationProvider_AuthorizationURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthorizedUserWithSession provides a mock function with given fields: ctx, sessionID
 func (_m *AuthenticationProvider) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
 	ret := _m.Called(ctx, sessionID)
@@ -607,6 +665,63 @@ func (_c *AuthenticationProvider_FindUserByAPIToken_Call) RunAndReturn(run func(
 	return _c
 }
 
+// FindUserByBearerToken provides a mock function with given fields: ctx, token
+func (_m *AuthenticationProvider) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByBearerToken")
+	}
+
+	var r0 sessions.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sessions.User, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sessions.User); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_FindUserByBearerToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByBearerToken'
+type AuthenticationProvider_FindUserByBearerToken_Call struct {
+	*mock.Call
+}
+
+// FindUserByBearerToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthenticationProvider_Expecter) FindUserByBearerToken(ctx interface{}, token interface{}) *AuthenticationProvider_FindUserByBearerToken_Call {
+	return &AuthenticationProvider_FindUserByBearerToken_Call{Call: _e.mock.On("FindUserByBearerToken", ctx, token)}
+}
+
+func (_c *AuthenticationProvider_FindUserByBearerToken_Call) Run(run func(ctx context.Context, token string)) *AuthenticationProvider_FindUserByBearerToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindUserByBearerToken_Call) Return(_a0 sessions.User, _a1 error) *AuthenticationProvider_FindUserByBearerToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindUserByBearerToken_Call) RunAndReturn(run func(context.Context, string) (sessions.User, error)) *AuthenticationProvider_FindUserByBearerToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserWebAuthn provides a mock function with given fields: ctx, email
 func (_m *AuthenticationProvider) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
 	ret := _m.Called(ctx, email)
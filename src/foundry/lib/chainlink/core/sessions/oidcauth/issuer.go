@@ -0,0 +1,295 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// issuerTimeout bounds every request to the issuer.
+	issuerTimeout = 10 * time.Second
+	// jwksRefreshInterval rate limits the refreshes of the signing keys of the
+	// issuer, which happen when a token is signed with an unknown key.
+	jwksRefreshInterval = time.Minute
+	// maxIssuerResponseSize bounds the responses read from the issuer.
+	maxIssuerResponseSize = 1 << 20
+)
+
+var signingMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// providerMetadata is the subset of the OpenID Provider Metadata used.
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// issuer is a client of an OpenID Connect issuer, for the authorization code
+// flow with PKCE of a single client.
+type issuer struct {
+	metadata     providerMetadata
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	client       *http.Client
+
+	keysMu      sync.Mutex
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+// discoverIssuer loads the metadata of the issuer at issuerURL.
+func discoverIssuer(ctx context.Context, issuerURL, clientID, clientSecret, redirectURL string, scopes []string) (*issuer, error) {
+	i := &issuer{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       scopes,
+		client:       &http.Client{Timeout: issuerTimeout},
+	}
+	wellKnown := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	if err := i.getJSON(ctx, wellKnown, &i.metadata); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC issuer: %w", err)
+	}
+	// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation
+	if strings.TrimSuffix(i.metadata.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return nil, fmt.Errorf("OIDC issuer %q does not match the configured IssuerURL %q", i.metadata.Issuer, issuerURL)
+	}
+	if i.metadata.AuthorizationEndpoint == "" || i.metadata.TokenEndpoint == "" || i.metadata.JWKSURI == "" {
+		return nil, errors.New("OIDC issuer metadata is missing the authorization, token or JWKS endpoint")
+	}
+	return i, nil
+}
+
+// authorizationURL returns the URL of the authorization request, with the S256
+// challenge of codeVerifier.
+func (i *issuer) authorizationURL(state, codeVerifier string) (string, error) {
+	u, err := url.Parse(i.metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid OIDC authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", i.clientID)
+	q.Set("redirect_uri", i.redirectURL)
+	q.Set("scope", strings.Join(i.scopes, " "))
+	q.Set("state", state)
+	q.Set("code_challenge", codeChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// codeChallenge is the S256 PKCE challenge of verifier.
+// https://datatracker.ietf.org/doc/html/rfc7636#section-4.2
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// exchange redeems an authorization code and returns the claims of the
+// verified ID token.
+func (i *issuer) exchange(ctx context.Context, code, codeVerifier string) (jwt.MapClaims, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {i.redirectURL},
+		"client_id":     {i.clientID},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if i.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(i.clientID), url.QueryEscape(i.clientSecret))
+	}
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeem authorization code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tr tokenResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxIssuerResponseSize)).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("failed to decode OIDC token response (status %d): %w", resp.StatusCode, err)
+	}
+	if tr.Error != "" {
+		return nil, fmt.Errorf("OIDC token request failed: %s: %s", tr.Error, tr.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC token request failed with status %d", resp.StatusCode)
+	}
+	if tr.IDToken == "" {
+		return nil, errors.New("OIDC token response has no ID token")
+	}
+	return i.verify(ctx, tr.IDToken, jwt.WithAudience(i.clientID))
+}
+
+// verify returns the claims of a token signed by the issuer, which hasn't expired.
+func (i *issuer) verify(ctx context.Context, token string, opts ...jwt.ParserOption) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	opts = append(opts,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(i.metadata.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return i.key(ctx, kid)
+	}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OIDC token: %w", err)
+	}
+	return claims, nil
+}
+
+// key returns the signing key kid of the issuer, or its only key if kid is
+// empty. The keys are refreshed when kid is unknown.
+func (i *issuer) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	i.keysMu.Lock()
+	defer i.keysMu.Unlock()
+
+	find := func() (crypto.PublicKey, bool) {
+		if kid == "" && len(i.keys) == 1 {
+			for _, k := range i.keys {
+				return k, true
+			}
+		}
+		k, ok := i.keys[kid]
+		return k, ok
+	}
+	if k, ok := find(); ok {
+		return k, nil
+	}
+	if time.Since(i.keysFetched) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown OIDC signing key %q", kid)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := i.getJSON(ctx, i.metadata.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC signing keys: %w", err)
+	}
+	i.keysFetched = time.Now()
+	i.keys = make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if k, err := jwk.publicKey(); err == nil {
+			i.keys[jwk.Kid] = k
+		}
+	}
+	if k, ok := find(); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown OIDC signing key %q", kid)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	decode := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func (i *issuer) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", u, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxIssuerResponseSize)).Decode(v)
+}
@@ -0,0 +1,418 @@
+/*
+The OIDC authentication package logs users in with an upstream OpenID Connect issuer, using
+the authorization code flow with PKCE.
+
+The operator UI redirects the user to the issuer through AuthorizationURL, and the code returned
+to the configured redirect URL is exchanged for an ID token in CreateSession. The email and group
+claims of the verified ID token are cached in the oidc_sessions table, the groups being mapped to
+the local RBAC roles by the group names defined in the OIDC config. Roles are refreshed when the
+user logs in again after the session timed out.
+
+API calls can be authenticated with an access token of the issuer, passed as a bearer token. Only
+JWT access tokens signed by the issuer for the configured client are accepted, they are verified
+with the signing keys of the issuer without a request per call.
+
+This implementation is read only; user mutation actions such as Delete are not supported. Local
+admin users of the users table can still log in with their password and use their API token, as
+for LDAP.
+*/
+package oidcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var ErrUserNoOIDCGroups = errors.New("user authenticated, but matching no role groups assigned")
+var ErrNoEmailClaim = errors.New("OIDC token has no verified email claim")
+
+type oidcAuthenticator struct {
+	ds          sqlutil.DataSource
+	issuer      *issuer
+	config      config.OIDC
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+	// local manages the API tokens and passwords of the local admin users
+	local sessions.AuthenticationProvider
+}
+
+// oidcAuthenticator implements sessions.AuthenticationProvider interface
+var _ sessions.AuthenticationProvider = (*oidcAuthenticator)(nil)
+
+func NewOIDCAuthenticator(
+	ctx context.Context,
+	ds sqlutil.DataSource,
+	oidcCfg config.OIDC,
+	dev bool,
+	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
+) (*oidcAuthenticator, error) {
+	if oidcCfg.IssuerURL() == nil || oidcCfg.ClientID() == "" || oidcCfg.RedirectURL() == nil {
+		return nil, errors.New("OIDC IssuerURL, ClientID and RedirectURL config required")
+	}
+	// If not chainlink dev and not tls, error
+	if !dev && oidcCfg.IssuerURL().Scheme != "https" {
+		return nil, errors.New("OIDC Authentication driver requires an https IssuerURL when running in Production mode")
+	}
+	// Ensure all RBAC role mappings to OIDC groups are defined, or error on startup
+	if oidcCfg.AdminUserGroup() == "" || oidcCfg.EditUserGroup() == "" ||
+		oidcCfg.RunUserGroup() == "" || oidcCfg.ReadUserGroup() == "" {
+		return nil, errors.New("OIDC group mapping for all local RBAC roles required. Set group names for `_UserGroup` fields")
+	}
+
+	lggr = lggr.Named("OIDCAuthenticationProvider")
+	lggr.Infof("Discovering configured OIDC issuer %s", oidcCfg.IssuerURL())
+	iss, err := discoverIssuer(ctx, oidcCfg.IssuerURL().String(), oidcCfg.ClientID(), oidcCfg.ClientSecret(), oidcCfg.RedirectURL().String(), oidcCfg.Scopes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &oidcAuthenticator{
+		ds:          ds,
+		issuer:      iss,
+		config:      oidcCfg,
+		lggr:        lggr,
+		auditLogger: auditLogger,
+		local:       localauth.NewORM(ds, oidcCfg.SessionTimeout().Duration(), lggr, auditLogger),
+	}, nil
+}
+
+// FindUser returns a local admin user, or the user of the latest OIDC session by email.
+// The issuer has no directory to query users from.
+func (o *oidcAuthenticator) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	user, err := o.local.FindUser(ctx, email)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return user, err
+	}
+	err = o.ds.GetContext(ctx, &user,
+		"SELECT user_email AS email, user_role AS role, created_at FROM oidc_sessions WHERE user_email = lower($1) ORDER BY created_at DESC LIMIT 1",
+		email,
+	)
+	return user, err
+}
+
+// FindUserByAPIToken supports the API tokens of the local admin users
+func (o *oidcAuthenticator) FindUserByAPIToken(ctx context.Context, apiToken string) (sessions.User, error) {
+	return o.local.FindUserByAPIToken(ctx, apiToken)
+}
+
+// FindUserByBearerToken verifies an access token signed by the issuer for the configured client,
+// and returns the user with the role of its groups.
+func (o *oidcAuthenticator) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	claims, err := o.issuer.verify(ctx, token)
+	if err != nil {
+		return sessions.User{}, err
+	}
+	// Access tokens name the client either as audience or as authorized party
+	aud, _ := claims.GetAudience()
+	azp, _ := claims["azp"].(string)
+	if !slices.Contains(aud, o.config.ClientID()) && azp != o.config.ClientID() {
+		return sessions.User{}, errors.New("invalid OIDC token: not issued for this client")
+	}
+	return o.claimsToUser(claims)
+}
+
+// AuthorizationURL returns the authorization request URL of the issuer
+func (o *oidcAuthenticator) AuthorizationURL(ctx context.Context, state, codeVerifier string) (string, error) {
+	return o.issuer.authorizationURL(state, codeVerifier)
+}
+
+// ListUsers returns the local admin users and the users with an active OIDC session
+func (o *oidcAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, error) {
+	users, err := o.local.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var oidcUsers []sessions.User
+	err = o.ds.SelectContext(ctx, &oidcUsers,
+		`SELECT DISTINCT ON (user_email) user_email AS email, user_role AS role, created_at FROM oidc_sessions
+WHERE NOT localauth_user AND created_at + $1 >= now() ORDER BY user_email, created_at DESC`,
+		o.config.SessionTimeout().Duration(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing OIDC users: %w", err)
+	}
+	return append(users, oidcUsers...), nil
+}
+
+// AuthorizedUserWithSession will return the API user associated with the Session ID if it
+// exists and hasn't expired
+func (o *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
+	if len(sessionID) == 0 {
+		return sessions.User{}, sessions.ErrEmptySessionID
+	}
+	var foundSession struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+		Valid     bool
+	}
+	if err := o.ds.GetContext(ctx, &foundSession,
+		"SELECT user_email, user_role, created_at + $2 >= now() as valid FROM oidc_sessions WHERE id = $1",
+		sessionID, o.config.SessionTimeout().Duration(),
+	); err != nil {
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+	if !foundSession.Valid {
+		// Sessions expired, purge
+		if _, execErr := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID); execErr != nil {
+			o.lggr.Errorf("error purging stale oidc session: %v", execErr)
+		}
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+	return sessions.User{
+		Email: foundSession.UserEmail,
+		Role:  foundSession.UserRole,
+	}, nil
+}
+
+// DeleteUser is not supported for read only OIDC
+func (o *oidcAuthenticator) DeleteUser(ctx context.Context, email string) error {
+	return sessions.ErrNotSupported
+}
+
+// DeleteUserSession removes an oidc_sessions table entry by ID
+func (o *oidcAuthenticator) DeleteUserSession(ctx context.Context, sessionID string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID)
+	return err
+}
+
+// GetUserWebAuthn returns an empty stub, MFA is handled by the issuer
+func (o *oidcAuthenticator) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
+	return []sessions.WebAuthn{}, nil
+}
+
+// CreateSession exchanges the authorization code of the session request for the ID token of the
+// user, or falls back to the password of a local admin user when there is no code.
+func (o *oidcAuthenticator) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
+	var user sessions.User
+	isLocalUser := sr.AuthorizationCode == ""
+	if isLocalUser {
+		var err error
+		if user, err = o.localLoginFallback(ctx, sr); err != nil {
+			return "", err
+		}
+	} else {
+		claims, err := o.issuer.exchange(ctx, sr.AuthorizationCode, sr.CodeVerifier)
+		if err != nil {
+			o.lggr.Infof("Error exchanging OIDC authorization code: %v", err)
+			return "", errors.New("unable to log in with OIDC issuer")
+		}
+		if user, err = o.claimsToUser(claims); err != nil {
+			o.lggr.Infof("Successful OIDC login, but unable to assume role: %v", err)
+			return "", err
+		}
+	}
+
+	o.lggr.Infof("Successful OIDC login request for user %s - %s", user.Email, user.Role)
+
+	// Save session, user, and role to database. Given a session ID for future queries, the issuer will not be queried
+	session := sessions.NewSession()
+	_, err := o.ds.ExecContext(
+		ctx,
+		"INSERT INTO oidc_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, $4, now())",
+		session.ID,
+		strings.ToLower(user.Email),
+		user.Role,
+		isLocalUser,
+	)
+	if err != nil {
+		o.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
+		return "", fmt.Errorf("error creating local OIDC session: %w", err)
+	}
+
+	o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": user.Email})
+
+	return session.ID, nil
+}
+
+// ClearNonCurrentSessions removes all oidc_sessions but the id passed in.
+func (o *oidcAuthenticator) ClearNonCurrentSessions(ctx context.Context, sessionID string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions where id != $1", sessionID)
+	return err
+}
+
+// CreateUser is not supported for read only OIDC
+func (o *oidcAuthenticator) CreateUser(ctx context.Context, user *sessions.User) error {
+	return sessions.ErrNotSupported
+}
+
+// UpdateRole is not supported for read only OIDC
+func (o *oidcAuthenticator) UpdateRole(ctx context.Context, email, newRole string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// SetPassword is only supported for local admin users, OIDC users have no password
+func (o *oidcAuthenticator) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetPassword(ctx, user, newPassword)
+}
+
+// TestPassword is only supported for local admin users, OIDC users have no password
+func (o *oidcAuthenticator) TestPassword(ctx context.Context, email string, password string) error {
+	return o.local.TestPassword(ctx, email, password)
+}
+
+// CreateAndSetAuthToken is only supported for local admin users, OIDC users use bearer access tokens
+func (o *oidcAuthenticator) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	return o.local.CreateAndSetAuthToken(ctx, user)
+}
+
+// SetAuthToken is only supported for local admin users, OIDC users use bearer access tokens
+func (o *oidcAuthenticator) SetAuthToken(ctx context.Context, user *sessions.User, token *auth.Token) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetAuthToken(ctx, user, token)
+}
+
+// DeleteAuthToken is only supported for local admin users, OIDC users use bearer access tokens
+func (o *oidcAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.DeleteAuthToken(ctx, user)
+}
+
+// SaveWebAuthn is not supported for read only OIDC
+func (o *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
+	return sessions.ErrNotSupported
+}
+
+// Sessions returns all sessions limited by the parameters.
+func (o *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
+	var sessions []sessions.Session
+	sql := `SELECT id, user_email AS email, created_at AS last_used, created_at FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	if err := o.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FindExternalInitiator supports the 'Run' role external intiator header auth functionality
+func (o *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
+	return o.local.FindExternalInitiator(ctx, eia)
+}
+
+// requireLocalUser returns ErrNotSupported unless email is a local admin user
+func (o *oidcAuthenticator) requireLocalUser(ctx context.Context, email string) error {
+	if _, err := o.local.FindUser(ctx, email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sessions.ErrNotSupported
+		}
+		return err
+	}
+	return nil
+}
+
+// localLoginFallback tests the credentials provided against the 'local' authentication method
+// This covers the case of local CLI API calls requiring local login separate from the issuer
+func (o *oidcAuthenticator) localLoginFallback(ctx context.Context, sr sessions.SessionRequest) (sessions.User, error) {
+	var user sessions.User
+	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	err := o.ds.GetContext(ctx, &user, sql, sr.Email)
+	if err != nil {
+		return user, errors.New("invalid email")
+	}
+	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
+		o.auditLogger.Audit(audit.AuthLoginFailedEmail, map[string]interface{}{"email": sr.Email})
+		return user, errors.New("invalid email")
+	}
+
+	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
+		o.auditLogger.Audit(audit.AuthLoginFailedPassword, map[string]interface{}{"email": sr.Email})
+		return user, errors.New("invalid password")
+	}
+
+	return user, nil
+}
+
+// claimsToUser returns the user identified by the email claim, with the role of its groups claim
+func (o *oidcAuthenticator) claimsToUser(claims jwt.MapClaims) (sessions.User, error) {
+	email, _ := claims["email"].(string)
+	if verified, ok := claims["email_verified"].(bool); email == "" || (ok && !verified) {
+		return sessions.User{}, ErrNoEmailClaim
+	}
+	role, err := GroupsToUserRole(
+		claimStrings(claims[o.config.GroupsClaim()]),
+		o.config.AdminUserGroup(),
+		o.config.EditUserGroup(),
+		o.config.RunUserGroup(),
+		o.config.ReadUserGroup(),
+	)
+	if err != nil {
+		return sessions.User{}, err
+	}
+	return sessions.User{
+		Email: strings.ToLower(email),
+		Role:  role,
+	}, nil
+}
+
+// claimStrings returns the values of a claim which is either a string or a list of strings
+func claimStrings(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// GroupsToUserRole returns the highest role mapped from the groups of a user
+func GroupsToUserRole(groups []string, adminGroup string, editGroup string, runGroup string, readGroup string) (sessions.UserRole, error) {
+	switch {
+	case slices.Contains(groups, adminGroup):
+		return sessions.UserRoleAdmin, nil
+	case slices.Contains(groups, editGroup):
+		return sessions.UserRoleEdit, nil
+	case slices.Contains(groups, runGroup):
+		return sessions.UserRoleRun, nil
+	case slices.Contains(groups, readGroup):
+		return sessions.UserRoleView, nil
+	default:
+		// No role group found, error
+		return sessions.UserRoleView, ErrUserNoOIDCGroups
+	}
+}
+
+const constantTimeEmailLength = 256
+
+func constantTimeEmailCompare(left, right string) bool {
+	length := mathutil.Max(constantTimeEmailLength, len(left), len(right))
+	leftBytes := make([]byte, length)
+	rightBytes := make([]byte, length)
+	copy(leftBytes, left)
+	copy(rightBytes, right)
+	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
+}
@@ -0,0 +1,292 @@
+package oidcauth_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidctest"
+)
+
+const (
+	testClientID    = "chainlink-node"
+	testRedirectURL = "https://node.example.com/sessions/oidc/callback"
+	testVerifier    = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+type testConfig struct {
+	issuerURL *url.URL
+	timeout   time.Duration
+}
+
+func (c testConfig) IssuerURL() *url.URL { return c.issuerURL }
+func (c testConfig) ClientID() string    { return testClientID }
+func (c testConfig) ClientSecret() string {
+	return "client-secret"
+}
+func (c testConfig) RedirectURL() *url.URL {
+	u, _ := url.Parse(testRedirectURL)
+	return u
+}
+func (c testConfig) Scopes() []string       { return []string{"openid", "email", "groups"} }
+func (c testConfig) GroupsClaim() string    { return "groups" }
+func (c testConfig) AdminUserGroup() string { return "NodeAdmins" }
+func (c testConfig) EditUserGroup() string  { return "NodeEditors" }
+func (c testConfig) RunUserGroup() string   { return "NodeRunners" }
+func (c testConfig) ReadUserGroup() string  { return "NodeReadOnly" }
+func (c testConfig) SessionTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(c.timeout)
+}
+
+func setupAuthenticationProvider(t *testing.T, timeout time.Duration) (*sqlx.DB, *oidctest.Issuer, sessions.AuthenticationProvider) {
+	t.Helper()
+
+	iss := oidctest.NewIssuer(t, testClientID)
+	iss.ClientSecret = "client-secret"
+	issuerURL, err := url.Parse(iss.URL)
+	require.NoError(t, err)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := testConfig{issuerURL: issuerURL, timeout: timeout}
+	provider, err := oidcauth.NewOIDCAuthenticator(testutils.Context(t), db, cfg, true, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.NoError(t, err)
+	return db, iss, provider
+}
+
+// authorize follows the authorization request of the provider at the issuer, and returns the
+// code and state of the redirect to the node.
+func authorize(t *testing.T, provider sessions.AuthenticationProvider, state string) (string, string) {
+	t.Helper()
+
+	authURL, err := provider.AuthorizationURL(testutils.Context(t), state, testVerifier)
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := resp.Location()
+	require.NoError(t, err)
+	assert.Equal(t, testRedirectURL, location.Scheme+"://"+location.Host+location.Path)
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestOIDC_NewAuthenticator_Config(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	_, err := oidcauth.NewOIDCAuthenticator(ctx, db, testConfig{}, true, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.ErrorContains(t, err, "OIDC IssuerURL, ClientID and RedirectURL config required")
+
+	issuerURL, err := url.Parse("http://issuer.example.com")
+	require.NoError(t, err)
+	_, err = oidcauth.NewOIDCAuthenticator(ctx, db, testConfig{issuerURL: issuerURL}, false, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.ErrorContains(t, err, "requires an https IssuerURL")
+
+	// Discovery of a server which is not the issuer fails
+	iss := oidctest.NewIssuer(t, testClientID)
+	otherURL, err := url.Parse(iss.URL + "/other")
+	require.NoError(t, err)
+	_, err = oidcauth.NewOIDCAuthenticator(ctx, db, testConfig{issuerURL: otherURL}, true, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.ErrorContains(t, err, "failed to discover OIDC issuer")
+}
+
+func TestOIDC_CreateSession(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, iss, provider := setupAuthenticationProvider(t, time.Hour)
+
+	tests := []struct {
+		name   string
+		groups []string
+		role   sessions.UserRole
+	}{
+		{"admin", []string{"Other", "NodeAdmins", "NodeReadOnly"}, sessions.UserRoleAdmin},
+		{"edit", []string{"NodeEditors", "NodeRunners"}, sessions.UserRoleEdit},
+		{"run", []string{"NodeRunners"}, sessions.UserRoleRun},
+		{"view", []string{"NodeReadOnly"}, sessions.UserRoleView},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := tt.name + "@example.com"
+			iss.SetUser(oidctest.User{Email: email, Groups: tt.groups})
+
+			code, state := authorize(t, provider, "state-"+tt.name)
+			assert.Equal(t, "state-"+tt.name, state)
+
+			sessionID, err := provider.CreateSession(ctx, sessions.SessionRequest{
+				AuthorizationCode: code,
+				CodeVerifier:      testVerifier,
+			})
+			require.NoError(t, err)
+
+			user, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+			require.NoError(t, err)
+			assert.Equal(t, email, user.Email)
+			assert.Equal(t, tt.role, user.Role)
+
+			user, err = provider.FindUser(ctx, email)
+			require.NoError(t, err)
+			assert.Equal(t, tt.role, user.Role)
+		})
+	}
+}
+
+func TestOIDC_CreateSession_Invalid(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, iss, provider := setupAuthenticationProvider(t, time.Hour)
+
+	// No group mapped to a role
+	iss.SetUser(oidctest.User{Email: "nogroups@example.com", Groups: []string{"Other"}})
+	code, _ := authorize(t, provider, "state")
+	_, err := provider.CreateSession(ctx, sessions.SessionRequest{AuthorizationCode: code, CodeVerifier: testVerifier})
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+
+	// Wrong PKCE verifier
+	iss.SetUser(oidctest.User{Email: "admin@example.com", Groups: []string{"NodeAdmins"}})
+	code, _ = authorize(t, provider, "state")
+	_, err = provider.CreateSession(ctx, sessions.SessionRequest{AuthorizationCode: code, CodeVerifier: "wrong-verifier"})
+	require.ErrorContains(t, err, "unable to log in with OIDC issuer")
+
+	// Codes can't be replayed
+	code, _ = authorize(t, provider, "state")
+	_, err = provider.CreateSession(ctx, sessions.SessionRequest{AuthorizationCode: code, CodeVerifier: testVerifier})
+	require.NoError(t, err)
+	_, err = provider.CreateSession(ctx, sessions.SessionRequest{AuthorizationCode: code, CodeVerifier: testVerifier})
+	require.ErrorContains(t, err, "unable to log in with OIDC issuer")
+}
+
+func TestOIDC_CreateSession_LocalAdminFallbackLogin(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, _, provider := setupAuthenticationProvider(t, time.Hour)
+
+	// Without an authorization code, local admin users log in with their password
+	sessionID, err := provider.CreateSession(ctx, sessions.SessionRequest{
+		Email:    cltest.APIEmailAdmin,
+		Password: cltest.Password,
+	})
+	require.NoError(t, err)
+	user, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+
+	_, err = provider.CreateSession(ctx, sessions.SessionRequest{
+		Email:    cltest.APIEmailAdmin,
+		Password: "incorrect-password",
+	})
+	require.ErrorContains(t, err, "invalid password")
+}
+
+func TestOIDC_AuthorizedUserWithSession_Expired(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, iss, provider := setupAuthenticationProvider(t, time.Hour)
+
+	iss.SetUser(oidctest.User{Email: "admin@example.com", Groups: []string{"NodeAdmins"}})
+	code, _ := authorize(t, provider, "state")
+	sessionID, err := provider.CreateSession(ctx, sessions.SessionRequest{AuthorizationCode: code, CodeVerifier: testVerifier})
+	require.NoError(t, err)
+
+	_, err = db.Exec("UPDATE oidc_sessions SET created_at = now() - interval '2 hours' WHERE id = $1", sessionID)
+	require.NoError(t, err)
+
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+
+	// Expired sessions are purged
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT count(*) FROM oidc_sessions WHERE id = $1", sessionID))
+	assert.Zero(t, count)
+
+	_, err = provider.AuthorizedUserWithSession(ctx, "")
+	require.ErrorIs(t, err, sessions.ErrEmptySessionID)
+}
+
+func TestOIDC_FindUserByBearerToken(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, iss, provider := setupAuthenticationProvider(t, time.Hour)
+	user := oidctest.User{Email: "Runner@Example.com", Groups: []string{"NodeRunners"}}
+
+	found, err := provider.FindUserByBearerToken(ctx, iss.Token(t, user, testClientID, time.Hour, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "runner@example.com", found.Email)
+	assert.Equal(t, sessions.UserRoleRun, found.Role)
+
+	// Access tokens may name the client as authorized party instead of audience
+	found, err = provider.FindUserByBearerToken(ctx, iss.Token(t, user, "https://api.example.com", time.Hour, jwt.MapClaims{"azp": testClientID}))
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, found.Role)
+
+	_, err = provider.FindUserByBearerToken(ctx, iss.Token(t, user, "other-client", time.Hour, nil))
+	require.ErrorContains(t, err, "not issued for this client")
+
+	_, err = provider.FindUserByBearerToken(ctx, iss.Token(t, user, testClientID, -time.Hour, nil))
+	require.ErrorContains(t, err, "invalid OIDC token")
+
+	_, err = provider.FindUserByBearerToken(ctx, iss.Token(t, user, testClientID, time.Hour, jwt.MapClaims{"iss": "https://other.example.com"}))
+	require.ErrorContains(t, err, "invalid OIDC token")
+
+	_, err = provider.FindUserByBearerToken(ctx, iss.Token(t, user, testClientID, time.Hour, jwt.MapClaims{"email_verified": false}))
+	require.ErrorIs(t, err, oidcauth.ErrNoEmailClaim)
+
+	// Tokens signed by another issuer with the same key ID are rejected
+	other := oidctest.NewIssuer(t, testClientID)
+	_, err = provider.FindUserByBearerToken(ctx, other.Token(t, user, testClientID, time.Hour, jwt.MapClaims{"iss": iss.URL}))
+	require.ErrorContains(t, err, "invalid OIDC token")
+
+	_, err = provider.FindUserByBearerToken(ctx, "not-a-jwt")
+	require.ErrorContains(t, err, "invalid OIDC token")
+}
+
+func TestOIDC_UnsupportedOperations(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, _, provider := setupAuthenticationProvider(t, time.Hour)
+
+	user := sessions.User{Email: "viewer@example.com", Role: sessions.UserRoleView}
+	require.ErrorIs(t, provider.CreateUser(ctx, &user), sessions.ErrNotSupported)
+	require.ErrorIs(t, provider.DeleteUser(ctx, user.Email), sessions.ErrNotSupported)
+	_, err := provider.CreateAndSetAuthToken(ctx, &user)
+	require.ErrorIs(t, err, sessions.ErrNotSupported)
+	require.ErrorIs(t, provider.SetPassword(ctx, &user, "new-password"), sessions.ErrNotSupported)
+}
+
+func TestOIDC_GroupsToUserRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := oidcauth.GroupsToUserRole([]string{"b", "d"}, "a", "b", "c", "d")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, role)
+
+	_, err = oidcauth.GroupsToUserRole(nil, "a", "b", "c", "d")
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+}
@@ -0,0 +1,200 @@
+// Package oidctest provides a stand-in OpenID Connect issuer for testing the
+// OIDC authentication provider without an identity provider.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const keyID = "oidctest"
+
+// User is the identity the issuer logs in.
+type User struct {
+	Email  string
+	Groups []string
+}
+
+type authorization struct {
+	user          User
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+}
+
+// Issuer is an OIDC issuer which authorizes every request of its client for
+// the current user, without prompting.
+type Issuer struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+	// GroupsClaim is the name of the claim listing the groups of the user.
+	GroupsClaim string
+
+	key   *rsa.PrivateKey
+	mu    sync.Mutex
+	user  User
+	codes map[string]authorization
+}
+
+// NewIssuer starts an issuer for the client clientID, which is stopped with the test.
+func NewIssuer(t testing.TB, clientID string) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	i := &Issuer{
+		ClientID:    clientID,
+		GroupsClaim: "groups",
+		key:         key,
+		codes:       map[string]authorization{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", i.discovery)
+	mux.HandleFunc("/keys", i.jwks)
+	mux.HandleFunc("/authorize", i.authorize)
+	mux.HandleFunc("/token", i.token)
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Server.Close)
+	return i
+}
+
+// SetUser sets the user logged in by the following authorization requests.
+func (i *Issuer) SetUser(user User) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = user
+}
+
+// Token returns a token of user signed by the issuer, with the extra claims.
+func (i *Issuer) Token(t testing.TB, user User, audience string, ttl time.Duration, extra jwt.MapClaims) string {
+	signed, err := i.sign(user, audience, ttl, extra)
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *Issuer) sign(user User, audience string, ttl time.Duration, extra jwt.MapClaims) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":         i.URL,
+		"sub":         user.Email,
+		"aud":         audience,
+		"iat":         now.Unix(),
+		"exp":         now.Add(ttl).Unix(),
+		"email":       user.Email,
+		i.GroupsClaim: user.Groups,
+	}
+	for k, v := range extra {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	return token.SignedString(i.key)
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.URL,
+		"authorization_endpoint":                i.URL + "/authorize",
+		"token_endpoint":                        i.URL + "/token",
+		"jwks_uri":                              i.URL + "/keys",
+		"response_types_supported":              []string{"code"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (i *Issuer) jwks(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kid": keyID,
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(i.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (i *Issuer) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || q.Get("client_id") != i.ClientID || q.Get("response_type") != "code" ||
+		q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	i.mu.Lock()
+	code := rand.Text()
+	i.codes[code] = authorization{
+		user:          i.user,
+		clientID:      q.Get("client_id"),
+		redirectURI:   q.Get("redirect_uri"),
+		codeChallenge: q.Get("code_challenge"),
+	}
+	i.mu.Unlock()
+
+	rq := redirectURI.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirectURI.RawQuery = rq.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	i.mu.Lock()
+	authz, ok := i.codes[r.PostForm.Get("code")]
+	delete(i.codes, r.PostForm.Get("code"))
+	i.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	switch {
+	case r.PostForm.Get("grant_type") != "authorization_code", !ok,
+		r.PostForm.Get("client_id") != authz.clientID,
+		r.PostForm.Get("redirect_uri") != authz.redirectURI,
+		base64.RawURLEncoding.EncodeToString(sum[:]) != authz.codeChallenge:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	if i.ClientSecret != "" {
+		if _, secret, _ := r.BasicAuth(); secret != url.QueryEscape(i.ClientSecret) {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+	}
+
+	token, err := i.sign(authz.user, i.ClientID, time.Hour, nil)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"access_token": token,
+		"id_token":     token,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
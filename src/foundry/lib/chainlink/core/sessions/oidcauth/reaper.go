@@ -0,0 +1,35 @@
+package oidcauth
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type sessionReaper struct {
+	ds     sqlutil.DataSource
+	config config.OIDC
+	lggr   logger.Logger
+}
+
+// NewSessionReaper creates a reaper that cleans expired sessions from the oidc_sessions table.
+func NewSessionReaper(ds sqlutil.DataSource, config config.OIDC, lggr logger.Logger) *utils.SleeperTask {
+	return utils.NewSleeperTaskCtx(&sessionReaper{
+		ds,
+		config,
+		lggr.Named("OIDCSessionReaper"),
+	})
+}
+
+func (sr *sessionReaper) Name() string { return sr.lggr.Name() }
+
+func (sr *sessionReaper) Work(ctx context.Context) {
+	_, err := sr.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE created_at < $1", sr.config.SessionTimeout().Before(time.Now()))
+	if err != nil {
+		sr.lggr.Error("unable to reap expired OIDC sessions: ", err)
+	}
+}
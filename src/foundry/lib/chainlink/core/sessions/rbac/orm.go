@@ -0,0 +1,146 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// ErrRoleInUse is returned when deleting a role assigned to users or API tokens.
+var ErrRoleInUse = errors.New("role is assigned to users or API tokens")
+
+// ORM manages the custom roles and their assignment to local users and API tokens.
+type ORM interface {
+	// Roles returns the built-in roles, followed by the custom roles by name.
+	Roles(ctx context.Context) ([]Role, error)
+	// FindRole returns a built-in or custom role, or sql.ErrNoRows.
+	FindRole(ctx context.Context, name string) (Role, error)
+	CreateRole(ctx context.Context, role *Role) error
+	UpdateRole(ctx context.Context, role *Role) error
+	DeleteRole(ctx context.Context, name string) error
+	// AssignRole assigns a custom role to the user with email, or to its API token, replacing the
+	// permissions of its built-in role.
+	AssignRole(ctx context.Context, email, role string, apiToken bool) error
+	// UnassignRole restores the permissions of the built-in role of the user, or of its API token.
+	UnassignRole(ctx context.Context, email string, apiToken bool) error
+	// UserPermissions returns the permissions of the custom role of user, or of its built-in role.
+	UserPermissions(ctx context.Context, user sessions.User) (Permissions, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) Roles(ctx context.Context) ([]Role, error) {
+	var roles []Role
+	if err := o.ds.SelectContext(ctx, &roles, "SELECT * FROM roles ORDER BY name"); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return append(BuiltInRoles(), roles...), nil
+}
+
+func (o *orm) FindRole(ctx context.Context, name string) (Role, error) {
+	if r, ok := builtInRole(name); ok {
+		return r, nil
+	}
+	var role Role
+	err := o.ds.GetContext(ctx, &role, "SELECT * FROM roles WHERE name = $1", name)
+	return role, err
+}
+
+func (o *orm) CreateRole(ctx context.Context, role *Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	return o.ds.GetContext(ctx, role, `INSERT INTO roles (name, description, permissions, created_at, updated_at)
+VALUES ($1, $2, $3, now(), now()) RETURNING *`, role.Name, role.Description, role.Permissions)
+}
+
+func (o *orm) UpdateRole(ctx context.Context, role *Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	return o.ds.GetContext(ctx, role, `UPDATE roles SET description = $2, permissions = $3, updated_at = now()
+WHERE name = $1 RETURNING *`, role.Name, role.Description, role.Permissions)
+}
+
+func (o *orm) DeleteRole(ctx context.Context, name string) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		var inUse bool
+		if err := tx.GetContext(ctx, &inUse,
+			"SELECT EXISTS (SELECT 1 FROM users WHERE custom_role = $1 OR token_custom_role = $1)", name,
+		); err != nil {
+			return err
+		}
+		if inUse {
+			return ErrRoleInUse
+		}
+		result, err := tx.ExecContext(ctx, "DELETE FROM roles WHERE name = $1", name)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
+
+func (o *orm) AssignRole(ctx context.Context, email, role string, apiToken bool) error {
+	if _, ok := builtInRole(role); ok {
+		return fmt.Errorf("role %q is built in, built-in roles are changed on the user", role)
+	}
+	if _, err := o.FindRole(ctx, role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("role %q not found", role)
+		}
+		return err
+	}
+	return o.setRole(ctx, email, sql.NullString{String: role, Valid: true}, apiToken)
+}
+
+func (o *orm) UnassignRole(ctx context.Context, email string, apiToken bool) error {
+	return o.setRole(ctx, email, sql.NullString{}, apiToken)
+}
+
+func (o *orm) setRole(ctx context.Context, email string, role sql.NullString, apiToken bool) error {
+	column := "custom_role"
+	if apiToken {
+		column = "token_custom_role"
+	}
+	result, err := o.ds.ExecContext(ctx,
+		"UPDATE users SET "+column+" = $1, updated_at = now() WHERE lower(email) = lower($2)", role, email)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("user %q not found", email)
+	}
+	return nil
+}
+
+func (o *orm) UserPermissions(ctx context.Context, user sessions.User) (Permissions, error) {
+	if !user.CustomRole.Valid {
+		return RolePermissions(user.Role), nil
+	}
+	role, err := o.FindRole(ctx, user.CustomRole.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role %q: %w", user.CustomRole.String, err)
+	}
+	return role.Grants()
+}
@@ -0,0 +1,113 @@
+package rbac_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func setupORM(t *testing.T) (rbac.ORM, sessions.BasicAdminUsersORM) {
+	t.Helper()
+
+	db := pgtest.NewSqlxDB(t)
+	return rbac.NewORM(db), localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{})
+}
+
+func TestORM_Roles(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	role := rbac.Role{Name: "key-exporter", Description: "Exports CSA keys", Permissions: []string{"keys.export:type=csa"}}
+	require.NoError(t, orm.CreateRole(ctx, &role))
+	assert.False(t, role.CreatedAt.IsZero())
+
+	err := orm.CreateRole(ctx, &rbac.Role{Name: "edit", Permissions: []string{}})
+	require.ErrorContains(t, err, "built in")
+	err = orm.CreateRole(ctx, &rbac.Role{Name: "broken", Permissions: []string{"keys.explode"}})
+	require.ErrorContains(t, err, "unknown permission")
+
+	roles, err := orm.Roles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, len(rbac.BuiltInRoles())+1)
+	assert.Equal(t, "key-exporter", roles[len(roles)-1].Name)
+
+	found, err := orm.FindRole(ctx, "key-exporter")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"keys.export:type=csa"}, []string(found.Permissions))
+	assert.False(t, found.BuiltIn)
+
+	admin, err := orm.FindRole(ctx, "admin")
+	require.NoError(t, err)
+	assert.True(t, admin.BuiltIn)
+
+	_, err = orm.FindRole(ctx, "missing")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	found.Permissions = []string{"keys.export"}
+	require.NoError(t, orm.UpdateRole(ctx, &found))
+	found, err = orm.FindRole(ctx, "key-exporter")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"keys.export"}, []string(found.Permissions))
+
+	require.NoError(t, orm.DeleteRole(ctx, "key-exporter"))
+	require.ErrorIs(t, orm.DeleteRole(ctx, "key-exporter"), sql.ErrNoRows)
+}
+
+func TestORM_AssignRole(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, users := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleView
+	require.NoError(t, users.CreateUser(ctx, &user))
+
+	role := rbac.Role{Name: "webhook-jobs", Permissions: []string{"jobs.create:type=webhook", "runs.create"}}
+	require.NoError(t, orm.CreateRole(ctx, &role))
+
+	require.ErrorContains(t, orm.AssignRole(ctx, user.Email, "missing", false), "not found")
+	require.ErrorContains(t, orm.AssignRole(ctx, user.Email, "admin", false), "built in")
+	require.ErrorContains(t, orm.AssignRole(ctx, "nobody@chain.link", role.Name, false), "not found")
+
+	permissions, err := orm.UserPermissions(ctx, user)
+	require.NoError(t, err)
+	assert.False(t, permissions.Allows(rbac.JobsCreate, nil))
+
+	require.NoError(t, orm.AssignRole(ctx, user.Email, role.Name, false))
+	user, err = users.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.Equal(t, role.Name, user.CustomRole.String)
+	assert.False(t, user.TokenCustomRole.Valid)
+
+	permissions, err = orm.UserPermissions(ctx, user)
+	require.NoError(t, err)
+	assert.True(t, permissions.Allows(rbac.JobsCreate, rbac.Attrs{"type": "webhook"}))
+	assert.False(t, permissions.Allows(rbac.JobsCreate, rbac.Attrs{"type": "cron"}))
+	assert.True(t, permissions.Allows(rbac.RunsCreate, nil))
+
+	require.ErrorIs(t, orm.DeleteRole(ctx, role.Name), rbac.ErrRoleInUse)
+
+	require.NoError(t, orm.AssignRole(ctx, user.Email, role.Name, true))
+	require.NoError(t, orm.UnassignRole(ctx, user.Email, false))
+	user, err = users.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.False(t, user.CustomRole.Valid)
+	assert.Equal(t, role.Name, user.TokenCustomRole.String)
+	require.ErrorIs(t, orm.DeleteRole(ctx, role.Name), rbac.ErrRoleInUse)
+
+	require.NoError(t, orm.UnassignRole(ctx, user.Email, true))
+	require.NoError(t, orm.DeleteRole(ctx, role.Name))
+}
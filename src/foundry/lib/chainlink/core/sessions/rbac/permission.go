@@ -0,0 +1,287 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Permission names an action on a resource of the node, as resource.action.
+type Permission string
+
+const (
+	UsersManage Permission = "users.manage"
+	RolesManage Permission = "roles.manage"
+	LogUpdate   Permission = "log.update"
+
+	ExternalInitiatorsCreate Permission = "external_initiators.create"
+	ExternalInitiatorsDelete Permission = "external_initiators.delete"
+
+	BridgesCreate Permission = "bridges.create"
+	BridgesUpdate Permission = "bridges.update"
+	BridgesDelete Permission = "bridges.delete"
+
+	TransfersCreate Permission = "transfers.create"
+
+	KeysCreate Permission = "keys.create"
+	KeysUpdate Permission = "keys.update"
+	KeysDelete Permission = "keys.delete"
+	KeysImport Permission = "keys.import"
+	KeysExport Permission = "keys.export"
+
+	JobsCreate Permission = "jobs.create"
+	JobsUpdate Permission = "jobs.update"
+	JobsDelete Permission = "jobs.delete"
+	JobsPause  Permission = "jobs.pause"
+	JobsExport Permission = "jobs.export"
+
+	RunsCreate Permission = "runs.create"
+	RunsReplay Permission = "runs.replay"
+
+	ChainsReplay       Permission = "chains.replay"
+	ForwardersManage   Permission = "forwarders.manage"
+	FeedsManage        Permission = "feeds.manage"
+	JobProposalsManage Permission = "job_proposals.manage"
+)
+
+// Wildcard grants every permission.
+const Wildcard = "*"
+
+// PermissionInfo describes a permission of the catalog.
+type PermissionInfo struct {
+	Permission  Permission
+	Description string
+	// Attributes are the keys a grant of the permission may be restricted to, e.g. type for jobs.create:type=webhook.
+	Attributes []string
+}
+
+var catalog = []PermissionInfo{
+	{UsersManage, "Create, update and delete users", nil},
+	{RolesManage, "Create, update, delete and assign roles", nil},
+	{LogUpdate, "Change the log level and SQL logging", nil},
+	{ExternalInitiatorsCreate, "Create external initiators", nil},
+	{ExternalInitiatorsDelete, "Delete external initiators", nil},
+	{BridgesCreate, "Create bridges", nil},
+	{BridgesUpdate, "Update bridges", nil},
+	{BridgesDelete, "Delete bridges", nil},
+	{TransfersCreate, "Transfer funds from node keys", []string{"chain"}},
+	{KeysCreate, "Create keys", []string{"type"}},
+	{KeysUpdate, "Update the chain state of keys", []string{"type"}},
+	{KeysDelete, "Delete keys", []string{"type"}},
+	{KeysImport, "Import keys", []string{"type"}},
+	{KeysExport, "Export keys", []string{"type"}},
+	{JobsCreate, "Create, import and dry run jobs", []string{"type"}},
+	{JobsUpdate, "Update jobs, roll back job versions and dismiss job errors; updates also require jobs.create for the job type", nil},
+	{JobsDelete, "Delete jobs", nil},
+	{JobsPause, "Pause and resume jobs", nil},
+	{JobsExport, "Export jobs with their bridges, external initiators and forwarders", nil},
+	{RunsCreate, "Run jobs", nil},
+	{RunsReplay, "Replay job runs", nil},
+	{ChainsReplay, "Replay chains from a block and find common ancestors", nil},
+	{ForwardersManage, "Track and delete forwarders", nil},
+	{FeedsManage, "Create and update feeds managers and their chain configs", nil},
+	{JobProposalsManage, "Approve, cancel, reject and update job proposals", nil},
+}
+
+// Catalog returns the permissions which can be granted to roles.
+func Catalog() []PermissionInfo {
+	return slices.Clone(catalog)
+}
+
+func lookup(p Permission) (PermissionInfo, bool) {
+	i := slices.IndexFunc(catalog, func(info PermissionInfo) bool { return info.Permission == p })
+	if i < 0 {
+		return PermissionInfo{}, false
+	}
+	return catalog[i], true
+}
+
+// Attrs are the attributes of a requested action, matched against the
+// restrictions of the grants.
+type Attrs map[string]string
+
+// Grant is a permission granted to a role, in the form name[:key=value[,key=value]].
+// The name is a permission, a resource wildcard like jobs.* or the Wildcard.
+// The optional attributes restrict the grant to matching actions.
+type Grant struct {
+	Name  string
+	Attrs Attrs
+}
+
+// ParseGrant parses and validates a grant against the catalog.
+func ParseGrant(s string) (Grant, error) {
+	name, attrs, hasAttrs := strings.Cut(strings.TrimSpace(s), ":")
+	g := Grant{Name: name}
+	if err := g.validateName(); err != nil {
+		return Grant{}, err
+	}
+	if !hasAttrs {
+		return g, nil
+	}
+
+	info, ok := lookup(Permission(name))
+	if !ok {
+		return Grant{}, fmt.Errorf("invalid grant %q: wildcards cannot be restricted to attributes", s)
+	}
+	g.Attrs = Attrs{}
+	for _, kv := range strings.Split(attrs, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" || v == "" {
+			return Grant{}, fmt.Errorf("invalid grant %q: attributes must be key=value", s)
+		}
+		if !slices.Contains(info.Attributes, k) {
+			return Grant{}, fmt.Errorf("invalid grant %q: %s has no attribute %q", s, name, k)
+		}
+		if _, dup := g.Attrs[k]; dup {
+			return Grant{}, fmt.Errorf("invalid grant %q: duplicate attribute %q", s, k)
+		}
+		g.Attrs[k] = v
+	}
+	return g, nil
+}
+
+func (g Grant) validateName() error {
+	if g.Name == Wildcard {
+		return nil
+	}
+	if resource, ok := strings.CutSuffix(g.Name, ".*"); ok {
+		if slices.ContainsFunc(catalog, func(info PermissionInfo) bool { return info.Permission.resource() == resource }) {
+			return nil
+		}
+		return fmt.Errorf("invalid grant %q: unknown resource %q", g.Name, resource)
+	}
+	if _, ok := lookup(Permission(g.Name)); !ok {
+		return fmt.Errorf("invalid grant %q: unknown permission", g.Name)
+	}
+	return nil
+}
+
+func (p Permission) resource() string {
+	resource, _, _ := strings.Cut(string(p), ".")
+	return resource
+}
+
+func (g Grant) covers(p Permission) bool {
+	if g.Name == Wildcard || g.Name == string(p) {
+		return true
+	}
+	resource, ok := strings.CutSuffix(g.Name, ".*")
+	return ok && resource == p.resource()
+}
+
+// String formats the grant as parsed by ParseGrant.
+func (g Grant) String() string {
+	if len(g.Attrs) == 0 {
+		return g.Name
+	}
+	keys := make([]string, 0, len(g.Attrs))
+	for k := range g.Attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]string, len(keys))
+	for i, k := range keys {
+		attrs[i] = k + "=" + g.Attrs[k]
+	}
+	return g.Name + ":" + strings.Join(attrs, ",")
+}
+
+// Permissions is the set of grants of a user.
+type Permissions []Grant
+
+// ParsePermissions parses and validates grants.
+func ParsePermissions(grants []string) (Permissions, error) {
+	ps := make(Permissions, 0, len(grants))
+	for _, s := range grants {
+		g, err := ParseGrant(s)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, g)
+	}
+	return ps, nil
+}
+
+// Allows returns whether the action p with attrs is granted. Grants restricted
+// to attributes only match when every attribute equals the one of the action.
+//
+// Nil attrs are a check before the attributes of the action are known, which
+// also accepts restricted grants. The caller must then check the action again
+// with its attributes.
+func (ps Permissions) Allows(p Permission, attrs Attrs) bool {
+	for _, g := range ps {
+		if !g.covers(p) {
+			continue
+		}
+		if attrs == nil || g.matches(attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g Grant) matches(attrs Attrs) bool {
+	for k, v := range g.Attrs {
+		if attrs[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns the grants allowed by both ps and other, e.g. the
+// permissions of a user narrowed to the scope of one of its API tokens.
+func (ps Permissions) Intersect(other Permissions) Permissions {
+	is := Permissions{}
+	for _, g := range ps {
+		for _, o := range other {
+			if i, ok := g.intersect(o); ok {
+				is = append(is, i)
+			}
+		}
+	}
+	return is
+}
+
+func (g Grant) intersect(o Grant) (Grant, bool) {
+	var i Grant
+	switch {
+	case g.includes(o.Name):
+		i.Name = o.Name
+	case o.includes(g.Name):
+		i.Name = g.Name
+	default:
+		return Grant{}, false
+	}
+	for _, attrs := range []Attrs{g.Attrs, o.Attrs} {
+		for k, v := range attrs {
+			if i.Attrs == nil {
+				i.Attrs = Attrs{}
+			}
+			if prev, ok := i.Attrs[k]; ok && prev != v {
+				return Grant{}, false
+			}
+			i.Attrs[k] = v
+		}
+	}
+	return i, true
+}
+
+// includes returns whether g grants everything the grant named name grants.
+func (g Grant) includes(name string) bool {
+	if g.Name == Wildcard || g.Name == name {
+		return true
+	}
+	resource, ok := strings.CutSuffix(g.Name, ".*")
+	return ok && name != Wildcard && Permission(name).resource() == resource
+}
+
+// Strings formats the grants as parsed by ParsePermissions.
+func (ps Permissions) Strings() []string {
+	s := make([]string, len(ps))
+	for i, g := range ps {
+		s[i] = g.String()
+	}
+	return s
+}
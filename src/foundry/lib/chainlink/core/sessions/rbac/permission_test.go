@@ -0,0 +1,168 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestParseGrant(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		grant   string
+		want    rbac.Grant
+		wantErr string
+	}{
+		{"keys.export", rbac.Grant{Name: "keys.export"}, ""},
+		{" bridges.update ", rbac.Grant{Name: "bridges.update"}, ""},
+		{"jobs.create:type=webhook", rbac.Grant{Name: "jobs.create", Attrs: rbac.Attrs{"type": "webhook"}}, ""},
+		{"transfers.create:chain=evm", rbac.Grant{Name: "transfers.create", Attrs: rbac.Attrs{"chain": "evm"}}, ""},
+		{"jobs.*", rbac.Grant{Name: "jobs.*"}, ""},
+		{"*", rbac.Grant{Name: "*"}, ""},
+		{"jobs.explode", rbac.Grant{}, "unknown permission"},
+		{"rockets.*", rbac.Grant{}, "unknown resource"},
+		{"jobs.*:type=webhook", rbac.Grant{}, "wildcards cannot be restricted"},
+		{"jobs.create:type", rbac.Grant{}, "attributes must be key=value"},
+		{"jobs.create:type=", rbac.Grant{}, "attributes must be key=value"},
+		{"jobs.create:chain=evm", rbac.Grant{}, `has no attribute "chain"`},
+		{"jobs.create:type=webhook,type=cron", rbac.Grant{}, "duplicate attribute"},
+		{"bridges.update:type=http", rbac.Grant{}, `has no attribute "type"`},
+	} {
+		t.Run(tt.grant, func(t *testing.T) {
+			g, err := rbac.ParseGrant(tt.grant)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, g)
+		})
+	}
+}
+
+func TestGrant_String(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"keys.export", "jobs.*", "*", "jobs.create:type=webhook", "keys.export:type=csa"} {
+		g, err := rbac.ParseGrant(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, g.String())
+	}
+}
+
+func TestPermissions_Allows(t *testing.T) {
+	t.Parallel()
+
+	ps, err := rbac.ParsePermissions([]string{"keys.export:type=csa", "jobs.create:type=webhook", "bridges.*"})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name  string
+		p     rbac.Permission
+		attrs rbac.Attrs
+		want  bool
+	}{
+		{"matching attrs", rbac.KeysExport, rbac.Attrs{"type": "csa"}, true},
+		{"other attrs", rbac.KeysExport, rbac.Attrs{"type": "eth"}, false},
+		{"unknown attrs", rbac.KeysExport, rbac.Attrs{}, false},
+		{"attrs not known yet", rbac.JobsCreate, nil, true},
+		{"job type", rbac.JobsCreate, rbac.Attrs{"type": "webhook"}, true},
+		{"other job type", rbac.JobsCreate, rbac.Attrs{"type": "cron"}, false},
+		{"resource wildcard", rbac.BridgesUpdate, nil, true},
+		{"resource wildcard with attrs", rbac.BridgesDelete, rbac.Attrs{}, true},
+		{"not granted", rbac.JobsDelete, nil, false},
+		{"not granted resource", rbac.TransfersCreate, rbac.Attrs{"chain": "evm"}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ps.Allows(tt.p, tt.attrs))
+		})
+	}
+
+	assert.Equal(t, []string{"keys.export:type=csa", "jobs.create:type=webhook", "bridges.*"}, ps.Strings())
+}
+
+func TestRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	admin := rbac.RolePermissions(sessions.UserRoleAdmin)
+	for _, info := range rbac.Catalog() {
+		assert.True(t, admin.Allows(info.Permission, rbac.Attrs{}), info.Permission)
+	}
+
+	edit := rbac.RolePermissions(sessions.UserRoleEdit)
+	assert.True(t, edit.Allows(rbac.JobsCreate, rbac.Attrs{"type": "webhook"}))
+	assert.True(t, edit.Allows(rbac.KeysCreate, rbac.Attrs{"type": "eth"}))
+	assert.True(t, edit.Allows(rbac.RunsCreate, nil))
+	assert.False(t, edit.Allows(rbac.KeysExport, rbac.Attrs{"type": "eth"}))
+	assert.False(t, edit.Allows(rbac.TransfersCreate, rbac.Attrs{"chain": "evm"}))
+	assert.False(t, edit.Allows(rbac.UsersManage, nil))
+	assert.False(t, edit.Allows(rbac.RolesManage, nil))
+
+	run := rbac.RolePermissions(sessions.UserRoleRun)
+	assert.True(t, run.Allows(rbac.RunsCreate, nil))
+	assert.True(t, run.Allows(rbac.ChainsReplay, nil))
+	assert.False(t, run.Allows(rbac.JobsCreate, nil))
+
+	view := rbac.RolePermissions(sessions.UserRoleView)
+	for _, info := range rbac.Catalog() {
+		assert.False(t, view.Allows(info.Permission, nil), info.Permission)
+	}
+
+	assert.Empty(t, rbac.RolePermissions("unknown"))
+}
+
+func TestRole_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, rbac.Role{Name: "key-exporter", Permissions: []string{"keys.export"}}.Validate())
+	require.NoError(t, rbac.Role{Name: "nothing", Permissions: []string{}}.Validate())
+	require.ErrorContains(t, rbac.Role{Name: "admin"}.Validate(), "built in")
+	require.ErrorContains(t, rbac.Role{Name: "Key Exporter"}.Validate(), "role name")
+	require.ErrorContains(t, rbac.Role{Name: "exporter", Permissions: []string{"keys.explode"}}.Validate(), "unknown permission")
+
+	for _, r := range rbac.BuiltInRoles() {
+		assert.True(t, r.BuiltIn)
+		_, err := r.Grants()
+		require.NoError(t, err)
+	}
+}
+
+func TestPermissions_Intersect(t *testing.T) {
+	t.Parallel()
+
+	parse := func(grants ...string) rbac.Permissions {
+		ps, err := rbac.ParsePermissions(grants)
+		require.NoError(t, err)
+		return ps
+	}
+
+	for _, tt := range []struct {
+		name string
+		a, b rbac.Permissions
+		want []string
+	}{
+		{"admin narrowed to scope", parse("*"), parse("jobs.create:type=webhook", "bridges.*"), []string{"jobs.create:type=webhook", "bridges.*"}},
+		{"scope wider than role", parse("jobs.create:type=webhook", "runs.create"), parse("jobs.*"), []string{"jobs.create:type=webhook"}},
+		{"resource wildcards", parse("jobs.*"), parse("*", "keys.*"), []string{"jobs.*"}},
+		{"attributes merged", parse("keys.export:type=csa"), parse("keys.export"), []string{"keys.export:type=csa"}},
+		{"conflicting attributes", parse("keys.export:type=csa"), parse("keys.export:type=eth"), []string{}},
+		{"disjoint", parse("bridges.*"), parse("keys.*", "runs.create"), []string{}},
+		{"empty scope", parse("*"), parse(), []string{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.Intersect(tt.b).Strings())
+			// the intersection is symmetric, up to the order of the grants
+			assert.ElementsMatch(t, tt.want, tt.b.Intersect(tt.a).Strings())
+		})
+	}
+
+	scoped := parse("*").Intersect(parse("transfers.create:chain=evm"))
+	assert.True(t, scoped.Allows(rbac.TransfersCreate, rbac.Attrs{"chain": "evm"}))
+	assert.False(t, scoped.Allows(rbac.TransfersCreate, rbac.Attrs{"chain": "solana"}))
+	assert.False(t, scoped.Allows(rbac.JobsCreate, nil))
+}
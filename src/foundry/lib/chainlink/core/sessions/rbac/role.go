@@ -0,0 +1,102 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// Role is a named set of permission grants.
+type Role struct {
+	Name        string
+	Description string
+	Permissions pq.StringArray
+	// BuiltIn roles are the admin, edit, run and view roles of users, which can't be modified.
+	BuiltIn   bool `db:"-"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var roleNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+var runPermissions = []string{
+	string(RunsCreate),
+	string(RunsReplay),
+	string(ChainsReplay),
+}
+
+var editPermissions = append(slices.Clone(runPermissions),
+	string(ExternalInitiatorsCreate),
+	string(ExternalInitiatorsDelete),
+	string(BridgesCreate),
+	string(BridgesUpdate),
+	string(BridgesDelete),
+	string(KeysCreate),
+	string(JobsCreate),
+	string(JobsUpdate),
+	string(JobsDelete),
+	string(JobsPause),
+	string(JobsExport),
+	string(ForwardersManage),
+	string(FeedsManage),
+	string(JobProposalsManage),
+)
+
+// builtInRoles map the roles of users onto permissions. Every authenticated
+// user can read the state of the node, so view has no permission.
+var builtInRoles = []Role{
+	{Name: string(sessions.UserRoleAdmin), Description: "Every permission", Permissions: pq.StringArray{Wildcard}, BuiltIn: true},
+	{Name: string(sessions.UserRoleEdit), Description: "Manage jobs, bridges and feeds, create keys and run jobs", Permissions: editPermissions, BuiltIn: true},
+	{Name: string(sessions.UserRoleRun), Description: "Run and replay jobs", Permissions: runPermissions, BuiltIn: true},
+	{Name: string(sessions.UserRoleView), Description: "Read only", Permissions: pq.StringArray{}, BuiltIn: true},
+}
+
+// BuiltInRoles returns the roles of users.
+func BuiltInRoles() []Role {
+	return slices.Clone(builtInRoles)
+}
+
+func builtInRole(name string) (Role, bool) {
+	i := slices.IndexFunc(builtInRoles, func(r Role) bool { return r.Name == name })
+	if i < 0 {
+		return Role{}, false
+	}
+	return builtInRoles[i], true
+}
+
+// RolePermissions returns the permissions of a built-in role of users.
+func RolePermissions(role sessions.UserRole) Permissions {
+	r, ok := builtInRole(string(role))
+	if !ok {
+		return Permissions{}
+	}
+	ps, err := r.Grants()
+	if err != nil {
+		// the built-in roles are valid
+		panic(err)
+	}
+	return ps
+}
+
+// Grants parses the permissions of the role.
+func (r Role) Grants() (Permissions, error) {
+	return ParsePermissions(r.Permissions)
+}
+
+// Validate checks the name and permissions of a custom role.
+func (r Role) Validate() error {
+	if _, ok := builtInRole(r.Name); ok {
+		return fmt.Errorf("role %q is built in", r.Name)
+	}
+	if !roleNameRegexp.MatchString(r.Name) {
+		return errors.New("role name must start with a lowercase letter, and contain at most 64 lowercase letters, digits, '_' and '-'")
+	}
+	_, err := r.Grants()
+	return err
+}
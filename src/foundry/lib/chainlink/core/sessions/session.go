@@ -19,6 +19,9 @@ type SessionRequest struct {
 	WebAuthnData   string `json:"webauthndata"`
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
+	// AuthorizationCode and CodeVerifier complete a login redirected to the identity provider
+	AuthorizationCode string `json:"-"`
+	CodeVerifier      string `json:"-"`
 }
 
 // Session holds the unique id for the authenticated session.
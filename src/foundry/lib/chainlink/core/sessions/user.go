@@ -21,6 +21,10 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// CustomRole names a custom role of the rbac package, which replaces the permissions of Role.
+	CustomRole null.String
+	// TokenCustomRole names a custom role for the requests authenticated by the API token of the user.
+	TokenCustomRole null.String
 }
 
 type UserRole string
@@ -30,6 +30,15 @@ const (
 	// ExternalInitiatorSecretHeader is the header name for the secret used by
 	// external initiators to authenticate
 	ExternalInitiatorSecretHeader = "X-Chainlink-EA-Secret"
+	// WebhookSignatureHeader is the header name for the signature of requests
+	// triggering signed webhook jobs
+	WebhookSignatureHeader = "X-Chainlink-Webhook-Signature"
+	// WebhookTimestampHeader is the header name for the unix time signed
+	// webhook requests were signed at
+	WebhookTimestampHeader = "X-Chainlink-Webhook-Timestamp"
+	// WebhookNonceHeader is the header name for the nonce of signed webhook
+	// requests
+	WebhookNonceHeader = "X-Chainlink-Webhook-Nonce"
 )
 
 func buildPrettyVersion() string {
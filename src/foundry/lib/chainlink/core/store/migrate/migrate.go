@@ -38,6 +38,7 @@ func NewProvider(ctx context.Context, db *sql.DB) (*goose.Provider, error) {
 		migrations.Migration54,
 		migrations.Migration56,
 		migrations.Migration195,
+		migrations.Migration282,
 	}
 
 	logMigrations := os.Getenv("CL_LOG_SQL_MIGRATIONS")
@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- Task runs produced by an iteration of a foreach task reference the parent task run
+ALTER TABLE pipeline_task_runs ADD COLUMN parent_task_run_id uuid;
+CREATE INDEX idx_pipeline_task_runs_parent_task_run_id ON pipeline_task_runs (parent_task_run_id) WHERE parent_task_run_id IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_pipeline_task_runs_parent_task_run_id;
+ALTER TABLE pipeline_task_runs DROP COLUMN parent_task_run_id;
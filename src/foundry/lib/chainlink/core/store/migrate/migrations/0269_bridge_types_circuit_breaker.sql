@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN circuit_breaker_failure_threshold bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_failure_threshold >= 0),
+    ADD COLUMN circuit_breaker_cool_down bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_cool_down >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN circuit_breaker_failure_threshold,
+    DROP COLUMN circuit_breaker_cool_down;
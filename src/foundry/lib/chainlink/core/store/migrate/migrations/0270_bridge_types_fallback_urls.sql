@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN fallback_urls text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN url_strategy text NOT NULL DEFAULT 'fallback' CHECK (url_strategy IN ('fallback', 'round-robin', 'hedged', 'fastest')),
+    ADD COLUMN hedge_delay bigint NOT NULL DEFAULT 0 CHECK (hedge_delay >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN fallback_urls,
+    DROP COLUMN url_strategy,
+    DROP COLUMN hedge_delay;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN concurrency_policy text NOT NULL DEFAULT 'allow' CHECK (concurrency_policy IN ('allow', 'forbid', 'replace')),
+    ADD COLUMN catch_up bigint NOT NULL DEFAULT 0 CHECK (catch_up >= 0),
+    ADD COLUMN timezone text NOT NULL DEFAULT '',
+    ADD COLUMN jitter bigint NOT NULL DEFAULT 0 CHECK (jitter >= 0),
+    ADD COLUMN last_fired_at timestamp with time zone;
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN concurrency_policy,
+    DROP COLUMN catch_up,
+    DROP COLUMN timezone,
+    DROP COLUMN jitter,
+    DROP COLUMN last_fired_at;
@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE webhook_specs
+    ADD COLUMN signature_algorithm text NOT NULL DEFAULT '' CHECK (signature_algorithm IN ('', 'hmac-sha256', 'ed25519')),
+    ADD COLUMN signature_key text NOT NULL DEFAULT '',
+    ADD COLUMN signature_tolerance bigint NOT NULL DEFAULT 0 CHECK (signature_tolerance >= 0);
+
+CREATE TABLE webhook_nonces (
+    webhook_spec_id integer NOT NULL REFERENCES webhook_specs (id) ON DELETE CASCADE,
+    nonce text NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (webhook_spec_id, nonce)
+);
+
+CREATE INDEX idx_webhook_nonces_expires_at ON webhook_nonces (expires_at);
+
+-- +goose Down
+DROP TABLE webhook_nonces;
+
+ALTER TABLE webhook_specs
+    DROP COLUMN signature_algorithm,
+    DROP COLUMN signature_key,
+    DROP COLUMN signature_tolerance;
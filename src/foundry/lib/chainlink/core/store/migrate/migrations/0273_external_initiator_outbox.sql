@@ -0,0 +1,31 @@
+-- +goose Up
+ALTER TABLE external_initiators
+    ADD COLUMN last_success_at timestamp with time zone,
+    ADD COLUMN last_failure_at timestamp with time zone,
+    ADD COLUMN consecutive_failures bigint NOT NULL DEFAULT 0,
+    ADD COLUMN last_error text;
+
+CREATE TABLE external_initiator_notifications (
+    id bigserial PRIMARY KEY,
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    kind text NOT NULL CHECK (kind IN ('job_created', 'job_deleted', 'run_finished')),
+    method text NOT NULL,
+    url text NOT NULL,
+    body bytea,
+    pipeline_run_id bigint,
+    attempts bigint NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL,
+    last_error text,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_external_initiator_notifications_next_attempt_at ON external_initiator_notifications (next_attempt_at);
+
+-- +goose Down
+DROP TABLE external_initiator_notifications;
+
+ALTER TABLE external_initiators
+    DROP COLUMN last_success_at,
+    DROP COLUMN last_failure_at,
+    DROP COLUMN consecutive_failures,
+    DROP COLUMN last_error;
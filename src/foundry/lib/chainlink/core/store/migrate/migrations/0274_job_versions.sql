@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE job_versions (
+    id bigserial PRIMARY KEY,
+    job_id int NOT NULL,
+    version int NOT NULL,
+    spec text NOT NULL,
+    author text NOT NULL DEFAULT '',
+    pipeline_spec_id int NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT fk_job_versions_job FOREIGN KEY (job_id) REFERENCES jobs (id) DEFERRABLE,
+    CONSTRAINT fk_job_versions_pipeline_spec FOREIGN KEY (pipeline_spec_id) REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+    CONSTRAINT uq_job_versions_job_version UNIQUE (job_id, version)
+);
+
+-- Updating a job replaces its row, the runs of the previous versions are kept
+-- with their pipeline specs. They are deleted with the pipeline specs when the
+-- job is deleted.
+ALTER TABLE pipeline_runs DROP CONSTRAINT fk_pipeline_runs_pruning_key;
+ALTER TABLE pipeline_runs ADD CONSTRAINT fk_pipeline_runs_pruning_key FOREIGN KEY (pruning_key) REFERENCES jobs (id) DEFERRABLE;
+
+-- +goose Down
+ALTER TABLE pipeline_runs DROP CONSTRAINT fk_pipeline_runs_pruning_key;
+ALTER TABLE pipeline_runs ADD CONSTRAINT fk_pipeline_runs_pruning_key FOREIGN KEY (pruning_key) REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE;
+
+DROP TABLE job_versions;
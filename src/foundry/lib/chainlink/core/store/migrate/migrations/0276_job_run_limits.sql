@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE jobs
+    ADD COLUMN max_concurrent_runs bigint CHECK (max_concurrent_runs >= 0),
+    ADD COLUMN max_runs_per_minute bigint CHECK (max_runs_per_minute >= 0),
+    ADD COLUMN run_limit_mode text CHECK (run_limit_mode IN ('queue', 'drop'));
+
+-- +goose Down
+ALTER TABLE jobs
+    DROP COLUMN max_concurrent_runs,
+    DROP COLUMN max_runs_per_minute,
+    DROP COLUMN run_limit_mode;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE jobs
+    ADD COLUMN retention_max_successful_runs bigint CHECK (retention_max_successful_runs >= 0),
+    ADD COLUMN retention_keep_failed_for bigint NOT NULL DEFAULT 0 CHECK (retention_keep_failed_for >= 0),
+    ADD COLUMN retention_keep_tags text[];
+
+-- +goose Down
+ALTER TABLE jobs
+    DROP COLUMN retention_max_successful_runs,
+    DROP COLUMN retention_keep_failed_for,
+    DROP COLUMN retention_keep_tags;
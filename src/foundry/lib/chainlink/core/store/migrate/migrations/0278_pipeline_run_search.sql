@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX idx_pipeline_runs_pruning_key_id ON pipeline_runs (pruning_key, id);
+CREATE INDEX idx_pipeline_runs_state_id ON pipeline_runs (state, id);
+CREATE INDEX idx_pipeline_task_runs_errored_type ON pipeline_task_runs (type, pipeline_run_id) WHERE error IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pipeline_runs_pruning_key_id;
+DROP INDEX idx_pipeline_runs_state_id;
+DROP INDEX idx_pipeline_task_runs_errored_type;
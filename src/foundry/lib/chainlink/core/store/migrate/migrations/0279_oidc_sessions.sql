@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS oidc_sessions (
+    id text PRIMARY KEY,
+    user_email text NOT NULL,
+    user_role user_roles,
+    localauth_user BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_oidc_sessions_user_email ON oidc_sessions (user_email, created_at);
+
+-- +goose Down
+DROP TABLE oidc_sessions;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE roles (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE users
+    ADD COLUMN custom_role TEXT REFERENCES roles (name),
+    ADD COLUMN token_custom_role TEXT REFERENCES roles (name);
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN custom_role,
+    DROP COLUMN token_custom_role;
+
+DROP TABLE roles;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email TEXT NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_key TEXT NOT NULL UNIQUE,
+    token_salt TEXT NOT NULL,
+    token_hashed_secret TEXT NOT NULL,
+    permissions TEXT[],
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    last_used_ip TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (user_email, name)
+);
+
+-- +goose Down
+DROP TABLE api_tokens;
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const (
+	createPipelineSpecBridges = `
+	CREATE TABLE pipeline_spec_bridges (
+		pipeline_spec_id int NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+		bridge_name text NOT NULL,
+		PRIMARY KEY (bridge_name, pipeline_spec_id)
+	);
+	CREATE INDEX idx_pipeline_spec_bridges_pipeline_spec_id ON pipeline_spec_bridges (pipeline_spec_id);
+	`
+
+	dropPipelineSpecBridges = `DROP TABLE pipeline_spec_bridges;`
+)
+
+// Up282 creates the table of the bridges called by each pipeline spec, and
+// fills it from the DAG sources of the existing specs.
+func Up282(ctx context.Context, tx *sql.Tx) error {
+	if _, err := tx.ExecContext(ctx, createPipelineSpecBridges); err != nil {
+		return errors.Wrap(err, "failed to create pipeline_spec_bridges")
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, dot_dag_source FROM pipeline_specs WHERE strpos(dot_dag_source, 'bridge') > 0`)
+	if err != nil {
+		return errors.Wrap(err, "failed to load pipeline specs")
+	}
+	sources := make(map[int32]string)
+	for rows.Next() {
+		var id int32
+		var source string
+		if err = rows.Scan(&id, &source); err != nil {
+			rows.Close()
+			return errors.Wrap(err, "failed to scan pipeline spec")
+		}
+		sources[id] = source
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to load pipeline specs")
+	}
+
+	for id, source := range sources {
+		p, err := pipeline.Parse(source)
+		if err != nil {
+			// specs that no longer parse cannot be run, nor call any bridge
+			continue
+		}
+		names := p.BridgeNames()
+		if len(names) == 0 {
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, `INSERT INTO pipeline_spec_bridges (pipeline_spec_id, bridge_name) SELECT $1, unnest($2::text[])`, id, pq.Array(names)); err != nil {
+			return errors.Wrapf(err, "failed to insert bridges of pipeline spec %d", id)
+		}
+	}
+	return nil
+}
+
+func Down282(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, dropPipelineSpecBridges)
+	return err
+}
+
+var Migration282 = goose.NewGoMigration(282, &goose.GoFunc{RunTx: Up282}, &goose.GoFunc{RunTx: Down282})
@@ -0,0 +1,167 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// APITokensController manages the named API tokens of users.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// CreateAPITokenRequest defines the request to create a named API token. Nil
+// Permissions grant the token every permission of the user, and a nil
+// ExpiresAt never expires it.
+type CreateAPITokenRequest struct {
+	Name        string     `json:"name"`
+	Password    string     `json:"password"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
+// Index lists the named API tokens of the current user.
+// Example:
+// "GET <application>/user/tokens"
+func (atc *APITokensController) Index(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tokens, err := atc.App.APITokenORM().ListTokens(c.Request.Context(), sessionUser.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// IndexAll lists the named API tokens of every user, or of the user of the email query parameter.
+// Example:
+// "GET <application>/api_tokens"
+func (atc *APITokensController) IndexAll(c *gin.Context) {
+	tokens, err := atc.App.APITokenORM().ListTokens(c.Request.Context(), c.Query("email"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// Create creates a named API token for the current user. The secret of the
+// token is only returned in the response.
+// Example:
+// "POST <application>/user/tokens"
+func (atc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	token := apitokens.Token{
+		UserEmail:   sessionUser.Email,
+		Name:        request.Name,
+		Permissions: request.Permissions,
+		ExpiresAt:   null.TimeFromPtr(request.ExpiresAt),
+	}
+	if err := token.Validate(time.Now()); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// In order to create an API token, login validation with provided password must succeed
+	if err := atc.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password); err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		atc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": sessionUser.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	secret, err := atc.App.APITokenORM().CreateToken(ctx, &token)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("API token %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.NamedAPITokenCreated, map[string]interface{}{
+		"user":        token.UserEmail,
+		"name":        token.Name,
+		"permissions": []string(token.Permissions),
+		"expiresAt":   token.ExpiresAt,
+	})
+
+	resource := presenters.NewAPITokenResource(token)
+	resource.Secret = secret.Secret
+	jsonAPIResponseWithStatus(c, resource, "api_token", http.StatusCreated)
+}
+
+// Revoke revokes a named API token of the current user.
+// Example:
+// "DELETE <application>/user/tokens/:name"
+func (atc *APITokensController) Revoke(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	atc.revoke(c, sessionUser.Email, c.Param("name"))
+}
+
+// RevokeForUser revokes a named API token of any user.
+// Example:
+// "DELETE <application>/api_tokens/:email/:name"
+func (atc *APITokensController) RevokeForUser(c *gin.Context) {
+	atc.revoke(c, c.Param("email"), c.Param("name"))
+}
+
+func (atc *APITokensController) revoke(c *gin.Context, email, name string) {
+	err := atc.App.APITokenORM().RevokeToken(c.Request.Context(), email, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var revokedBy string
+	if sessionUser, ok := webauth.GetAuthenticatedUser(c); ok {
+		revokedBy = sessionUser.Email
+	}
+	atc.App.GetAuditLogger().Audit(audit.NamedAPITokenRevoked, map[string]interface{}{
+		"user":      email,
+		"name":      name,
+		"revokedBy": revokedBy,
+	})
+	jsonAPIResponseWithStatus(c, nil, "api_token", http.StatusNoContent)
+}
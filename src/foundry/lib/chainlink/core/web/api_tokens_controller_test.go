@@ -0,0 +1,152 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func requestWithAPIToken(t *testing.T, app *cltest.TestApplication, method, path string, token presenters.APITokenResource) *http.Response {
+	req, err := http.NewRequestWithContext(testutils.Context(t), method, app.Server.URL+path, bytes.NewBufferString("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webauth.APIKey, token.AccessKey)
+	req.Header.Set(webauth.APISecret, token.Secret)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestAPITokensController_CreateUseAndRevoke(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleEdit})
+
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBufferString(fmt.Sprintf(
+		`{"name": "ci", "password": "%s", "permissions": ["runs.create", "bridges.create"]}`, cltest.Password)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var token presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &token))
+	assert.Equal(t, "ci", token.Name)
+	assert.NotEmpty(t, token.AccessKey)
+	assert.NotEmpty(t, token.Secret)
+	assert.Equal(t, []string{"runs.create", "bridges.create"}, token.Permissions)
+
+	// reads are allowed, and the token is granted bridges.create
+	resp = requestWithAPIToken(t, app, "GET", "/v2/bridge_types", token)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = requestWithAPIToken(t, app, "POST", "/v2/bridge_types", token)
+	assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+	assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
+	// but not the other permissions of the edit role of its user
+	resp = requestWithAPIToken(t, app, "DELETE", "/v2/bridge_types/MOCK", token)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/user/tokens")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &tokens))
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+	require.NotNil(t, tokens[0].LastUsedAt)
+	assert.NotEmpty(t, tokens[0].LastUsedIP)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = requestWithAPIToken(t, app, "GET", "/v2/bridge_types", token)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAPITokensController_Create_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBufferString(fmt.Sprintf(`{"name": "ci", "password": "%s"}`, cltest.Password)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{"Invalid name", fmt.Sprintf(`{"name": "my token", "password": "%s"}`, cltest.Password), http.StatusBadRequest, "token name must start with a letter or digit, and contain at most 64 letters, digits, '_', '.' and '-'"},
+		{"Invalid scope", fmt.Sprintf(`{"name": "ci2", "password": "%s", "permissions": ["keys.explode"]}`, cltest.Password), http.StatusBadRequest, `invalid grant "keys.explode": unknown permission`},
+		{"Expired", fmt.Sprintf(`{"name": "ci2", "password": "%s", "expiresAt": "%s"}`, cltest.Password, time.Now().Add(-time.Hour).Format(time.RFC3339)), http.StatusBadRequest, "token expiry must be in the future"},
+		{"Wrong password", `{"name": "ci2", "password": "wrong"}`, http.StatusUnauthorized, "incorrect password"},
+		{"Duplicate name", fmt.Sprintf(`{"name": "ci", "password": "%s"}`, cltest.Password), http.StatusBadRequest, "API token ci already exists"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBufferString(tc.reqBody))
+			t.Cleanup(cleanup)
+			errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+			require.Len(t, errors.Errors, 1)
+			assert.Equal(t, tc.wantErrMessage, errors.Errors[0].Detail)
+		})
+	}
+}
+
+func TestAPITokensController_IndexAll(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	viewer := cltest.User{Role: sessions.UserRoleView}
+	viewerClient := app.NewHTTPClient(&viewer)
+	admin := app.NewHTTPClient(nil)
+
+	resp, cleanup := viewerClient.Post("/v2/user/tokens", bytes.NewBufferString(fmt.Sprintf(`{"name": "ci", "password": "%s"}`, cltest.Password)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	resp, cleanup = viewerClient.Get("/v2/api_tokens")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, cleanup = admin.Get("/v2/api_tokens?email=" + viewer.Email)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, viewer.Email, tokens[0].UserEmail)
+
+	resp, cleanup = admin.Delete("/v2/api_tokens/" + viewer.Email + "/ci")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
 
@@ -22,6 +30,9 @@ const (
 	// APISecret is the header name for the API token secret for user authentication.
 	APISecret = "X-API-SECRET"
 
+	// AuthorizationHeader is the header name for the bearer access token for user authentication.
+	AuthorizationHeader = "Authorization"
+
 	// SessionName is the session name
 	SessionName = "clsession"
 
@@ -33,6 +44,24 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionWebhookSignatureKey is the webhook signature key in the session map
+	SessionWebhookSignatureKey = "webhook_signature"
+
+	// SessionPermissionsKey is the permissions of the User key in the session map
+	SessionPermissionsKey = "permissions"
+
+	// SessionAPITokenKey is the named API token key in the session map
+	SessionAPITokenKey = "api_token"
+
+	// SessionTokenRoleKey is the custom role of the API token of the User key in the session map
+	SessionTokenRoleKey = "token_role"
+
+	// signedRequestRole is the role reported for signed webhook requests
+	signedRequestRole = "webhook_signature"
+
+	// namedTokenUseInterval is how often the use of a named API token is recorded
+	namedTokenUseInterval = time.Minute
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -42,6 +71,18 @@ type Authenticator interface {
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(ctx context.Context, email string) (clsessions.User, error)
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
+	FindUserByBearerToken(ctx context.Context, token string) (clsessions.User, error)
+}
+
+// PermissionResolver resolves the permissions of authenticated users.
+type PermissionResolver interface {
+	UserPermissions(ctx context.Context, user clsessions.User) (rbac.Permissions, error)
+}
+
+// APITokenAuthenticator authenticates the named API tokens of users.
+type APITokenAuthenticator interface {
+	FindUserByToken(ctx context.Context, accessKey string) (clsessions.User, apitokens.Token, error)
+	MarkUsed(ctx context.Context, id int64, ip string) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -107,12 +148,102 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	// The role of the API token narrows the permissions of the user
+	if user.TokenCustomRole.Valid {
+		c.Set(SessionTokenRoleKey, user.TokenCustomRole.String)
+	}
 
 	return nil
 }
 
 var _ authMethod = AuthenticateByToken
 
+// AuthenticateByNamedToken returns an authMethod which authenticates a User by
+// one of their named API tokens, passed in the same headers as their API token.
+// Expired tokens fail to authenticate and are audited, and the last use of the
+// token is recorded at most once per namedTokenUseInterval.
+func AuthenticateByNamedToken(tokens APITokenAuthenticator, lggr logger.Logger, auditLogger audit.AuditLogger) func(*gin.Context, Authenticator) error {
+	return func(c *gin.Context, _ Authenticator) error {
+		ctx := c.Request.Context()
+		token := &auth.Token{
+			AccessKey: c.GetHeader(APIKey),
+			Secret:    c.GetHeader(APISecret),
+		}
+		if token.AccessKey == "" {
+			return auth.ErrorAuthFailed
+		}
+
+		user, apiToken, err := tokens.FindUserByToken(ctx, token.AccessKey)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				auditLogger.Audit(audit.NamedAPITokenAuthFailed, map[string]interface{}{
+					"ip":     c.ClientIP(),
+					"reason": "not_found",
+				})
+				return auth.ErrorAuthFailed
+			}
+			return err
+		}
+
+		ok, err := apiToken.Authenticate(token)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		reason := ""
+		if !ok {
+			reason = "bad_secret"
+		} else if apiToken.Expired(now) {
+			reason = "expired"
+		}
+		if reason != "" {
+			auditLogger.Audit(audit.NamedAPITokenAuthFailed, map[string]interface{}{
+				"id":     apiToken.ID,
+				"name":   apiToken.Name,
+				"user":   apiToken.UserEmail,
+				"ip":     c.ClientIP(),
+				"reason": reason,
+			})
+			return auth.ErrorAuthFailed
+		}
+
+		// Recording the use is best effort, and skipped for tokens used recently
+		if !apiToken.LastUsedAt.Valid || now.Sub(apiToken.LastUsedAt.Time) >= namedTokenUseInterval {
+			if err = tokens.MarkUsed(ctx, apiToken.ID, c.ClientIP()); err != nil {
+				lggr.Warnw("Failed to record the use of the API token", "id", apiToken.ID, "err", err)
+			}
+		}
+
+		c.Set(SessionUserKey, &user)
+		c.Set(SessionAPITokenKey, &apiToken)
+
+		return nil
+	}
+}
+
+// AuthenticateByBearerToken authenticates a User by an access token of the
+// authentication provider, passed in the Authorization header.
+//
+// Implements authMethod
+func AuthenticateByBearerToken(c *gin.Context, authr Authenticator) error {
+	scheme, token, ok := strings.Cut(c.GetHeader(AuthorizationHeader), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return auth.ErrorAuthFailed
+	}
+
+	// Invalid tokens, and providers without bearer tokens, fall through to the next method
+	user, err := authr.FindUserByBearerToken(c.Request.Context(), strings.TrimSpace(token))
+	if err != nil {
+		return auth.ErrorAuthFailed
+	}
+
+	c.Set(SessionUserKey, &user)
+
+	return nil
+}
+
+var _ authMethod = AuthenticateByBearerToken
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -152,6 +283,34 @@ func AuthenticateExternalInitiator(c *gin.Context, store Authenticator) error {
 
 var _ authMethod = AuthenticateExternalInitiator
 
+// AuthenticateBySignature accepts requests signed for a webhook job. The
+// signature can only be verified against the job and the body of the request,
+// so verifying it is left to the webhook.Authorizer of the handler. Signed
+// requests are not made by a user, they are only granted
+// signedRequestPermissions.
+//
+// Implements authMethod
+func AuthenticateBySignature(c *gin.Context, _ Authenticator) error {
+	signature := webhook.Signature{
+		Signature: c.GetHeader(static.WebhookSignatureHeader),
+		Timestamp: c.GetHeader(static.WebhookTimestampHeader),
+		Nonce:     c.GetHeader(static.WebhookNonceHeader),
+	}
+	if signature.Signature == "" {
+		return auth.ErrorAuthFailed
+	}
+
+	c.Set(SessionWebhookSignatureKey, &signature)
+
+	return nil
+}
+
+var _ authMethod = AuthenticateBySignature
+
+// signedRequestPermissions are the permissions of requests signed for a
+// webhook job, which may only trigger its runs.
+var signedRequestPermissions = rbac.Permissions{{Name: string(rbac.RunsCreate)}}
+
 // Authenticate is middleware which authenticates the request by attempting to
 // authenticate using all the provided methods.
 func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
@@ -174,6 +333,88 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 	}
 }
 
+// ResolvePermissions is middleware which resolves the permissions of the
+// authenticated user, for RequiresPermission.
+func ResolvePermissions(resolver PermissionResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		permissions, err := resolver.UserPermissions(c.Request.Context(), *user)
+		if err == nil {
+			permissions, err = scopeToTokenRole(c, resolver, permissions)
+		}
+		if err == nil {
+			permissions, err = scopeToAPIToken(c, permissions)
+		}
+		if err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Set(SessionPermissionsKey, permissions)
+		c.Next()
+	}
+}
+
+// GetAuthenticatedPermissions extracts the permissions of the authenticated
+// user from the context. Without ResolvePermissions, users have the
+// permissions of their built-in role, or none with a custom role, of their own
+// or of their API token. Signed requests have signedRequestPermissions.
+func GetAuthenticatedPermissions(c *gin.Context) rbac.Permissions {
+	if obj, ok := c.Get(SessionPermissionsKey); ok {
+		if permissions, ok := obj.(rbac.Permissions); ok {
+			return permissions
+		}
+	}
+	user, ok := GetAuthenticatedUser(c)
+	if _, isSigned := GetWebhookSignature(c); isSigned && !ok {
+		return signedRequestPermissions
+	}
+	if !ok || user.CustomRole.Valid || c.GetString(SessionTokenRoleKey) != "" {
+		return rbac.Permissions{}
+	}
+	permissions, err := scopeToAPIToken(c, rbac.RolePermissions(user.Role))
+	if err != nil {
+		return rbac.Permissions{}
+	}
+	return permissions
+}
+
+// scopeToTokenRole narrows the permissions of the user to the custom role of
+// their API token, if the request is authenticated by it. The role can not
+// grant more than the user is granted.
+func scopeToTokenRole(c *gin.Context, resolver PermissionResolver, permissions rbac.Permissions) (rbac.Permissions, error) {
+	role := c.GetString(SessionTokenRoleKey)
+	if role == "" {
+		return permissions, nil
+	}
+	rolePermissions, err := resolver.UserPermissions(c.Request.Context(), clsessions.User{CustomRole: null.StringFrom(role)})
+	if err != nil {
+		return nil, err
+	}
+	return permissions.Intersect(rolePermissions), nil
+}
+
+// scopeToAPIToken narrows the permissions of the user to the scope of the named
+// API token the request is authenticated by, if any.
+func scopeToAPIToken(c *gin.Context, permissions rbac.Permissions) (rbac.Permissions, error) {
+	token, ok := GetAuthenticatedAPIToken(c)
+	if !ok {
+		return permissions, nil
+	}
+	scope, err := token.Scope()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid scope of API token %s", token.Name)
+	}
+	if scope == nil {
+		return permissions, nil
+	}
+	return permissions.Intersect(scope), nil
+}
+
 // GetAuthenticatedUser extracts the authentication user from the context.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	obj, ok := c.Get(SessionUserKey)
@@ -186,6 +427,19 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuthenticatedAPIToken extracts the named API token the request is
+// authenticated by from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*apitokens.Token, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	token, ok := obj.(*apitokens.Token)
+
+	return token, ok
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -197,8 +451,60 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
+// GetWebhookSignature extracts the unverified webhook signature from the
+// context.
+func GetWebhookSignature(c *gin.Context) (*webhook.Signature, bool) {
+	obj, ok := c.Get(SessionWebhookSignatureKey)
+	if !ok {
+		return nil, false
+	}
+
+	return obj.(*webhook.Signature), ok
+}
+
+// RequiresPermission extracts the user object, or the webhook signature, from the context, and
+// asserts it is granted p with attrs. Nil attrs accept grants restricted to attributes, the handler
+// must then check the action with AuthorizePermission once its attributes are known.
+func RequiresPermission(p rbac.Permission, attrs rbac.Attrs, handler func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		_, isUser := GetAuthenticatedUser(c)
+		if _, isSigned := GetWebhookSignature(c); !isUser && !isSigned {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			return
+		}
+		if !AuthorizePermission(c, p, attrs) {
+			return
+		}
+		handler(c)
+	}
+}
+
+// AuthorizePermission returns whether the authenticated user is granted p with
+// attrs, or aborts the request as Forbidden.
+func AuthorizePermission(c *gin.Context, p rbac.Permission, attrs rbac.Attrs) bool {
+	if GetAuthenticatedPermissions(c).Allows(p, attrs) {
+		return true
+	}
+	c.Abort()
+	var role, email string
+	if user, ok := GetAuthenticatedUser(c); ok {
+		role, email = string(user.Role), user.Email
+		if user.CustomRole.Valid {
+			role = user.CustomRole.String
+		}
+	} else if _, ok := GetWebhookSignature(c); ok {
+		role = signedRequestRole
+	}
+	addForbiddenPermissionHeaders(c, rbac.Grant{Name: string(p), Attrs: attrs}.String(), role, email)
+	jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+	return false
+}
+
 // RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
 // 'run'
+//
+// Deprecated: use RequiresPermission, which supports custom roles.
 func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
@@ -218,6 +524,8 @@ func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 
 // RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
 // 'edit'
+//
+// Deprecated: use RequiresPermission, which supports custom roles.
 func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
@@ -236,6 +544,8 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 }
 
 // RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
+//
+// Deprecated: use RequiresPermission, which supports custom roles.
 func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
@@ -2,22 +2,30 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
@@ -47,6 +55,10 @@ func (u userFindFailer) FindUserByAPIToken(ctx context.Context, token string) (s
 	return sessions.User{}, u.err
 }
 
+func (u userFindFailer) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	return sessions.User{}, u.err
+}
+
 type userFindSuccesser struct {
 	sessions.AuthenticationProvider
 	user sessions.User
@@ -60,6 +72,10 @@ func (u userFindSuccesser) FindUserByAPIToken(ctx context.Context, token string)
 	return u.user, nil
 }
 
+func (u userFindSuccesser) FindUserByBearerToken(ctx context.Context, token string) (sessions.User, error) {
+	return u.user, nil
+}
+
 func TestAuthenticateByToken_Success(t *testing.T) {
 	user := cltest.MustRandomUser(t)
 	key, secret := uuid.New().String(), uuid.New().String()
@@ -107,6 +123,85 @@ func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type rolePermissionResolver map[string][]string
+
+func (r rolePermissionResolver) UserPermissions(ctx context.Context, user sessions.User) (rbac.Permissions, error) {
+	if !user.CustomRole.Valid {
+		return rbac.RolePermissions(user.Role), nil
+	}
+	return rbac.Role{Name: user.CustomRole.String, Permissions: r[user.CustomRole.String]}.Grants()
+}
+
+func TestAuthenticateByToken_TokenRole(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleRun
+	user.TokenCustomRole = null.StringFrom("token-role")
+	key, secret := uuid.New().String(), uuid.New().String()
+	require.NoError(t, user.SetAuthToken(&auth.Token{AccessKey: key, Secret: secret}))
+	resolver := rolePermissionResolver{"token-role": {"jobs.create", "runs.create"}}
+
+	var canRun, canEdit bool
+	router := gin.New()
+	router.Use(webauth.Authenticate(userFindSuccesser{user: user}, webauth.AuthenticateByToken))
+	router.Use(webauth.ResolvePermissions(resolver))
+	router.GET("/", func(c *gin.Context) {
+		permissions := webauth.GetAuthenticatedPermissions(c)
+		canRun = permissions.Allows(rbac.RunsCreate, nil)
+		canEdit = permissions.Allows(rbac.JobsCreate, nil)
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/", nil)
+	req.Header.Set(webauth.APIKey, key)
+	req.Header.Set(webauth.APISecret, secret)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+	assert.True(t, canRun)
+	// the role of the token does not grant more than the role of the user
+	assert.False(t, canEdit)
+}
+
+func TestAuthenticateByBearerToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+
+	tests := []struct {
+		name   string
+		authr  webauth.Authenticator
+		header string
+		status int
+	}{
+		{"valid", userFindSuccesser{user: user}, "Bearer access-token", http.StatusOK},
+		{"case insensitive scheme", userFindSuccesser{user: user}, "bearer access-token", http.StatusOK},
+		{"no header", userFindSuccesser{user: user}, "", http.StatusUnauthorized},
+		{"basic scheme", userFindSuccesser{user: user}, "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"blank token", userFindSuccesser{user: user}, "Bearer ", http.StatusUnauthorized},
+		{"invalid token", userFindFailer{err: sessions.ErrNotSupported}, "Bearer access-token", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			router := gin.New()
+			router.Use(webauth.Authenticate(tt.authr, webauth.AuthenticateByBearerToken))
+			router.GET("/", func(c *gin.Context) {
+				called = true
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req := mustRequest(t, "GET", "/", nil)
+			if tt.header != "" {
+				req.Header.Set(webauth.AuthorizationHeader, tt.header)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status == http.StatusOK, called)
+			assert.Equal(t, http.StatusText(tt.status), http.StatusText(w.Code))
+		})
+	}
+}
+
 func TestAuthenticateByToken_RejectsBlankAccessKey(t *testing.T) {
 	user := cltest.MustRandomUser(t)
 	key, secret := "", uuid.New().String()
@@ -133,6 +228,180 @@ func TestAuthenticateByToken_RejectsBlankAccessKey(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type namedTokenStore struct {
+	user    sessions.User
+	tokens  map[string]apitokens.Token
+	used    []int64
+	usedErr error
+}
+
+func (s *namedTokenStore) FindUserByToken(ctx context.Context, accessKey string) (sessions.User, apitokens.Token, error) {
+	token, ok := s.tokens[accessKey]
+	if !ok {
+		return sessions.User{}, apitokens.Token{}, sql.ErrNoRows
+	}
+	return s.user, token, nil
+}
+
+func (s *namedTokenStore) MarkUsed(ctx context.Context, id int64, ip string) error {
+	s.used = append(s.used, id)
+	return s.usedErr
+}
+
+type auditRecorder struct {
+	audit.AuditLogger
+	events []audit.EventID
+	data   []audit.Data
+}
+
+func (r *auditRecorder) Audit(eventID audit.EventID, data audit.Data) {
+	r.events = append(r.events, eventID)
+	r.data = append(r.data, data)
+}
+
+func newNamedToken(t *testing.T, id int64, permissions []string, expiresAt null.Time) (*auth.Token, apitokens.Token) {
+	token := auth.NewToken()
+	hashedSecret, err := auth.HashedSecret(token, "salt")
+	require.NoError(t, err)
+	return token, apitokens.Token{
+		ID:                id,
+		Name:              fmt.Sprintf("token-%d", id),
+		TokenKey:          token.AccessKey,
+		TokenSalt:         "salt",
+		TokenHashedSecret: hashedSecret,
+		Permissions:       permissions,
+		ExpiresAt:         expiresAt,
+	}
+}
+
+func TestAuthenticateByNamedToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleAdmin
+
+	unscoped, unscopedToken := newNamedToken(t, 1, nil, null.Time{})
+	scoped, scopedToken := newNamedToken(t, 2, []string{"runs.create"}, null.TimeFrom(time.Now().Add(time.Hour)))
+	expired, expiredToken := newNamedToken(t, 3, nil, null.TimeFrom(time.Now().Add(-time.Minute)))
+	recent, recentToken := newNamedToken(t, 4, nil, null.Time{})
+	recentToken.LastUsedAt = null.TimeFrom(time.Now().Add(-time.Second))
+	store := &namedTokenStore{user: user, tokens: map[string]apitokens.Token{
+		unscoped.AccessKey: unscopedToken,
+		scoped.AccessKey:   scopedToken,
+		expired.AccessKey:  expiredToken,
+		recent.AccessKey:   recentToken,
+	}}
+	auditLogger := &auditRecorder{AuditLogger: audit.NoopLogger}
+
+	tests := []struct {
+		name        string
+		key, secret string
+		status      int
+		canRun      bool
+		canEdit     bool
+	}{
+		{"unscoped", unscoped.AccessKey, unscoped.Secret, http.StatusOK, true, true},
+		{"scoped", scoped.AccessKey, scoped.Secret, http.StatusOK, true, false},
+		{"recently used", recent.AccessKey, recent.Secret, http.StatusOK, true, true},
+		{"expired", expired.AccessKey, expired.Secret, http.StatusUnauthorized, false, false},
+		{"wrong secret", scoped.AccessKey, unscoped.Secret, http.StatusUnauthorized, false, false},
+		{"unknown key", "unknown", unscoped.Secret, http.StatusUnauthorized, false, false},
+		{"no key", "", unscoped.Secret, http.StatusUnauthorized, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called, canRun, canEdit bool
+			router := gin.New()
+			router.Use(webauth.Authenticate(userFindFailer{err: sql.ErrNoRows},
+				webauth.AuthenticateByNamedToken(store, logger.TestLogger(t), auditLogger),
+				webauth.AuthenticateByToken,
+			))
+			router.GET("/", func(c *gin.Context) {
+				called = true
+				permissions := webauth.GetAuthenticatedPermissions(c)
+				canRun = permissions.Allows(rbac.RunsCreate, nil)
+				canEdit = permissions.Allows(rbac.JobsCreate, nil)
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req := mustRequest(t, "GET", "/", nil)
+			req.Header.Set(webauth.APIKey, tt.key)
+			req.Header.Set(webauth.APISecret, tt.secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status == http.StatusOK, called)
+			assert.Equal(t, http.StatusText(tt.status), http.StatusText(w.Code))
+			assert.Equal(t, tt.canRun, canRun)
+			assert.Equal(t, tt.canEdit, canEdit)
+		})
+	}
+	assert.Equal(t, []int64{1, 2}, store.used)
+	assert.Equal(t, []audit.EventID{audit.NamedAPITokenAuthFailed, audit.NamedAPITokenAuthFailed, audit.NamedAPITokenAuthFailed}, auditLogger.events)
+	var reasons []interface{}
+	for _, data := range auditLogger.data {
+		reasons = append(reasons, data["reason"])
+	}
+	assert.Equal(t, []interface{}{"expired", "bad_secret", "not_found"}, reasons)
+}
+
+func TestAuthenticateByNamedToken_MarkUsedFails(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	token, apiToken := newNamedToken(t, 1, nil, null.Time{})
+	store := &namedTokenStore{user: user, tokens: map[string]apitokens.Token{token.AccessKey: apiToken}, usedErr: errors.New("db down")}
+
+	called := false
+	router := gin.New()
+	router.Use(webauth.Authenticate(userFindFailer{err: sql.ErrNoRows},
+		webauth.AuthenticateByNamedToken(store, logger.TestLogger(t), audit.NoopLogger),
+	))
+	router.GET("/", func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/", nil)
+	req.Header.Set(webauth.APIKey, token.AccessKey)
+	req.Header.Set(webauth.APISecret, token.Secret)
+	router.ServeHTTP(w, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+	assert.Equal(t, []int64{1}, store.used)
+}
+
+func TestAuthenticateBySignature(t *testing.T) {
+	var isUser bool
+	router := gin.New()
+	router.Use(webauth.Authenticate(userFindFailer{err: sql.ErrNoRows}, webauth.AuthenticateBySignature))
+	router.Use(webauth.ResolvePermissions(rolePermissionResolver{}))
+	handler := func(c *gin.Context) {
+		_, isUser = webauth.GetAuthenticatedUser(c)
+		c.String(http.StatusOK, "")
+	}
+	router.POST("/runs", webauth.RequiresPermission(rbac.RunsCreate, nil, handler))
+	router.POST("/jobs", webauth.RequiresPermission(rbac.JobsCreate, nil, handler))
+
+	send := func(path, signature string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := mustRequest(t, "POST", path, nil)
+		req.Header.Set(static.WebhookSignatureHeader, signature)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send("/runs", "abcd")
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+	// signed requests are not made by a user
+	assert.False(t, isUser)
+
+	w = send("/jobs", "abcd")
+	assert.Equal(t, http.StatusText(http.StatusForbidden), http.StatusText(w.Code))
+	assert.Equal(t, "webhook_signature", w.Header().Get("forbidden-provided-role"))
+
+	w = send("/runs", "")
+	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
@@ -225,6 +494,18 @@ var routesRolesMap = [...]routeRules{
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens", true, true, true},
+	{"DELETE", "/v2/user/tokens/MOCK", true, true, true},
+	{"GET", "/v2/api_tokens", false, false, false},
+	{"DELETE", "/v2/api_tokens/MOCK/MOCK", false, false, false},
+	{"GET", "/v2/roles", true, true, true},
+	{"GET", "/v2/roles/MOCK", true, true, true},
+	{"POST", "/v2/roles", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
+	{"PATCH", "/v2/users/custom_role", false, false, false},
+	{"GET", "/v2/permissions", true, true, true},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -413,10 +694,8 @@ func TestRBAC_Routemap_Edit(t *testing.T) {
 			if route.EditAllowed || route.editMinimalAllowed || route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		}()
 	}
@@ -460,10 +739,8 @@ func TestRBAC_Routemap_Run(t *testing.T) {
 			if route.editMinimalAllowed || route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		}()
 	}
@@ -508,10 +785,8 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 			if route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		})
 	}
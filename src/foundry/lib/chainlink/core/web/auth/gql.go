@@ -11,12 +11,24 @@ import (
 	"github.com/gin-gonic/gin"
 
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 )
 
 type sessionUserKey struct{}
 type GQLSession struct {
 	SessionID string
 	User      *clsessions.User
+	// Permissions of the User, which defaults to the permissions of its built-in role when nil.
+	Permissions rbac.Permissions
+}
+
+// Allows returns whether the user of the session is granted p with attrs.
+func (s *GQLSession) Allows(p rbac.Permission, attrs rbac.Attrs) bool {
+	permissions := s.Permissions
+	if permissions == nil && !s.User.CustomRole.Valid {
+		permissions = rbac.RolePermissions(s.User.Role)
+	}
+	return permissions.Allows(p, attrs)
 }
 
 // AuthenticateGQL middleware checks the session cookie for a user and sets it
@@ -24,7 +36,7 @@ type GQLSession struct {
 // to validate whether it requires an authenticated user.
 //
 // We currently only support GQL authentication by session cookie.
-func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.HandlerFunc {
+func AuthenticateGQL(authenticator Authenticator, permissions PermissionResolver, lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		session := sessions.Default(c)
@@ -43,7 +55,13 @@ func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.Handle
 			return
 		}
 
-		ctx = WithGQLAuthenticatedSession(c.Request.Context(), user, sessionID)
+		userPermissions, err := permissions.UserPermissions(ctx, user)
+		if err != nil {
+			lggr.Errorw("Failed to resolve the permissions of the user", "err", err)
+			return
+		}
+
+		ctx = context.WithValue(ctx, sessionUserKey{}, &GQLSession{sessionID, &user, userPermissions})
 
 		c.Request = c.Request.WithContext(ctx)
 	}
@@ -56,7 +74,7 @@ func WithGQLAuthenticatedSession(ctx context.Context, user clsessions.User, sess
 	return context.WithValue(
 		ctx,
 		sessionUserKey{},
-		&GQLSession{sessionID, &user},
+		&GQLSession{SessionID: sessionID, User: &user},
 	)
 }
 
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -16,9 +17,16 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
+type builtInPermissions struct{}
+
+func (builtInPermissions) UserPermissions(_ context.Context, user clsessions.User) (rbac.Permissions, error) {
+	return rbac.RolePermissions(user.Role), nil
+}
+
 func Test_AuthenticateGQL_Unauthenticated(t *testing.T) {
 	t.Parallel()
 
@@ -27,7 +35,7 @@ func Test_AuthenticateGQL_Unauthenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, builtInPermissions{}, logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c)
@@ -51,12 +59,13 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, builtInPermissions{}, logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
 		assert.True(t, ok)
 		assert.NotNil(t, session)
+		assert.True(t, session.Allows(rbac.UsersManage, nil))
 
 		c.String(http.StatusOK, "")
 	})
@@ -32,3 +32,11 @@ func addForbiddenErrorHeaders(c *gin.Context, requiredRole string, providedRole
 	c.Header("forbidden-provided-role", providedRole)
 	c.Header("forbidden-provided-email", providedEmail)
 }
+
+// addForbiddenPermissionHeaders adds custom headers to the 403 (Forbidden) response of a denied
+// permission, like addForbiddenErrorHeaders.
+func addForbiddenPermissionHeaders(c *gin.Context, requiredPermission string, providedRole string, providedEmail string) {
+	c.Header("forbidden-required-permission", requiredPermission)
+	c.Header("forbidden-provided-role", providedRole)
+	c.Header("forbidden-provided-email", providedEmail)
+}
@@ -50,6 +50,14 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	for i, fallbackURL := range bt.FallbackURLs {
+		if len(strings.TrimSpace(fallbackURL.String())) == 0 {
+			fe.Add(fmt.Sprintf("FallbackURLs[%d] must be present", i))
+		}
+	}
+	if bt.URLStrategy == bridges.URLStrategyHedged && bt.HedgeDelay.Duration() <= 0 {
+		fe.Add("HedgeDelay must be positive when using the hedged URL strategy")
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -104,6 +112,8 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
+		"bridgeURLStrategy":            bt.URLStrategy,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -143,7 +153,17 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	if bt.CircuitBreakerFailureThreshold > 0 {
+		state, ok := btc.App.BridgeCircuitBreakers().State(bt.Name)
+		if !ok {
+			// no task has used the bridge since the node started
+			state = bridges.CircuitBreakerState{State: bridges.CircuitClosed}
+		}
+		resource.CircuitBreaker = presenters.NewBridgeCircuitBreakerResource(state)
+	}
+
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -187,6 +207,8 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
+		"bridgeURLStrategy":            bt.URLStrategy,
 	})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
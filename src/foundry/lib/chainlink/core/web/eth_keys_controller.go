@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -156,6 +157,55 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 	})
 }
 
+// AddRemote adds a key held by a remote signer, referenced by the name of the
+// signer in the node config and the ID of the key in the signer
+// Example:
+//
+//	"POST <application>/keys/evm/remote?backend=kms&keyID=..."
+func (ekc *ETHKeysController) AddRemote(c *gin.Context) {
+	ethKeyStore := ekc.app.GetKeyStore().Eth()
+
+	backend, keyID := c.Query("backend"), c.Query("keyID")
+	if backend == "" || keyID == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("backend and keyID are required"))
+		return
+	}
+	cid := c.Query("evmChainID")
+	chain, ok := ekc.getChain(c, cid)
+	if !ok {
+		return
+	}
+
+	key, err := ethKeyStore.AddRemote(c.Request.Context(), backend, keyID, chain.ID())
+	if err != nil {
+		switch {
+		case errors.Is(err, keystore.ErrNoRemoteSigner), errors.Is(err, remotesigner.ErrKeyNotFound):
+			jsonAPIError(c, http.StatusBadRequest, err)
+		case errors.Is(err, keystore.ErrKeyExists):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	state, err := ethKeyStore.GetState(c.Request.Context(), key.ID(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Set("key", key)
+	c.Set("state", state)
+
+	ekc.app.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+		"type":    "ethereum",
+		"id":      key.ID(),
+		"backend": backend,
+		"keyID":   keyID,
+	})
+}
+
 // Delete an ETH key bundle (irreversible!)
 // Example:
 // "DELETE <application>/keys/eth/:keyID"
@@ -3,25 +3,30 @@ package web_test
 import (
 	"errors"
 	"math/big"
+	"net"
 	"net/http"
 	"net/url"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	webpresenters "github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -250,6 +255,80 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 	assert.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129639935", balance.MaxGasPriceWei.String())
 }
 
+func TestETHKeysController_AddRemote(t *testing.T) {
+	t.Parallel()
+
+	software := remotesigner.NewSoftware()
+	keyID, err := software.CreateKey()
+	require.NoError(t, err)
+	expectedAddress, err := remotesigner.Address(testutils.Context(t), software, keyID)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	remotesigner.RegisterGRPCServer(server, software)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.RemoteSigners = toml.RemoteSigners{
+			{Name: ptr("kms"), Endpoint: ptr(lis.Addr().String()), InsecureConnection: ptr(true)},
+		}
+	})
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := clienttest.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("LINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(0), nil).Maybe()
+
+	client := app.NewHTTPClient(nil)
+
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	addRemote := func(backend, keyID string) (*http.Response, func()) {
+		u := url.URL{Path: "/v2/keys/evm/remote"}
+		query := u.Query()
+		query.Set("backend", backend)
+		query.Set("keyID", keyID)
+		query.Set("evmChainID", cltest.FixtureChainID.String())
+		u.RawQuery = query.Encode()
+		return client.Post(u.String(), nil)
+	}
+
+	resp, cleanup := addRemote("unknown", keyID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = addRemote("kms", "unknown")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = addRemote("kms", keyID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var key webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &key))
+	assert.Equal(t, expectedAddress.Hex(), key.Address)
+
+	// the key signs through the signer registered from the config
+	digest := crypto.Keccak256([]byte("hello"))
+	sig, err := app.KeyStore.Eth().SignHash(ctx, expectedAddress, digest)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress, crypto.PubkeyToAddress(*pub))
+
+	resp, cleanup = addRemote("kms", keyID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
+
 func TestETHKeysController_ChainSuccess_UpdateNonce(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobVersionsController manages the version history of jobs
+type JobVersionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the versions of a job, latest first.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jvc *JobVersionsController) Index(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := jvc.App.JobORM().FindJobVersions(c.Request.Context(), jb.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.JobVersionResource{}
+	for _, v := range versions {
+		resources = append(resources, *presenters.NewJobVersionResource(v))
+	}
+
+	jsonAPIResponse(c, resources, "jobVersions")
+}
+
+// Diff returns the unified diff between the spec of a version and the spec of
+// the version given by the from query parameter, which defaults to the
+// previous version. The first version is diffed against an empty spec.
+// Example:
+// "GET <application>/jobs/:ID/versions/:version/diff?from=:from"
+func (jvc *JobVersionsController) Diff(c *gin.Context) {
+	jobID, version, err := parseJobVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	from := version - 1
+	if s := c.Query("from"); s != "" {
+		parsed, perr := strconv.ParseInt(s, 10, 32)
+		if perr != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(perr, "invalid from version"))
+			return
+		}
+		from = int32(parsed)
+	}
+
+	to, ok := jvc.findVersion(c, jobID, version)
+	if !ok {
+		return
+	}
+	fromVersion := job.JobVersion{JobID: jobID}
+	if from != 0 || c.Query("from") != "" {
+		if fromVersion, ok = jvc.findVersion(c, jobID, from); !ok {
+			return
+		}
+	}
+
+	to, fromVersion = to.Redacted(), fromVersion.Redacted()
+	diff, err := to.Diff(fromVersion)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobVersionDiffResource(fromVersion, to, diff), "jobVersionDiffs")
+}
+
+// Rollback re-applies the spec of an earlier version of a job through the job
+// spawner, and records it as the latest version.
+// Example:
+// "POST <application>/jobs/:ID/versions/:version/rollback"
+func (jvc *JobVersionsController) Rollback(c *gin.Context) {
+	jobID, version, err := parseJobVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	target, ok := jvc.findVersion(c, jobID, version)
+	if !ok {
+		return
+	}
+
+	jc := JobsController{App: jvc.App}
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), target.Spec)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrapf(err, "version %d is no longer valid", version))
+		return
+	}
+	if !auth.AuthorizePermission(c, rbac.JobsCreate, rbac.Attrs{"type": jb.Type.String()}) {
+		return
+	}
+	jb.ID = jobID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	latest := job.JobVersion{Spec: target.Spec, Author: versionAuthor(c)}
+	err = jvc.App.UpdateJobV2(ctx, &jb, &latest)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
+			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to roll back job"))
+			return
+		}
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobVersionResource(latest), "jobVersions")
+}
+
+func (jvc *JobVersionsController) findVersion(c *gin.Context, jobID, version int32) (job.JobVersion, bool) {
+	v, err := jvc.App.JobORM().FindJobVersion(c.Request.Context(), jobID, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("version %d of job %d not found", version, jobID))
+		return v, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return v, false
+	}
+	return v, true
+}
+
+func parseJobVersion(c *gin.Context) (jobID int32, version int32, err error) {
+	jb := job.Job{}
+	if err = jb.SetID(c.Param("ID")); err != nil {
+		return 0, 0, err
+	}
+	v, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "invalid version")
+	}
+	return jb.ID, int32(v), nil
+}
+
+// versionAuthor returns the email of the user making the request, who is the
+// author of the job versions it records.
+func versionAuthor(c *gin.Context) string {
+	if user, ok := auth.GetAuthenticatedUser(c); ok {
+		return user.Email
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobVersionsController(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	v1 := testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String())
+	v2 := testspecs.GetWebhookSpecNoBody(externalJobID, submitBridge.Name.String(), fetchBridge.Name.String())
+
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: v1})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	jr := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &jr))
+	jobID := mustInt32FromString(t, jr.ID)
+
+	created, err := app.JobORM().FindJob(ctx, jobID)
+	require.NoError(t, err)
+	run := cltest.MustInsertPipelineRunWithStatus(t, app.GetDB(), created.PipelineSpecID, pipeline.RunStatusCompleted, jobID)
+
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: v2})
+	response, cleanup = client.Put("/v2/jobs/"+jr.ID, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	listVersions := func(t *testing.T) []presenters.JobVersionResource {
+		response, cleanup := client.Get("/v2/jobs/" + jr.ID + "/versions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		var versions []presenters.JobVersionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions))
+		return versions
+	}
+
+	t.Run("lists the versions, latest first", func(t *testing.T) {
+		versions := listVersions(t)
+		require.Len(t, versions, 2)
+		assert.Equal(t, int32(2), versions[0].Version)
+		assert.Equal(t, v2, versions[0].Spec)
+		assert.Equal(t, cltest.APIEmailAdmin, versions[0].Author)
+		assert.Equal(t, int32(1), versions[1].Version)
+		assert.Equal(t, v1, versions[1].Spec)
+		assert.Equal(t, created.PipelineSpecID, versions[1].PipelineSpecID)
+	})
+
+	t.Run("keeps the runs of earlier versions", func(t *testing.T) {
+		runs, count, err := app.JobORM().PipelineRuns(ctx, &jobID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, run.ID, runs[0].ID)
+		assert.Equal(t, created.PipelineSpecID, runs[0].PipelineSpecID)
+	})
+
+	t.Run("diffs versions", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/jobs/" + jr.ID + "/versions/2/diff")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		diff := presenters.JobVersionDiffResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+		assert.Equal(t, int32(1), diff.From)
+		assert.Equal(t, int32(2), diff.To)
+		assert.Contains(t, diff.Diff, "--- version 1\n+++ version 2\n")
+		assert.Contains(t, diff.Diff, `-    fetch          [type=bridge name="`+fetchBridge.Name.String()+`"]`)
+		assert.Contains(t, diff.Diff, `+    fetch          [type=bridge name="`+submitBridge.Name.String()+`"]`)
+
+		response, cleanup = client.Get("/v2/jobs/" + jr.ID + "/versions/1/diff")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		diff = presenters.JobVersionDiffResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+		assert.Equal(t, int32(0), diff.From)
+		assert.Contains(t, diff.Diff, "--- version 0\n+++ version 1\n")
+		assert.Contains(t, diff.Diff, `+    fetch          [type=bridge name="`+fetchBridge.Name.String()+`"]`)
+
+		response, cleanup = client.Get("/v2/jobs/" + jr.ID + "/versions/2/diff?from=5")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("rolls back to an earlier version", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+jr.ID+"/versions/1/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		latest := presenters.JobVersionResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &latest))
+		assert.Equal(t, int32(3), latest.Version)
+		assert.Equal(t, v1, latest.Spec)
+
+		jb, err := app.JobORM().FindJob(ctx, jobID)
+		require.NoError(t, err)
+		assert.Equal(t, externalJobID, jb.ExternalJobID)
+		assert.Equal(t, latest.PipelineSpecID, jb.PipelineSpecID)
+		assert.Contains(t, jb.PipelineSpec.DotDagSource, `fetch          [type=bridge name="`+fetchBridge.Name.String()+`"]`)
+
+		assert.Len(t, listVersions(t), 3)
+		_, count, err := app.JobORM().PipelineRuns(ctx, &jobID, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("fails for unknown versions", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+jr.ID+"/versions/42/rollback", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+		response, cleanup = client.Get("/v2/jobs/" + jr.ID + "/versions/v1/diff")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("redacts secrets", func(t *testing.T) {
+		secret := job.JobVersion{JobID: jobID, Spec: v1 + "signatureKey = 's3cr3t-signature-key'\n"}
+		require.NoError(t, app.JobORM().InsertJobVersion(ctx, &secret))
+
+		versions := listVersions(t)
+		assert.Equal(t, secret.Version, versions[0].Version)
+		assert.NotContains(t, versions[0].Spec, "s3cr3t")
+		assert.Contains(t, versions[0].Spec, "signatureKey = 'xxxxx'")
+
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%s/versions/%d/diff", jr.ID, secret.Version))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		diff := presenters.JobVersionDiffResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+		assert.NotContains(t, diff.Diff, "s3cr3t")
+	})
+
+	t.Run("requires jobs.create for the job type", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/roles", bytes.NewBufferString(`{"name": "cron-updater", "permissions": ["jobs.update", "jobs.create:type=cron"]}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusCreated)
+		updater := cltest.User{Role: sessions.UserRoleView}
+		updaterClient := app.NewHTTPClient(&updater)
+		response, cleanup = client.Patch("/v2/users/custom_role", bytes.NewBufferString(`{"email": "`+updater.Email+`", "role": "cron-updater"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		body, _ := json.Marshal(web.UpdateJobRequest{TOML: v2})
+		response, cleanup = updaterClient.Put("/v2/jobs/"+jr.ID, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusForbidden, response.StatusCode)
+		assert.Equal(t, "jobs.create:type=webhook", response.Header.Get("forbidden-required-permission"))
+
+		response, cleanup = updaterClient.Post("/v2/jobs/"+jr.ID+"/versions/2/rollback", nil)
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusForbidden, response.StatusCode)
+		assert.Equal(t, "jobs.create:type=webhook", response.Header.Get("forbidden-required-permission"))
+	})
+
+	t.Run("deletes the versions and runs with the job", func(t *testing.T) {
+		response, cleanup := client.Delete("/v2/jobs/" + jr.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNoContent)
+
+		// check the constraints deferred by the updates now, as the test
+		// transaction is never committed
+		_, err := app.GetDB().ExecContext(ctx, `SET CONSTRAINTS ALL IMMEDIATE`)
+		require.NoError(t, err)
+		var count int
+		require.NoError(t, app.GetDB().GetContext(ctx, &count, `SELECT count(*) FROM job_versions WHERE job_id = $1`, jobID))
+		assert.Equal(t, 0, count)
+		require.NoError(t, app.GetDB().GetContext(ctx, &count, `SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1`, jobID))
+		assert.Equal(t, 0, count)
+	})
+}
@@ -12,26 +12,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
-	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
-	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
-	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
-	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
-	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
-	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
-	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
-	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
-	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
-	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
-	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
-	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
-	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -89,7 +78,10 @@ func (jc *JobsController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	resource := presenters.NewJobResource(jobSpec)
+	retention := jc.App.GetConfig().JobPipeline().RunRetention(string(jobSpec.Type)).Merge(jobSpec.RunRetention())
+	resource.RunRetention = presenters.NewJobRunRetention(retention)
+	jsonAPIResponse(c, resource, "jobs")
 }
 
 // CreateJobRequest represents a request to create and start a job (V2).
@@ -112,6 +104,9 @@ func (jc *JobsController) Create(c *gin.Context) {
 		jsonAPIError(c, status, err)
 		return
 	}
+	if !auth.AuthorizePermission(c, rbac.JobsCreate, rbac.Attrs{"type": jb.Type.String()}) {
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -125,6 +120,10 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
+	if err = jc.App.JobORM().InsertJobVersion(ctx, &job.JobVersion{JobID: jb.ID, Spec: request.TOML, Author: versionAuthor(c)}); err != nil {
+		jc.App.GetLogger().Errorw("Could not record the first version of the job", "jobID", jb.ID, "err", err)
+	}
+
 	jbj, err := json.Marshal(jb)
 	if err == nil {
 		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
@@ -135,6 +134,79 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// DryRunJobRequest represents a request to execute a job spec without side effects.
+type DryRunJobRequest struct {
+	TOML string `json:"toml"`
+	// Vars are the variables the pipeline is run with, e.g. jobRun.requestBody.
+	// jobSpec is populated from the spec if not given.
+	Vars map[string]interface{} `json:"vars"`
+	// MockOutputs replace the results of the tasks with the given dot IDs.
+	MockOutputs map[string]pipeline.MockOutput `json:"mockOutputs"`
+}
+
+// DryRun validates a job spec and executes its pipeline in memory. Tasks can
+// be mocked, ethtx tasks only record the transactions they would have sent
+// and nothing is persisted.
+// Example:
+// "POST <application>/jobs/dry_run"
+func (jc *JobsController) DryRun(c *gin.Context) {
+	request := DryRunJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if !auth.AuthorizePermission(c, rbac.JobsCreate, rbac.Attrs{"type": jb.Type.String()}) {
+		return
+	}
+	if jb.PipelineSpec == nil || strings.TrimSpace(jb.PipelineSpec.DotDagSource) == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s jobs without an observation source cannot be dry run", jb.Type))
+		return
+	}
+
+	mockOutputs := make(map[string]pipeline.Result, len(request.MockOutputs))
+	for dotID, mockOutput := range request.MockOutputs {
+		result, err2 := mockOutput.ToResult()
+		if err2 != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err2, "mock output for task %s", dotID))
+			return
+		}
+		mockOutputs[dotID] = result
+	}
+
+	vars := request.Vars
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	if _, exists := vars["jobSpec"]; !exists {
+		vars["jobSpec"] = map[string]interface{}{
+			"databaseID":    jb.ID,
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		}
+	}
+
+	spec := *jb.PipelineSpec
+	spec.JobName = jb.Name.ValueOrZero()
+	spec.JobType = string(jb.Type)
+
+	result, err := jc.App.DryRunJobV2(c.Request.Context(), spec, pipeline.NewVarsFrom(vars), mockOutputs)
+	if errors.Is(err, pipeline.ErrDryRunUnknownTask) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobDryRunResource(jb.ExternalJobID.String(), *result, mockOutputs, jc.App.GetLogger()), "jobDryRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -161,12 +233,114 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the services of a job until it is resumed.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume starts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	ctx := c.Request.Context()
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	event := audit.JobResumed
+	if paused {
+		event = audit.JobPaused
+		err = jc.App.PauseJob(ctx, j.ID)
+	} else {
+		err = jc.App.ResumeJob(ctx, j.ID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	j, err = jc.App.JobORM().FindJob(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
+	jsonAPIResponse(c, presenters.NewJobResource(j), "jobs")
+}
+
+// Export returns the archive of the jobs of the node, with the bridges,
+// external initiators and forwarders they depend on.
+// Example:
+// "GET <application>/jobs/export"
+func (jc *JobsController) Export(c *gin.Context) {
+	archive, err := jc.App.ExportJobs(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	b, err := json.Marshal(archive)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobsExported, map[string]interface{}{
+		"jobs":    len(archive.Jobs),
+		"skipped": len(archive.Skipped),
+	})
+	c.Data(http.StatusOK, MediaType, b)
+}
+
+// Import creates the jobs of an archive, with the bridges and forwarders they
+// depend on, in one transaction. Existing resources and jobs with missing
+// dependencies are skipped.
+// Example:
+// "POST <application>/jobs/import"
+func (jc *JobsController) Import(c *gin.Context) {
+	var archive jobarchive.Archive
+	if err := c.ShouldBindJSON(&archive); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	report, err := jc.App.ImportJobs(c.Request.Context(), archive)
+	if errors.Is(err, jobarchive.ErrUnsupportedVersion) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobsImported, map[string]interface{}{
+		"imported": report.Imported,
+		"skipped":  report.Skipped,
+	})
+	jsonAPIResponse(c, presenters.NewJobImportResource(*report), "jobImports")
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
 }
 
 // Update validates a new TOML for an existing job, stops and deletes existing job, saves and starts a new job.
+// The TOML is recorded as the next version of the job.
 // Example:
 // "PUT <application>/jobs/:ID"
 func (jc *JobsController) Update(c *gin.Context) {
@@ -181,6 +355,9 @@ func (jc *JobsController) Update(c *gin.Context) {
 		jsonAPIError(c, status, err)
 		return
 	}
+	if !auth.AuthorizePermission(c, rbac.JobsCreate, rbac.Attrs{"type": jb.Type.String()}) {
+		return
+	}
 
 	err = jb.SetID(c.Param("ID"))
 	if err != nil {
@@ -191,20 +368,15 @@ func (jc *JobsController) Update(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
-	err = jc.App.DeleteJob(ctx, jb.ID)
+	// The job is replaced in a single transaction, if the provided job id is not matching any job it fails with 404 leaving state unchanged.
+	version := job.JobVersion{Spec: request.TOML, Author: versionAuthor(c)}
+	err = jc.App.UpdateJobV2(ctx, &jb, &version)
 	// Error can be either come from ORM or from the activeJobs map.
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
 			return
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
-	}
-
-	err = jc.App.AddJobV2(ctx, &jb)
-	if err != nil {
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
@@ -221,51 +393,15 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
 	}
-	config := jc.App.GetConfig()
-	switch jobType {
-	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(config, jc.App.GetRelayers().LegacyEVMChains(), tomlString)
-		if !config.OCR().Enabled() {
-			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
-		}
-	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(ctx, config.OCR2(), config.Insecure(), tomlString, jc.App.GetLoopRegistrarConfig())
-		if !config.OCR2().Enabled() {
-			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
-		}
-	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
-	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString)
-	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(tomlString)
-	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(tomlString)
-	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
-	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, jc.App.GetExternalInitiatorManager())
-	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(tomlString)
-	case job.BlockHeaderFeeder:
-		jb, err = blockheaderfeeder.ValidatedSpec(tomlString)
-	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
-	case job.Gateway:
-		jb, err = gateway.ValidatedGatewaySpec(tomlString)
-	case job.Stream:
-		jb, err = streams.ValidatedStreamSpec(tomlString)
-	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, tomlString)
-	case job.StandardCapabilities:
-		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(tomlString)
-	case job.CCIP:
-		jb, err = ccip.ValidatedCCIPSpec(tomlString)
-	default:
+	jb, err = chainlink.ValidatedJobSpec(ctx, jc.App, jobType, tomlString)
+	switch {
+	case errors.Is(err, feeds.ErrOCRDisabled):
+		return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
+	case errors.Is(err, feeds.ErrOCR2Disabled):
+		return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+	case errors.Is(err, chainlink.ErrUnknownJobType):
 		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
-	}
-
-	if err != nil {
+	case err != nil:
 		return jb, http.StatusBadRequest, err
 	}
 	return jb, 0, nil
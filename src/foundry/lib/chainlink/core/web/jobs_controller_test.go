@@ -38,6 +38,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -523,6 +525,77 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_DryRun(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+	dryRun := func(request web.DryRunJobRequest) (*http.Response, func()) {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		return client.Post("/v2/jobs/dry_run", bytes.NewReader(body))
+	}
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String())
+
+	t.Run("runs the pipeline with mocked tasks", func(t *testing.T) {
+		response, cleanup := dryRun(web.DryRunJobRequest{
+			TOML: tomlStr,
+			MockOutputs: map[string]pipeline.MockOutput{
+				"fetch":  {Value: json.RawMessage(`{"data": {"result": "1.5"}}`)},
+				"submit": {Value: json.RawMessage(`"ok"`)},
+			},
+		})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		resource := presenters.JobDryRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+
+		require.Len(t, resource.FatalErrors, 1)
+		assert.Nil(t, resource.FatalErrors[0])
+		require.Len(t, resource.TaskRuns, 4)
+		taskRuns := make(map[string]presenters.PipelineTaskRunDryRunResource)
+		for _, tr := range resource.TaskRuns {
+			taskRuns[tr.DotID] = tr
+		}
+		assert.True(t, taskRuns["fetch"].Mocked)
+		assert.True(t, taskRuns["submit"].Mocked)
+		assert.False(t, taskRuns["multiply"].Mocked)
+		require.NotNil(t, taskRuns["multiply"].Output)
+		assert.Contains(t, *taskRuns["multiply"].Output, "150")
+
+		// nothing is persisted
+		cltest.AssertCount(t, app.GetDB(), "jobs", 0)
+		cltest.AssertCount(t, app.GetDB(), "pipeline_runs", 0)
+	})
+
+	t.Run("rejects mocks of unknown tasks", func(t *testing.T) {
+		response, cleanup := dryRun(web.DryRunJobRequest{
+			TOML:        tomlStr,
+			MockOutputs: map[string]pipeline.MockOutput{"nope": {Value: json.RawMessage(`1`)}},
+		})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects invalid specs", func(t *testing.T) {
+		response, cleanup := dryRun(web.DryRunJobRequest{TOML: "not = [valid"})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("requires jobs.create", func(t *testing.T) {
+		body, err := json.Marshal(web.DryRunJobRequest{TOML: tomlStr})
+		require.NoError(t, err)
+		viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+		response, cleanup := viewer.Post("/v2/jobs/dry_run", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -861,3 +934,69 @@ func setupJobSpecsControllerTestsWithJobs(t *testing.T) (*cltest.TestApplication
 
 	return app, client, jb, jb.ID, erejb, erejb.ID
 }
+
+func TestJobsController_PauseResume(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String()),
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	jr := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &jr))
+	jobID := mustInt32FromString(t, jr.ID)
+	assert.Nil(t, jr.PausedAt)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	response, cleanup = client.Post("/v2/jobs/"+jr.ID+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	paused := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &paused))
+	assert.NotNil(t, paused.PausedAt)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	// Webhook runs of paused jobs are rejected
+	response, cleanup = client.Post("/v2/jobs/"+externalJobID.String()+"/runs", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Post("/v2/jobs/"+jr.ID+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resumed := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resumed))
+	assert.Nil(t, resumed.PausedAt)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	response, cleanup = client.Post("/v2/jobs/4242/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Export_RequiresPermission(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	response, cleanup := viewer.Get("/v2/jobs/export")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusForbidden, response.StatusCode)
+	assert.Equal(t, "jobs.export", response.Header.Get("forbidden-required-permission"))
+
+	editor := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleEdit})
+	response, cleanup = editor.Get("/v2/jobs/export")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+}
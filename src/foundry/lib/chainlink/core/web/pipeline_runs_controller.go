@@ -1,14 +1,18 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -36,12 +40,19 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 		size = 1000
 	}
 
+	filter, filtered, err := parseRunFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
 	var pipelineRuns []pipeline.Run
 	var count int
-	var err error
 
 	ctx := c.Request.Context()
-	if id == "" {
+	if filtered {
+		pipelineRuns, count, err = prc.App.PipelineORM().FindRuns(ctx, filter, offset, size)
+	} else if id == "" {
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(ctx, nil, offset, size)
 	} else {
 		jobSpec := job.Job{}
@@ -63,6 +74,108 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+// Errors returns the number of failed runs per error, for the runs selected
+// by the same query parameters as Index.
+// Example:
+// "GET <application>/pipeline/runs/errors"
+// "GET <application>/jobs/:ID/runs/errors"
+func (prc *PipelineRunsController) Errors(c *gin.Context) {
+	filter, _, err := parseRunFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	counts, err := prc.App.PipelineORM().CountRunErrors(c.Request.Context(), filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunErrorCountResources(counts), "pipelineRunErrorCounts")
+}
+
+// parseRunFilter parses the run filter query parameters, reporting whether any
+// was given. The job is taken from the ID path parameter or the jobID query
+// parameter, and since and until accept RFC3339 times or a duration before now.
+func parseRunFilter(c *gin.Context) (filter pipeline.RunFilter, filtered bool, err error) {
+	jobID := c.Param("ID")
+	if jobID == "" {
+		jobID = c.Query("jobID")
+	}
+	if jobID != "" {
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(jobID); err != nil {
+			return filter, false, err
+		}
+		filter.JobID = &jobSpec.ID
+	}
+
+	if v := c.Query("state"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			state := pipeline.RunStatus(strings.ToLower(strings.TrimSpace(s)))
+			switch state {
+			case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+				filter.States = append(filter.States, state)
+			default:
+				return filter, false, errors.Errorf("invalid state %q", s)
+			}
+		}
+		filtered = true
+	}
+	if v := c.Query("failed"); v != "" {
+		failed, perr := strconv.ParseBool(v)
+		if perr != nil {
+			return filter, false, errors.Wrap(perr, "invalid failed")
+		}
+		if failed {
+			filter.States = append(filter.States, pipeline.RunStatusErrored)
+			filtered = true
+		}
+	}
+	if filter.Since, err = parseRunFilterTime(c.Query("since")); err != nil {
+		return filter, false, errors.Wrap(err, "invalid since")
+	}
+	if filter.Until, err = parseRunFilterTime(c.Query("until")); err != nil {
+		return filter, false, errors.Wrap(err, "invalid until")
+	}
+	filter.Error = c.Query("error")
+	filter.FailedTaskType = pipeline.TaskType(c.Query("failedTaskType"))
+	filter.Bridge = c.Query("bridge")
+	if filter.MinOutput, err = parseRunFilterDecimal(c.Query("minOutput")); err != nil {
+		return filter, false, errors.Wrap(err, "invalid minOutput")
+	}
+	if filter.MaxOutput, err = parseRunFilterDecimal(c.Query("maxOutput")); err != nil {
+		return filter, false, errors.Wrap(err, "invalid maxOutput")
+	}
+
+	filtered = filtered || !filter.Since.IsZero() || !filter.Until.IsZero() || filter.Error != "" ||
+		filter.FailedTaskType != "" || filter.Bridge != "" || filter.MinOutput != nil || filter.MaxOutput != nil ||
+		(c.Param("ID") == "" && filter.JobID != nil)
+	return filter, filtered, nil
+}
+
+func parseRunFilterTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
+
+func parseRunFilterDecimal(v string) (*decimal.Decimal, error) {
+	if v == "" {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -85,6 +198,53 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Replay re-executes a finished pipeline run, substituting the recorded
+// results of tasks with external dependencies, and reports where the
+// recomputed results differ from the recorded ones. Nothing is persisted.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/replay"
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Runs are only replayed under the job they belong to
+	if id := c.Param("ID"); id != "" {
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && pipelineRun.PipelineSpec.JobID != jobSpec.ID) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	result, err := prc.App.ReplayPipelineRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if errors.Is(err, pipeline.ErrReplayMissingTaskRun) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunReplayResource(*result), "pipelineRunReplay")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -109,13 +269,29 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 
 	user, isUser := auth.GetAuthenticatedUser(c)
 	ei, _ := auth.GetAuthenticatedExternalInitiator(c)
-	authorizer := webhook.NewAuthorizer(prc.App.GetDB(), user, ei)
+	signature, isSigned := auth.GetWebhookSignature(c)
+	var authorizer webhook.Authorizer
+	if isSigned {
+		authorizer = webhook.NewSignatureAuthorizer(prc.App.GetDB(), *signature, bodyBytes)
+	} else {
+		authorizer = webhook.NewAuthorizer(prc.App.GetDB(), user, ei)
+	}
 
 	// Is it a UUID? Then process it as a webhook job
 	jobUUID, err := uuid.Parse(idStr)
 	if err == nil {
 		canRun, err2 := authorizer.CanRun(ctx, prc.App.GetConfig().JobPipeline(), jobUUID)
-		if err2 != nil {
+		if isSigned && (err2 != nil || !canRun) {
+			reason := "job does not accept signed requests"
+			if err2 != nil {
+				reason = err2.Error()
+			}
+			prc.App.GetAuditLogger().Audit(audit.SignedRunRejected, map[string]interface{}{"jobID": jobUUID, "ip": c.ClientIP(), "reason": reason})
+		}
+		if errors.Is(err2, webhook.ErrSignatureInvalid) || errors.Is(err2, webhook.ErrSignatureExpired) || errors.Is(err2, webhook.ErrSignatureReplayed) {
+			jsonAPIError(c, http.StatusUnauthorized, err2)
+			return
+		} else if err2 != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err2)
 			return
 		}
@@ -124,18 +300,34 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err3)
+				return
+			} else if errors.Is(err3, pipeline.ErrRunRejected) {
+				jsonAPIError(c, http.StatusTooManyRequests, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
 			}
+			if isSigned {
+				prc.App.GetAuditLogger().Audit(audit.SignedRunTriggered, map[string]interface{}{"jobID": jobUUID, "runID": jobRunID, "ip": c.ClientIP()})
+			}
+			if ei != nil {
+				if err4 := prc.App.GetExternalInitiatorManager().NotifyRunFinished(ctx, *ei, jobUUID, jobRunID); err4 != nil {
+					prc.App.GetLogger().Warnw("Failed to notify external initiator of the run status", "externalInitiator", ei.Name, "runID", jobRunID, "err", err4)
+				}
+			}
 			respondWithPipelineRun(jobRunID)
+		} else if isSigned {
+			jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("job %s does not accept signed requests", jobUUID))
 		} else {
 			jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("external initiator %s is not allowed to run job %s", ei.Name, jobUUID))
 		}
 		return
 	}
 
-	// only users are allowed to run jobs using int IDs - EIs not allowed
+	// only users are allowed to run jobs using int IDs - EIs and signed requests not allowed
 	if isUser {
 		// Is it an int32? Then process it regardless of type
 		var jobID int32
@@ -143,7 +335,13 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		if err == nil {
 			jobID = int32(jobID64)
 			jobRunID, err := prc.App.RunJobV2(ctx, jobID, nil)
-			if err != nil {
+			if errors.Is(err, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err)
+				return
+			} else if errors.Is(err, pipeline.ErrRunRejected) {
+				jsonAPIError(c, http.StatusTooManyRequests, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
@@ -1,6 +1,9 @@
 package web_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -148,6 +152,78 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_CreateSigned(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.HTTPRequest.DefaultTimeout = commonconfig.MustNewDuration(2 * time.Second)
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(10 * time.Millisecond)
+	})
+
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	secret := "0123456789abcdef0123456789abcdef"
+	jobID := uuid.New()
+	{
+		tomlStr := fmt.Sprintf(`
+type               = "webhook"
+schemaVersion      = 1
+externalJobID      = "%s"
+signatureAlgorithm = "hmac-sha256"
+signatureKey       = "%s"
+observationSource  = """
+    parse_request [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+"""
+`, jobID, secret)
+		jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		require.NoError(t, app.AddJobV2(ctx, &jb))
+	}
+
+	send := func(method, path, nonce string, body string, sign func(payload []byte) []byte) *http.Response {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req, err := http.NewRequestWithContext(ctx, method, app.Server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("X-Chainlink-Webhook-Signature", hex.EncodeToString(sign(webhook.SignedPayload(timestamp, nonce, []byte(body)))))
+		req.Header.Set("X-Chainlink-Webhook-Timestamp", timestamp)
+		req.Header.Set("X-Chainlink-Webhook-Nonce", nonce)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+	signHMAC := func(key string) func([]byte) []byte {
+		return func(payload []byte) []byte {
+			mac := hmac.New(sha256.New, []byte(key))
+			mac.Write(payload)
+			return mac.Sum(nil)
+		}
+	}
+	body := `{"data":{"result":"123.45"}}`
+
+	resp := send(http.MethodPost, "/v2/jobs/"+jobID.String()+"/runs", "first", body, signHMAC(secret))
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// replayed
+	resp = send(http.MethodPost, "/v2/jobs/"+jobID.String()+"/runs", "first", body, signHMAC(secret))
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+	// signed with another key
+	resp = send(http.MethodPost, "/v2/jobs/"+jobID.String()+"/runs", "second", body, signHMAC(strings.Repeat("x", 32)))
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+	// signed requests cannot run jobs by ID
+	resp = send(http.MethodPost, "/v2/jobs/1/runs", "third", body, signHMAC(secret))
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	// signed requests are only accepted to trigger runs
+	resp = send(http.MethodGet, "/v2/ping", "fourth", "", signHMAC(secret))
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -221,6 +297,58 @@ func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	require.Len(t, parsedResponse[0].TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Index_Filter(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/pipeline/runs?jobID=" + strconv.Itoa(int(jobID)) + "&error=UH&since=1h&state=completed")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse []presenters.PipelineRunResource
+	responseBytes := cltest.ParseResponseBody(t, response)
+	assert.Contains(t, string(responseBytes), `"meta":{"count":2}`)
+	require.NoError(t, web.ParseJSONAPIResponse(responseBytes, &parsedResponse))
+	require.Len(t, parsedResponse, 2)
+	assert.Equal(t, strconv.Itoa(int(runIDs[1])), parsedResponse[0].ID)
+	assert.Equal(t, pipeline.RunStatusCompleted, parsedResponse[0].State)
+	require.Len(t, parsedResponse[0].TaskRuns, 8)
+
+	response, cleanup = client.Get("/v2/jobs/" + strconv.Itoa(int(jobID)) + "/runs?failed=true")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	responseBytes = cltest.ParseResponseBody(t, response)
+	assert.Contains(t, string(responseBytes), `"meta":{"count":0}`)
+
+	response, cleanup = client.Get("/v2/pipeline/runs?bridge=missing")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	responseBytes = cltest.ParseResponseBody(t, response)
+	assert.Contains(t, string(responseBytes), `"meta":{"count":0}`)
+
+	for _, query := range []string{"state=done", "since=yesterday", "minOutput=abc", "failed=maybe"} {
+		response, cleanup = client.Get("/v2/pipeline/runs?" + query)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestPipelineRunsController_Errors(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jobID)) + "/runs/errors")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse []presenters.PipelineRunErrorCountResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	// the runs completed, their errors were not fatal
+	assert.Empty(t, parsedResponse)
+
+	response, cleanup = client.Get("/v2/pipeline/runs/errors?state=done")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -240,6 +368,29 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Replay(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	runID := strconv.FormatInt(runIDs[0], 10)
+
+	response, cleanup := client.Post("/v2/jobs/"+strconv.Itoa(int(jobID))+"/runs/"+runID+"/replay", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var replay presenters.PipelineRunReplayResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &replay))
+	assert.Equal(t, runID, replay.ID)
+	assert.Equal(t, jobID, replay.JobID)
+	assert.True(t, replay.Matches)
+
+	// the run does not belong to another job
+	response, cleanup = client.Post("/v2/jobs/"+strconv.Itoa(int(jobID+1))+"/runs/"+runID+"/replay", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post("/v2/jobs/"+strconv.Itoa(int(jobID))+"/runs/4242/replay", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	app := cltest.NewApplicationEVMDisabled(t)
@@ -0,0 +1,54 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+)
+
+// APITokenResource represents a named API token JSONAPI resource. The secret is
+// only set when the token is created.
+type APITokenResource struct {
+	JAID
+	Name        string     `json:"name"`
+	UserEmail   string     `json:"userEmail"`
+	AccessKey   string     `json:"accessKey"`
+	Secret      string     `json:"secret,omitempty"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	Expired     bool       `json:"expired"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	LastUsedIP  string     `json:"lastUsedIP,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource. Nil permissions
+// mean the token has every permission of its user.
+func NewAPITokenResource(t apitokens.Token) *APITokenResource {
+	return &APITokenResource{
+		JAID:        NewJAID(t.UserEmail + "/" + t.Name),
+		Name:        t.Name,
+		UserEmail:   t.UserEmail,
+		AccessKey:   t.TokenKey,
+		Permissions: t.Permissions,
+		ExpiresAt:   t.ExpiresAt.Ptr(),
+		Expired:     t.Expired(time.Now()),
+		LastUsedAt:  t.LastUsedAt.Ptr(),
+		LastUsedIP:  t.LastUsedIP.String,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources.
+func NewAPITokenResources(tokens []apitokens.Token) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -18,6 +19,15 @@ type BridgeResource struct {
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+	// The circuit breaker settings are omitted when the circuit breaker is disabled
+	CircuitBreakerFailureThreshold uint32          `json:"circuitBreakerFailureThreshold,omitempty"`
+	CircuitBreakerCoolDown         models.Interval `json:"circuitBreakerCoolDown,omitempty"`
+	// The URL strategy settings are omitted when the bridge has no fallback URLs
+	FallbackURLs []string            `json:"fallbackURLs,omitempty"`
+	URLStrategy  bridges.URLStrategy `json:"urlStrategy,omitempty"`
+	HedgeDelay   models.Interval     `json:"hedgeDelay,omitempty"`
+	// The CircuitBreaker state is only provided when showing a single Bridge
+	CircuitBreaker *BridgeCircuitBreakerResource `json:"circuitBreaker,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,7 +37,7 @@ func (r BridgeResource) GetName() string {
 
 // NewBridgeResource constructs a new BridgeResource
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
-	return &BridgeResource{
+	r := &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
 		Name:                   b.Name.String(),
@@ -36,5 +46,34 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
+
+		CircuitBreakerFailureThreshold: b.CircuitBreakerFailureThreshold,
+		CircuitBreakerCoolDown:         b.CircuitBreakerCoolDown,
+	}
+	if len(b.FallbackURLs) > 0 {
+		for _, u := range b.FallbackURLs {
+			r.FallbackURLs = append(r.FallbackURLs, u.String())
+		}
+		r.URLStrategy = b.URLStrategy
+		r.HedgeDelay = b.HedgeDelay
+	}
+	return r
+}
+
+// BridgeCircuitBreakerResource represents the state of a bridge's circuit breaker.
+type BridgeCircuitBreakerResource struct {
+	State               bridges.CircuitState `json:"state"`
+	ConsecutiveFailures uint32               `json:"consecutiveFailures"`
+	OpenedAt            *time.Time           `json:"openedAt"`
+	LastError           string               `json:"lastError,omitempty"`
+}
+
+// NewBridgeCircuitBreakerResource constructs a new BridgeCircuitBreakerResource
+func NewBridgeCircuitBreakerResource(s bridges.CircuitBreakerState) *BridgeCircuitBreakerResource {
+	return &BridgeCircuitBreakerResource{
+		State:               s.State,
+		ConsecutiveFailures: s.ConsecutiveFailures,
+		OpenedAt:            s.OpenedAt,
+		LastError:           s.LastError,
 	}
 }
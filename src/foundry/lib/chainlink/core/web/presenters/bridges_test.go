@@ -79,3 +79,103 @@ func TestBridgeResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 }
+
+func TestBridgeResource_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	url, err := url.Parse("https://bridge.example.com/api")
+	require.NoError(t, err)
+
+	bridge := bridges.BridgeType{
+		Name:                           "test",
+		URL:                            models.WebURL(*url),
+		OutgoingToken:                  "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+		CreatedAt:                      timestamp,
+		CircuitBreakerFailureThreshold: 3,
+		CircuitBreakerCoolDown:         *models.NewInterval(time.Minute),
+	}
+
+	r := NewBridgeResource(bridge)
+	r.CircuitBreaker = NewBridgeCircuitBreakerResource(bridges.CircuitBreakerState{
+		State:               bridges.CircuitOpen,
+		ConsecutiveFailures: 3,
+		OpenedAt:            &timestamp,
+		LastError:           "connection refused",
+	})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":0,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":null,
+			"createdAt":"2000-01-01T00:00:00Z",
+			"circuitBreakerFailureThreshold":3,
+			"circuitBreakerCoolDown":"1m0s",
+			"circuitBreaker":{
+				"state":"open",
+				"consecutiveFailures":3,
+				"openedAt":"2000-01-01T00:00:00Z",
+				"lastError":"connection refused"
+			}
+		}
+	}
+}
+`
+
+	assert.JSONEq(t, expected, string(b))
+}
+
+func TestBridgeResource_FallbackURLs(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	primary, err := url.Parse("https://bridge.example.com/api")
+	require.NoError(t, err)
+	fallback, err := url.Parse("https://bridge-backup.example.com/api")
+	require.NoError(t, err)
+
+	bridge := bridges.BridgeType{
+		Name:          "test",
+		URL:           models.WebURL(*primary),
+		OutgoingToken: "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+		CreatedAt:     timestamp,
+		FallbackURLs:  bridges.WebURLs{models.WebURL(*fallback)},
+		URLStrategy:   bridges.URLStrategyHedged,
+		HedgeDelay:    *models.NewInterval(250 * time.Millisecond),
+	}
+
+	b, err := jsonapi.Marshal(NewBridgeResource(bridge))
+	require.NoError(t, err)
+
+	expected := `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":0,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":null,
+			"createdAt":"2000-01-01T00:00:00Z",
+			"fallbackURLs":["https://bridge-backup.example.com/api"],
+			"urlStrategy":"hedged",
+			"hedgeDelay":"250ms"
+		}
+	}
+}
+`
+
+	assert.JSONEq(t, expected, string(b))
+}
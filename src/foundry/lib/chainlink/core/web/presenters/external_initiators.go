@@ -4,6 +4,8 @@ import (
 	"strconv"
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -56,12 +58,23 @@ func (ei *ExternalInitiatorAuthentication) SetID(name string) error {
 
 type ExternalInitiatorResource struct {
 	JAID
-	Name          string         `json:"name"`
-	URL           *models.WebURL `json:"url"`
-	AccessKey     string         `json:"accessKey"`
-	OutgoingToken string         `json:"outgoingToken"`
-	CreatedAt     time.Time      `json:"createdAt"`
-	UpdatedAt     time.Time      `json:"updatedAt"`
+	Name          string                  `json:"name"`
+	URL           *models.WebURL          `json:"url"`
+	AccessKey     string                  `json:"accessKey"`
+	OutgoingToken string                  `json:"outgoingToken"`
+	Health        ExternalInitiatorHealth `json:"health"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	UpdatedAt     time.Time               `json:"updatedAt"`
+}
+
+// ExternalInitiatorHealth is the health of the notifications to an external
+// initiator.
+type ExternalInitiatorHealth struct {
+	Status              bridges.ExternalInitiatorHealth `json:"status"`
+	LastSuccessAt       null.Time                       `json:"lastSuccessAt"`
+	LastFailureAt       null.Time                       `json:"lastFailureAt"`
+	ConsecutiveFailures int64                           `json:"consecutiveFailures"`
+	LastError           null.String                     `json:"lastError"`
 }
 
 func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiatorResource {
@@ -71,8 +84,15 @@ func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiato
 		URL:           ei.URL,
 		AccessKey:     ei.AccessKey,
 		OutgoingToken: ei.OutgoingToken,
-		CreatedAt:     ei.CreatedAt,
-		UpdatedAt:     ei.UpdatedAt,
+		Health: ExternalInitiatorHealth{
+			Status:              ei.Health(),
+			LastSuccessAt:       ei.LastSuccessAt,
+			LastFailureAt:       ei.LastFailureAt,
+			ConsecutiveFailures: ei.ConsecutiveFailures,
+			LastError:           ei.LastError,
+		},
+		CreatedAt: ei.CreatedAt,
+		UpdatedAt: ei.UpdatedAt,
 	}
 }
 
@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,8 +14,10 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/jobarchive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/signatures/secp256k1"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -242,33 +245,49 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	SignatureAlgorithm job.WebhookSignatureAlgorithm `json:"signatureAlgorithm"`
+	SignatureTolerance models.Interval               `json:"signatureTolerance"`
+	CreatedAt          time.Time                     `json:"createdAt"`
+	UpdatedAt          time.Time                     `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		SignatureAlgorithm: spec.SignatureAlgorithm,
+		SignatureTolerance: models.Interval(spec.Tolerance()),
+		CreatedAt:          spec.CreatedAt,
+		UpdatedAt:          spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule      string                    `json:"schedule"`
+	ConcurrencyPolicy job.CronConcurrencyPolicy `json:"concurrencyPolicy"`
+	CatchUp           uint32                    `json:"catchUp"`
+	Timezone          string                    `json:"timezone"`
+	Jitter            models.Interval           `json:"jitter"`
+	LastFiredAt       null.Time                 `json:"lastFiredAt"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
+	EVMChainID        *big.Big                  `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
+	// an empty policy is the default allow policy
+	policy, _ := job.ParseCronConcurrencyPolicy(string(spec.ConcurrencyPolicy))
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:      spec.CronSchedule,
+		ConcurrencyPolicy: policy,
+		CatchUp:           spec.CatchUp,
+		Timezone:          spec.Timezone,
+		Jitter:            spec.Jitter,
+		LastFiredAt:       spec.LastFiredAt,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
+		EVMChainID:        spec.EVMChainID,
 	}
 }
 
@@ -522,6 +541,7 @@ type JobResource struct {
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
 	MaxTaskDuration          models.Interval           `json:"maxTaskDuration"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
+	PausedAt                 *time.Time                `json:"pausedAt,omitempty"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
 	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
 	CronSpec                 *CronSpec                 `json:"cronSpec"`
@@ -538,9 +558,27 @@ type JobResource struct {
 	StandardCapabilitiesSpec *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
+	RunRetention             *JobRunRetention          `json:"runRetention,omitempty"`
 	Errors                   []JobError                `json:"errors"`
 }
 
+// JobRunRetention is the retention policy of the runs of a job, its unset
+// fields are pruned by the reaper threshold.
+type JobRunRetention struct {
+	MaxSuccessfulRuns *uint64          `json:"maxSuccessfulRuns"`
+	KeepFailedFor     *models.Interval `json:"keepFailedFor"`
+	KeepTags          []string         `json:"keepTags"`
+}
+
+// NewJobRunRetention initializes a new job run retention
+func NewJobRunRetention(r config.RunRetention) *JobRunRetention {
+	jr := &JobRunRetention{MaxSuccessfulRuns: r.MaxSuccessfulRuns, KeepTags: r.KeepTags}
+	if r.KeepFailedFor != nil {
+		jr.KeepFailedFor = models.NewInterval(*r.KeepFailedFor)
+	}
+	return jr
+}
+
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
@@ -554,6 +592,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		PausedAt:          j.PausedAt.Ptr(),
 	}
 
 	switch j.Type {
@@ -606,3 +645,80 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobVersionResource represents a version of the spec of a job
+type JobVersionResource struct {
+	JAID
+	JobID          int32     `json:"jobID"`
+	Version        int32     `json:"version"`
+	Spec           string    `json:"spec"`
+	Author         string    `json:"author"`
+	PipelineSpecID int32     `json:"pipelineSpecID"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// NewJobVersionResource initializes a new JSONAPI job version resource
+func NewJobVersionResource(v job.JobVersion) *JobVersionResource {
+	v = v.Redacted()
+	return &JobVersionResource{
+		JAID:           NewJAIDInt32(v.Version),
+		JobID:          v.JobID,
+		Version:        v.Version,
+		Spec:           v.Spec,
+		Author:         v.Author,
+		PipelineSpecID: v.PipelineSpecID,
+		CreatedAt:      v.CreatedAt,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobVersionResource) GetName() string {
+	return "jobVersions"
+}
+
+// JobVersionDiffResource is the unified diff between the specs of two
+// versions of a job
+type JobVersionDiffResource struct {
+	JAID
+	JobID int32  `json:"jobID"`
+	From  int32  `json:"from"`
+	To    int32  `json:"to"`
+	Diff  string `json:"diff"`
+}
+
+// NewJobVersionDiffResource initializes a new JSONAPI job version diff resource
+func NewJobVersionDiffResource(from, to job.JobVersion, diff string) *JobVersionDiffResource {
+	return &JobVersionDiffResource{
+		JAID:  NewJAID(fmt.Sprintf("%d..%d", from.Version, to.Version)),
+		JobID: to.JobID,
+		From:  from.Version,
+		To:    to.Version,
+		Diff:  diff,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobVersionDiffResource) GetName() string {
+	return "jobVersionDiffs"
+}
+
+// JobImportResource is the report of an import of a jobs archive
+type JobImportResource struct {
+	JAID
+	Imported []jobarchive.Imported `json:"imported"`
+	Skipped  []jobarchive.Skipped  `json:"skipped"`
+}
+
+// NewJobImportResource initializes a new JSONAPI job import resource
+func NewJobImportResource(report jobarchive.Report) *JobImportResource {
+	return &JobImportResource{
+		JAID:     NewJAID("import"),
+		Imported: report.Imported,
+		Skipped:  report.Skipped,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobImportResource) GetName() string {
+	return "jobImports"
+}
@@ -408,6 +408,11 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "concurrencyPolicy": "allow",
+                            "catchUp": 0,
+                            "timezone": "",
+                            "jitter": "0s",
+                            "lastFiredAt": null,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z",
                             "evmChainID":"42"
@@ -438,8 +443,10 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				WebhookSpec: &job.WebhookSpec{
-					CreatedAt: timestamp,
-					UpdatedAt: timestamp,
+					SignatureAlgorithm: job.WebhookSignatureEd25519,
+					SignatureKey:       "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+					CreatedAt:          timestamp,
+					UpdatedAt:          timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
 				PipelineSpec: &pipeline.Spec{
@@ -468,6 +475,8 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"signatureAlgorithm":"ed25519",
+							"signatureTolerance":"5m0s",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
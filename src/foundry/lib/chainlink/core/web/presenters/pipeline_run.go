@@ -24,6 +24,7 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                         `json:"createdAt"`
 	FinishedAt   null.Time                         `json:"finishedAt"`
 	PipelineSpec PipelineSpec                      `json:"pipelineSpec"`
+	State        pipeline.RunStatus                `json:"state"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -56,6 +57,7 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt,
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		State:        pr.State,
 	}
 }
 
@@ -104,3 +106,153 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunErrorCountResource is the number of failed runs with an error.
+type PipelineRunErrorCountResource struct {
+	JAID
+	Error string `json:"error"`
+	Count int64  `json:"count"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunErrorCountResource) GetName() string {
+	return "pipelineRunErrorCounts"
+}
+
+// NewPipelineRunErrorCountResources identifies the counts by their rank.
+func NewPipelineRunErrorCountResources(counts []pipeline.RunErrorCount) []PipelineRunErrorCountResource {
+	out := make([]PipelineRunErrorCountResource, 0, len(counts))
+	for i, ec := range counts {
+		out = append(out, PipelineRunErrorCountResource{
+			JAID:  NewJAIDInt64(int64(i + 1)),
+			Error: ec.Error,
+			Count: ec.Count,
+		})
+	}
+	return out
+}
+
+// PipelineRunReplayResource is the outcome of replaying a stored pipeline run.
+type PipelineRunReplayResource struct {
+	JAID
+	JobID           int32                             `json:"jobId"`
+	RecordedOutputs jsonserializable.JSONSerializable `json:"recordedOutputs"`
+	ReplayedOutputs jsonserializable.JSONSerializable `json:"replayedOutputs"`
+	Matches         bool                              `json:"matches"`
+	TaskRuns        []PipelineTaskRunReplayResource   `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// PipelineTaskRunReplayResource compares the recorded and recomputed result of a task.
+type PipelineTaskRunReplayResource struct {
+	DotID          string            `json:"dotId"`
+	Type           pipeline.TaskType `json:"type"`
+	Recorded       bool              `json:"recorded"`
+	RecordedOutput *string           `json:"recordedOutput"`
+	RecordedError  *string           `json:"recordedError"`
+	ReplayedOutput *string           `json:"replayedOutput"`
+	ReplayedError  *string           `json:"replayedError"`
+	Matches        bool              `json:"matches"`
+}
+
+func NewPipelineRunReplayResource(rr pipeline.ReplayResult) PipelineRunReplayResource {
+	trs := make([]PipelineTaskRunReplayResource, 0, len(rr.TaskRuns))
+	for _, tr := range rr.TaskRuns {
+		trs = append(trs, PipelineTaskRunReplayResource{
+			DotID:          tr.DotID,
+			Type:           tr.Type,
+			Recorded:       tr.Recorded,
+			RecordedOutput: outputString(tr.RecordedOutput),
+			RecordedError:  tr.RecordedError.Ptr(),
+			ReplayedOutput: outputString(tr.ReplayedOutput),
+			ReplayedError:  tr.ReplayedError.Ptr(),
+			Matches:        tr.Matches,
+		})
+	}
+	return PipelineRunReplayResource{
+		JAID:            NewJAIDInt64(rr.RunID),
+		JobID:           rr.JobID,
+		RecordedOutputs: rr.RecordedOutputs,
+		ReplayedOutputs: rr.ReplayedOutputs,
+		Matches:         rr.Matches,
+		TaskRuns:        trs,
+	}
+}
+
+func outputString(output jsonserializable.JSONSerializable) *string {
+	if !output.Valid {
+		return nil
+	}
+	outputBytes, _ := output.MarshalJSON()
+	outputStr := string(outputBytes)
+	return &outputStr
+}
+
+// JobDryRunResource is the outcome of executing a job spec without side effects.
+type JobDryRunResource struct {
+	JAID
+	Outputs      []*string                       `json:"outputs"`
+	AllErrors    []*string                       `json:"allErrors"`
+	FatalErrors  []*string                       `json:"fatalErrors"`
+	TaskRuns     []PipelineTaskRunDryRunResource `json:"taskRuns"`
+	Transactions []pipeline.RecordedETHTx        `json:"transactions"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobDryRunResource) GetName() string {
+	return "jobDryRun"
+}
+
+// PipelineTaskRunDryRunResource is the result of a single task during a dry run.
+type PipelineTaskRunDryRunResource struct {
+	DotID      string            `json:"dotId"`
+	Type       pipeline.TaskType `json:"type"`
+	Mocked     bool              `json:"mocked"`
+	Output     *string           `json:"output"`
+	Error      *string           `json:"error"`
+	Attempts   uint              `json:"attempts"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	FinishedAt null.Time         `json:"finishedAt"`
+	// Duration is the wall clock time the task took, e.g. "12.5ms"
+	Duration string `json:"duration"`
+}
+
+func NewJobDryRunResource(id string, result pipeline.DryRunResult, mocked map[string]pipeline.Result, lggr logger.Logger) JobDryRunResource {
+	lggr = lggr.Named("JobDryRunResource")
+	outputs, err := result.Run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", result.Run.Outputs)
+	}
+
+	trs := make([]PipelineTaskRunDryRunResource, 0, len(result.TaskRunResults))
+	for _, trr := range result.TaskRunResults {
+		_, isMocked := mocked[trr.Task.DotID()]
+		tr := PipelineTaskRunDryRunResource{
+			DotID:      trr.Task.DotID(),
+			Type:       trr.Task.Type(),
+			Mocked:     isMocked,
+			Output:     outputString(trr.Result.OutputDB()),
+			Error:      trr.Result.ErrorDB().Ptr(),
+			Attempts:   trr.Attempts,
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+		}
+		if trr.FinishedAt.Valid {
+			tr.Duration = trr.FinishedAt.Time.Sub(trr.CreatedAt).String()
+		}
+		trs = append(trs, tr)
+	}
+
+	return JobDryRunResource{
+		JAID:         NewJAID(id),
+		Outputs:      outputs,
+		AllErrors:    result.Run.StringAllErrors(),
+		FatalErrors:  result.Run.StringFatalErrors(),
+		TaskRuns:     trs,
+		Transactions: result.Transactions,
+	}
+}
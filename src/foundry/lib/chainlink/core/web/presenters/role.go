@@ -0,0 +1,78 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// RoleResource represents a Role JSONAPI resource.
+type RoleResource struct {
+	JAID
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	BuiltIn     bool      `json:"builtIn"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RoleResource) GetName() string {
+	return "roles"
+}
+
+// NewRoleResource constructs a new RoleResource.
+func NewRoleResource(r rbac.Role) *RoleResource {
+	permissions := []string(r.Permissions)
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &RoleResource{
+		JAID:        NewJAID(r.Name),
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+		BuiltIn:     r.BuiltIn,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// NewRoleResources constructs a slice of RoleResources.
+func NewRoleResources(roles []rbac.Role) []RoleResource {
+	rs := []RoleResource{}
+	for _, r := range roles {
+		rs = append(rs, *NewRoleResource(r))
+	}
+	return rs
+}
+
+// PermissionResource represents a permission of the catalog as a JSONAPI resource.
+type PermissionResource struct {
+	JAID
+	Description string   `json:"description"`
+	Attributes  []string `json:"attributes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PermissionResource) GetName() string {
+	return "permissions"
+}
+
+// NewPermissionResources constructs a slice of PermissionResources.
+func NewPermissionResources(catalog []rbac.PermissionInfo) []PermissionResource {
+	ps := []PermissionResource{}
+	for _, info := range catalog {
+		attrs := info.Attributes
+		if attrs == nil {
+			attrs = []string{}
+		}
+		ps = append(ps, PermissionResource{
+			JAID:        NewJAID(string(info.Permission)),
+			Description: info.Description,
+			Attributes:  attrs,
+		})
+	}
+	return ps
+}
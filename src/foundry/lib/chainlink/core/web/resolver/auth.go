@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
@@ -16,40 +17,20 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts the user is granted p with attrs.
+// Nil attrs accept grants restricted to attributes, the resolver must then check the action
+// again once its attributes are known.
+func authenticateUserCan(ctx context.Context, p rbac.Permission, attrs rbac.Attrs) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
-}
-
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
-	}
-	return nil
-}
-
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
+	if !session.Allows(p, attrs) {
+		role := session.User.Role
+		if session.User.CustomRole.Valid {
+			role = sessions.UserRole(session.User.CustomRole.String)
+		}
+		return RoleNotPermittedErr{role}
 	}
 	return nil
 }
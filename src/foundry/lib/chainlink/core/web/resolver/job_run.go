@@ -2,9 +2,11 @@ package resolver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -38,6 +40,67 @@ func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
 	}
 }
 
+// JobRunsFilterInput is the filter argument of the jobRuns query.
+type JobRunsFilterInput struct {
+	JobID          *graphql.ID
+	States         *[]JobRunStatus
+	Since          *graphql.Time
+	Until          *graphql.Time
+	Error          *string
+	FailedTaskType *string
+	Bridge         *string
+	MinOutput      *string
+	MaxOutput      *string
+}
+
+func (f JobRunsFilterInput) runFilter() (filter pipeline.RunFilter, err error) {
+	if f.JobID != nil {
+		id, err := stringutils.ToInt32(string(*f.JobID))
+		if err != nil {
+			return filter, err
+		}
+		filter.JobID = &id
+	}
+	if f.States != nil {
+		for _, s := range *f.States {
+			filter.States = append(filter.States, pipeline.RunStatus(strings.ToLower(string(s))))
+		}
+	}
+	if f.Since != nil {
+		filter.Since = f.Since.Time
+	}
+	if f.Until != nil {
+		filter.Until = f.Until.Time
+	}
+	if f.Error != nil {
+		filter.Error = *f.Error
+	}
+	if f.FailedTaskType != nil {
+		filter.FailedTaskType = pipeline.TaskType(*f.FailedTaskType)
+	}
+	if f.Bridge != nil {
+		filter.Bridge = *f.Bridge
+	}
+	if filter.MinOutput, err = decimalInput(f.MinOutput); err != nil {
+		return filter, errors.Wrap(err, "invalid minOutput")
+	}
+	if filter.MaxOutput, err = decimalInput(f.MaxOutput); err != nil {
+		return filter, errors.Wrap(err, "invalid maxOutput")
+	}
+	return filter, nil
+}
+
+func decimalInput(s *string) (*decimal.Decimal, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
 var outputRetrievalErrorStr = "error: unable to retrieve outputs"
 
 type JobRunResolver struct {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
@@ -84,6 +86,92 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestQuery_FilteredJobRuns(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobsRuns($filter: JobRunsFilterInput) {
+			jobRuns(filter: $filter) {
+				results {
+					id
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	minOutput := decimal.RequireFromString("1.5")
+	jobID := int32(7)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.pipelineORM.On("FindRuns", mock.Anything, pipeline.RunFilter{
+					JobID:          &jobID,
+					States:         []pipeline.RunStatus{pipeline.RunStatusErrored},
+					Since:          since,
+					Error:          "timeout",
+					FailedTaskType: pipeline.TaskTypeHTTP,
+					Bridge:         "foo",
+					MinOutput:      &minOutput,
+				}, PageDefaultOffset, PageDefaultLimit).Return([]pipeline.Run{
+					{
+						ID: int64(200),
+					},
+				}, 1, nil)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"jobID":          "7",
+					"states":         []interface{}{"ERRORED"},
+					"since":          "2024-01-02T03:04:05Z",
+					"error":          "timeout",
+					"failedTaskType": "http",
+					"bridge":         "foo",
+					"minOutput":      "1.5",
+				},
+			},
+			result: `
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "200"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid output bound",
+			authenticated: true,
+			query:         query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"maxOutput": "abc",
+				},
+			},
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: errors.New("invalid maxOutput: can't convert abc to decimal"),
+					Path:          []interface{}{"jobRuns"},
+					Message:       "invalid maxOutput: can't convert abc to decimal",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_JobRun(t *testing.T) {
 	t.Parallel()
 
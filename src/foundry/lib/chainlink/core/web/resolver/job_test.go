@@ -355,6 +355,8 @@ func TestResolver_CreateJob(t *testing.T) {
 			before: func(ctx context.Context, f *gqlTestFramework) {
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("InsertJobVersion", mock.Anything, &job.JobVersion{JobID: jb.ID, Spec: spec, Author: "gqltester@chain.link"}).Return(nil)
 			},
 			query:     mutation,
 			variables: variables,
@@ -563,3 +565,165 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						paused
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:       id,
+					PausedAt: null.TimeFrom(f.Timestamp()),
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"paused": true,
+							"pausedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(errors.Wrap(sql.ErrNoRows, "failed to pause job 123"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "generic error on PauseJob()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						paused
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"paused": false,
+							"pausedAt": null
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
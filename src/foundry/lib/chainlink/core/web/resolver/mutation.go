@@ -43,6 +43,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -63,7 +64,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.BridgesCreate, nil); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +114,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysCreate, rbac.Attrs{"type": "csa"}); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +138,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysDelete, rbac.Attrs{"type": "csa"}); err != nil {
 		return nil, err
 	}
 
@@ -180,7 +181,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -265,7 +266,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -321,7 +322,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +406,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +459,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.BridgesUpdate, nil); err != nil {
 		return nil, err
 	}
 
@@ -526,7 +527,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -574,7 +575,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +597,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.FeedsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -615,7 +616,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysCreate, rbac.Attrs{"type": "ocr"}); err != nil {
 		return nil, err
 	}
 
@@ -635,7 +636,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysDelete, rbac.Attrs{"type": "ocr"}); err != nil {
 		return nil, err
 	}
 
@@ -654,7 +655,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.BridgesDelete, nil); err != nil {
 		return nil, err
 	}
 
@@ -690,7 +691,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysCreate, rbac.Attrs{"type": "p2p"}); err != nil {
 		return nil, err
 	}
 
@@ -714,7 +715,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysDelete, rbac.Attrs{"type": "p2p"}); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +741,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysCreate, rbac.Attrs{"type": "vrf"}); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +763,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysDelete, rbac.Attrs{"type": "vrf"}); err != nil {
 		return nil, err
 	}
 
@@ -787,7 +788,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobProposalsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -826,7 +827,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobProposalsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -861,7 +862,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobProposalsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -897,7 +898,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobProposalsManage, nil); err != nil {
 		return nil, err
 	}
 
@@ -971,7 +972,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.LogUpdate, nil); err != nil {
 		return nil, err
 	}
 
@@ -1062,7 +1063,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobsCreate, nil); err != nil {
 		return nil, err
 	}
 
@@ -1072,6 +1073,9 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
 		}), nil
 	}
+	if err = authenticateUserCan(ctx, rbac.JobsCreate, rbac.Attrs{"type": jbt.String()}); err != nil {
+		return nil, err
+	}
 
 	var jb job.Job
 	config := r.App.GetConfig()
@@ -1131,6 +1135,14 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	var author string
+	if session, ok := webauth.GetGQLAuthenticatedSession(ctx); ok {
+		author = session.User.Email
+	}
+	if err = r.App.JobORM().InsertJobVersion(ctx, &job.JobVersion{JobID: jb.ID, Spec: args.Input.TOML, Author: author}); err != nil {
+		r.App.GetLogger().Errorw("Could not record the first version of the job", "jobID", jb.ID, "err", err)
+	}
+
 	jbj, _ := json.Marshal(jb)
 	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
 
@@ -1140,7 +1152,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobsDelete, nil); err != nil {
 		return nil, err
 	}
 
@@ -1171,10 +1183,70 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCan(ctx, rbac.JobsPause, nil); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.PauseJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": args.ID})
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCan(ctx, rbac.JobsPause, nil); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.ResumeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": args.ID})
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.JobsUpdate, nil); err != nil {
 		return nil, err
 	}
 
@@ -1208,7 +1280,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.RunsCreate, nil); err != nil {
 		return nil, err
 	}
 
@@ -1219,7 +1291,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 
 	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil)
 	if err != nil {
-		if errors.Is(err, webhook.ErrJobNotExists) {
+		if errors.Is(err, webhook.ErrJobNotExists) || errors.Is(err, job.ErrJobPaused) {
 			return NewRunJobPayload(nil, r.App, err), nil
 		}
 
@@ -1238,7 +1310,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.LogUpdate, nil); err != nil {
 		return nil, err
 	}
 
@@ -1264,7 +1336,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysCreate, rbac.Attrs{"type": "ocr2"}); err != nil {
 		return nil, err
 	}
 
@@ -1292,7 +1364,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, rbac.KeysDelete, rbac.Attrs{"type": "ocr2"}); err != nil {
 		return nil, err
 	}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
@@ -403,6 +404,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
+	Filter *JobRunsFilterInput
 }) (*JobRunsPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -411,9 +413,23 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	limit := pageLimit(args.Limit)
 	offset := pageOffset(args.Offset)
 
-	runs, count, err := r.App.JobORM().PipelineRuns(ctx, nil, offset, limit)
-	if err != nil {
-		return nil, err
+	var runs []pipeline.Run
+	var count int
+	if args.Filter != nil {
+		filter, err := args.Filter.runFilter()
+		if err != nil {
+			return nil, err
+		}
+		runs, count, err = r.App.PipelineORM().FindRuns(ctx, filter, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		runs, count, err = r.App.JobORM().PipelineRuns(ctx, nil, offset, limit)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return NewJobRunsPayload(runs, int32(count), r.App), nil
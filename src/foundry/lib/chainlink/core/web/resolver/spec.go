@@ -164,6 +164,35 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// ConcurrencyPolicy resolves the spec's concurrency policy.
+func (r *CronSpecResolver) ConcurrencyPolicy() string {
+	policy, _ := job.ParseCronConcurrencyPolicy(string(r.spec.ConcurrencyPolicy))
+	return string(policy)
+}
+
+// CatchUp resolves the maximum number of missed schedules run on start.
+func (r *CronSpecResolver) CatchUp() int32 {
+	return int32(r.spec.CatchUp)
+}
+
+// Timezone resolves the spec's time zone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.Duration().String()
+}
+
+// LastFiredAt resolves the scheduled time of the spec's last run.
+func (r *CronSpecResolver) LastFiredAt() *graphql.Time {
+	if !r.spec.LastFiredAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.spec.LastFiredAt.Time}
+}
+
 // EVMChainID resolves the spec's evm chain id.
 func (r *CronSpecResolver) EVMChainID() *string {
 	if r.spec.EVMChainID == nil {
@@ -737,6 +766,17 @@ type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
 
+// SignatureAlgorithm resolves the algorithm of signed requests, empty when
+// the job does not accept them.
+func (r *WebhookSpecResolver) SignatureAlgorithm() string {
+	return string(r.spec.SignatureAlgorithm)
+}
+
+// SignatureTolerance resolves the maximum age of signed requests.
+func (r *WebhookSpecResolver) SignatureTolerance() string {
+	return r.spec.Tolerance().String()
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -77,6 +77,64 @@ func TestResolver_CronSpec(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "cron spec with scheduling options success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					Type: job.Cron,
+					CronSpec: &job.CronSpec{
+						CronSchedule:      "0 0 1 1 *",
+						ConcurrencyPolicy: job.CronConcurrencyForbid,
+						CatchUp:           3,
+						Timezone:          "UTC",
+						Jitter:            models.Interval(30 * time.Second),
+						LastFiredAt:       null.TimeFrom(f.Timestamp()),
+						EVMChainID:        ubig.NewI(42),
+						CreatedAt:         f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on CronSpec {
+									schedule
+									concurrencyPolicy
+									catchUp
+									timezone
+									jitter
+									lastFiredAt
+									evmChainID
+									createdAt
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "CronSpec",
+							"schedule": "0 0 1 1 *",
+							"concurrencyPolicy": "forbid",
+							"catchUp": 3,
+							"timezone": "UTC",
+							"jitter": "30s",
+							"lastFiredAt": "2021-01-01T00:00:00Z",
+							"evmChainID": "42",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
 	}
 
 	RunGQLTests(t, testCases)
@@ -698,7 +756,9 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						SignatureAlgorithm: job.WebhookSignatureHMACSHA256,
+						SignatureTolerance: models.Interval(time.Minute),
+						CreatedAt:          f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -709,6 +769,8 @@ func TestResolver_WebhookSpec(t *testing.T) {
 							spec {
 								__typename
 								... on WebhookSpec {
+									signatureAlgorithm
+									signatureTolerance
 									createdAt
 								}
 							}
@@ -721,6 +783,8 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
+							"signatureAlgorithm": "hmac-sha256",
+							"signatureTolerance": "1m0s",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
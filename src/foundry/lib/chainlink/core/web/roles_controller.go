@@ -0,0 +1,247 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// RolesController manages the custom roles and their assignment to users.
+type RolesController struct {
+	App chainlink.Application
+}
+
+// CreateRoleRequest defines the request to create a custom role.
+type CreateRoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateRoleRequest defines the request to update a custom role, fields left
+// nil are unchanged.
+type UpdateRoleRequest struct {
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// AssignRoleRequest defines the request to assign a custom role to a user or
+// to its API token. An empty Role unassigns the custom role.
+type AssignRoleRequest struct {
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	APIToken bool   `json:"apiToken"`
+}
+
+// Index lists the built-in and custom roles.
+// Example:
+// "GET <application>/roles"
+func (rc *RolesController) Index(c *gin.Context) {
+	roles, err := rc.App.RoleORM().Roles(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResources(roles), "roles")
+}
+
+// Show returns a role by name.
+// Example:
+// "GET <application>/roles/:name"
+func (rc *RolesController) Show(c *gin.Context) {
+	role, err := rc.App.RoleORM().FindRole(c.Request.Context(), c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Permissions lists the permissions which can be granted to roles.
+// Example:
+// "GET <application>/permissions"
+func (rc *RolesController) Permissions(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewPermissionResources(rbac.Catalog()), "permissions")
+}
+
+// Create creates a custom role.
+// Example:
+// "POST <application>/roles"
+func (rc *RolesController) Create(c *gin.Context) {
+	var request CreateRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role := rbac.Role{Name: request.Name, Description: request.Description, Permissions: request.Permissions}
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+	if err := role.Validate(); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := rc.App.RoleORM().CreateRole(c.Request.Context(), &role); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]interface{}{
+		"role":        role.Name,
+		"permissions": []string(role.Permissions),
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewRoleResource(role), "role", http.StatusCreated)
+}
+
+// Update changes the description or permissions of a custom role.
+// Example:
+// "PATCH <application>/roles/:name"
+func (rc *RolesController) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request UpdateRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := rc.App.RoleORM().FindRole(ctx, c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if role.BuiltIn {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s is built in and can not be changed", role.Name))
+		return
+	}
+
+	if request.Description != nil {
+		role.Description = *request.Description
+	}
+	if request.Permissions != nil {
+		role.Permissions = request.Permissions
+	}
+	if err = role.Validate(); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = rc.App.RoleORM().UpdateRole(ctx, &role); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]interface{}{
+		"role":        role.Name,
+		"permissions": []string(role.Permissions),
+	})
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Delete deletes a custom role which isn't assigned.
+// Example:
+// "DELETE <application>/roles/:name"
+func (rc *RolesController) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	role, err := rc.App.RoleORM().FindRole(ctx, c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if role.BuiltIn {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s is built in and can not be deleted", role.Name))
+		return
+	}
+
+	err = rc.App.RoleORM().DeleteRole(ctx, role.Name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	case errors.Is(err, rbac.ErrRoleInUse):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]interface{}{"role": role.Name})
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Assign assigns a custom role to a user or to its API token, or unassigns it.
+// Example:
+// "PATCH <application>/users/custom_role"
+func (rc *RolesController) Assign(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request AssignRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Email == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("email flag is empty, must specify an email"))
+		return
+	}
+
+	// Don't allow current user to edit self, which could remove its own access
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if strings.EqualFold(sessionUser.Email, request.Email) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("can not change state or permissions of current user"))
+		return
+	}
+
+	var err error
+	event := audit.RoleAssigned
+	if request.Role == "" {
+		event = audit.RoleUnassigned
+		err = rc.App.RoleORM().UnassignRole(ctx, request.Email, request.APIToken)
+	} else {
+		err = rc.App.RoleORM().AssignRole(ctx, request.Email, request.Role, request.APIToken)
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	user, err := rc.App.BasicAdminUsersORM().FindUser(ctx, request.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(event, map[string]interface{}{
+		"email":    user.Email,
+		"role":     request.Role,
+		"apiToken": request.APIToken,
+	})
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
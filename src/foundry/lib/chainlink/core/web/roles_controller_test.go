@@ -0,0 +1,138 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestRolesController_CreateAndAssign(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	admin := app.NewHTTPClient(nil)
+	viewer := cltest.User{Role: sessions.UserRoleView}
+	viewerClient := app.NewHTTPClient(&viewer)
+
+	// a view user can't create bridges
+	resp, cleanup := viewerClient.Post("/v2/bridge_types", bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "bridges.create", resp.Header.Get("forbidden-required-permission"))
+
+	resp, cleanup = admin.Post("/v2/roles", bytes.NewBufferString(`{"name": "bridge-admin", "permissions": ["bridges.create", "bridges.update"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var role presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &role))
+	assert.Equal(t, "bridge-admin", role.Name)
+	assert.Equal(t, []string{"bridges.create", "bridges.update"}, role.Permissions)
+
+	resp, cleanup = admin.Patch("/v2/users/custom_role", bytes.NewBufferString(`{"email": "`+viewer.Email+`", "role": "bridge-admin"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// the custom role now grants the view user bridges.create
+	resp, cleanup = viewerClient.Post("/v2/bridge_types", bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+	assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// but not to delete them
+	resp, cleanup = viewerClient.Delete("/v2/bridge_types/MOCK")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, cleanup = admin.Delete("/v2/roles/bridge-admin")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, cleanup = admin.Patch("/v2/users/custom_role", bytes.NewBufferString(`{"email": "`+viewer.Email+`"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = admin.Delete("/v2/roles/bridge-admin")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+}
+
+func TestRolesController_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{"Built-in name", "POST", "/v2/roles", `{"name": "admin"}`, http.StatusBadRequest, `role "admin" is built in`},
+		{"Unknown permission", "POST", "/v2/roles", `{"name": "exporter", "permissions": ["keys.explode"]}`, http.StatusBadRequest, `invalid grant "keys.explode": unknown permission`},
+		{"Update built-in", "PATCH", "/v2/roles/edit", `{"permissions": ["*"]}`, http.StatusBadRequest, "role edit is built in and can not be changed"},
+		{"Update missing", "PATCH", "/v2/roles/missing", `{"permissions": []}`, http.StatusNotFound, "role not found"},
+		{"Delete missing", "DELETE", "/v2/roles/missing", "", http.StatusNotFound, "role not found"},
+		{"Assign missing", "PATCH", "/v2/users/custom_role", `{"email": "nobody@chain.link", "role": "missing"}`, http.StatusBadRequest, `role "missing" not found`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var resp *http.Response
+			var cleanup func()
+			switch tc.method {
+			case "POST":
+				resp, cleanup = client.Post(tc.path, bytes.NewBufferString(tc.reqBody))
+			case "PATCH":
+				resp, cleanup = client.Patch(tc.path, bytes.NewBufferString(tc.reqBody))
+			case "DELETE":
+				resp, cleanup = client.Delete(tc.path)
+			}
+			t.Cleanup(cleanup)
+			errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+			require.Len(t, errors.Errors, 1)
+			assert.Equal(t, tc.wantErrMessage, errors.Errors[0].Detail)
+		})
+	}
+}
+
+func TestRolesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+
+	resp, cleanup := client.Get("/v2/roles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var roles []presenters.RoleResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &roles))
+	require.Len(t, roles, 4)
+	assert.Equal(t, "admin", roles[0].Name)
+	assert.True(t, roles[0].BuiltIn)
+
+	resp, cleanup = client.Get("/v2/permissions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+}
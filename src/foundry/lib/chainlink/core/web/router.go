@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -94,7 +95,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	guiAssetRoutes(engine, config.Insecure().DisableRateLimiting(), app.GetLogger())
 
 	api.POST("/query",
-		auth.AuthenticateGQL(app.AuthenticationProvider(), app.GetLogger().Named("GQLHandler")),
+		auth.AuthenticateGQL(app.AuthenticationProvider(), app.RoleORM(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
 		graphqlHandler(app),
 	)
@@ -211,6 +212,8 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	unauth.GET("/sessions/oidc/login", sc.OIDCLogin)
+	unauth.GET("/sessions/oidc/callback", sc.OIDCCallback)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -238,42 +241,60 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+		auth.AuthenticateByNamedToken(app.APITokenORM(), app.GetLogger(), app.GetAuditLogger()),
 		auth.AuthenticateByToken,
+		auth.AuthenticateByBearerToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.ResolvePermissions(app.RoleORM()))
 	{
 		uc := UserController{app}
-		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
-		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
-		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
-		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.GET("/users", auth.RequiresPermission(rbac.UsersManage, nil, uc.Index))
+		authv2.POST("/users", auth.RequiresPermission(rbac.UsersManage, nil, uc.Create))
+		authv2.PATCH("/users", auth.RequiresPermission(rbac.UsersManage, nil, uc.UpdateRole))
+		authv2.DELETE("/users/:email", auth.RequiresPermission(rbac.UsersManage, nil, uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		atc := APITokensController{app}
+		authv2.GET("/user/tokens", atc.Index)
+		authv2.POST("/user/tokens", atc.Create)
+		authv2.DELETE("/user/tokens/:name", atc.Revoke)
+		authv2.GET("/api_tokens", auth.RequiresPermission(rbac.UsersManage, nil, atc.IndexAll))
+		authv2.DELETE("/api_tokens/:email/:name", auth.RequiresPermission(rbac.UsersManage, nil, atc.RevokeForUser))
+
+		rc := RolesController{app}
+		authv2.GET("/roles", rc.Index)
+		authv2.GET("/roles/:name", rc.Show)
+		authv2.POST("/roles", auth.RequiresPermission(rbac.RolesManage, nil, rc.Create))
+		authv2.PATCH("/roles/:name", auth.RequiresPermission(rbac.RolesManage, nil, rc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresPermission(rbac.RolesManage, nil, rc.Delete))
+		authv2.PATCH("/users/custom_role", auth.RequiresPermission(rbac.RolesManage, nil, rc.Assign))
+		authv2.GET("/permissions", rc.Permissions)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.POST("/external_initiators", auth.RequiresPermission(rbac.ExternalInitiatorsCreate, nil, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(rbac.ExternalInitiatorsDelete, nil, eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
+		authv2.POST("/bridge_types", auth.RequiresPermission(rbac.BridgesCreate, nil, bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.BridgesUpdate, nil, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.BridgesDelete, nil, bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
-		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers", auth.RequiresPermission(rbac.TransfersCreate, rbac.Attrs{"chain": "evm"}, ets.Create))
+		authv2.POST("/transfers/evm", auth.RequiresPermission(rbac.TransfersCreate, rbac.Attrs{"chain": "evm"}, ets.Create))
 		tts := CosmosTransfersController{app}
-		authv2.POST("/transfers/cosmos", auth.RequiresAdminRole(tts.Create))
+		authv2.POST("/transfers/cosmos", auth.RequiresPermission(rbac.TransfersCreate, rbac.Attrs{"chain": "cosmos"}, tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
+		authv2.POST("/transfers/solana", auth.RequiresPermission(rbac.TransfersCreate, rbac.Attrs{"chain": "solana"}, sts.Create))
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
@@ -290,58 +311,61 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", auth.RequiresPermission(rbac.ChainsReplay, nil, rc.ReplayFromBlock))
 		lcaC := LCAController{app}
-		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		authv2.GET("/find_lca", auth.RequiresPermission(rbac.ChainsReplay, nil, lcaC.FindLCA))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.POST("/keys/csa", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "csa"}, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "csa"}, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "csa"}, csakc.Export))
 
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.POST("/keys/eth", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "eth"}, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "eth"}, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "eth"}, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "eth"}, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
 		ethKeysGroup := authv2.Group("", auth.Authenticate(app.AuthenticationProvider(),
+			auth.AuthenticateByNamedToken(app.APITokenORM(), app.GetLogger(), app.GetAuditLogger()),
 			auth.AuthenticateByToken,
+			auth.AuthenticateByBearerToken,
 			auth.AuthenticateBySession,
 		))
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
 		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		ethKeysGroup.POST("/keys/evm", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "eth"}, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "eth"}, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "eth"}, ekc.Import))
+		ethKeysGroup.POST("/keys/evm/remote", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "eth"}, ekc.AddRemote))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "eth"}, ekc.Export))
+		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresPermission(rbac.KeysUpdate, rbac.Attrs{"type": "eth"}, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "ocr"}, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "ocr"}, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "ocr"}, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "ocr"}, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
 		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "ocr2"}, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "ocr2"}, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "ocr2"}, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "ocr2"}, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "p2p"}, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "p2p"}, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "p2p"}, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "p2p"}, p2pkc.Export))
 
 		for _, keys := range []struct {
 			path string
@@ -354,41 +378,55 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"tron", NewTronKeysController(app)},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": keys.path}, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": keys.path}, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": keys.path}, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": keys.path}, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(rbac.KeysCreate, rbac.Attrs{"type": "vrf"}, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(rbac.KeysDelete, rbac.Attrs{"type": "vrf"}, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(rbac.KeysImport, rbac.Attrs{"type": "vrf"}, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(rbac.KeysExport, rbac.Attrs{"type": "vrf"}, vrfkc.Export))
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs", auth.RequiresPermission(rbac.JobsCreate, nil, jc.Create))
+		authv2.POST("/jobs/dry_run", auth.RequiresPermission(rbac.JobsCreate, nil, jc.DryRun))
+		authv2.GET("/jobs/export", auth.RequiresPermission(rbac.JobsExport, nil, jc.Export))
+		authv2.POST("/jobs/import", auth.RequiresPermission(rbac.JobsCreate, rbac.Attrs{}, jc.Import))
+		authv2.PUT("/jobs/:ID", auth.RequiresPermission(rbac.JobsUpdate, nil, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresPermission(rbac.JobsDelete, nil, jc.Delete))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresPermission(rbac.JobsPause, nil, jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresPermission(rbac.JobsPause, nil, jc.Resume))
+
+		jvc := JobVersionsController{app}
+		authv2.GET("/jobs/:ID/versions", jvc.Index)
+		authv2.GET("/jobs/:ID/versions/:version/diff", jvc.Diff)
+		authv2.POST("/jobs/:ID/versions/:version/rollback", auth.RequiresPermission(rbac.JobsUpdate, nil, jvc.Rollback))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/errors", prc.Errors)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
+		authv2.GET("/jobs/:ID/runs/errors", prc.Errors)
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/:runID/replay", auth.RequiresPermission(rbac.RunsReplay, nil, prc.Replay))
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresPermission(rbac.RunsReplay, nil, prc.Replay))
 
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresPermission(rbac.JobsUpdate, nil, psec.Destroy))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+		authv2.PATCH("/log", auth.RequiresPermission(rbac.LogUpdate, nil, lgc.Patch))
 
 		chains := authv2.Group("chains")
 		chainController := NewChainsController(
@@ -411,8 +449,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresPermission(rbac.ForwardersManage, nil, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresPermission(rbac.ForwardersManage, nil, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
@@ -424,11 +462,23 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	ping := PingController{app}
 	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateExternalInitiator,
+		auth.AuthenticateByNamedToken(app.APITokenORM(), app.GetLogger(), app.GetAuditLogger()),
 		auth.AuthenticateByToken,
+		auth.AuthenticateByBearerToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.ResolvePermissions(app.RoleORM()))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+
+	// signed webhook requests are only accepted to trigger runs
+	userOrEIOrSigned := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+		auth.AuthenticateExternalInitiator,
+		auth.AuthenticateByNamedToken(app.APITokenORM(), app.GetLogger(), app.GetAuditLogger()),
+		auth.AuthenticateByToken,
+		auth.AuthenticateByBearerToken,
+		auth.AuthenticateBySession,
+		auth.AuthenticateBySignature,
+	), auth.ResolvePermissions(app.RoleORM()))
+	userOrEIOrSigned.POST("/jobs/:ID/runs", auth.RequiresPermission(rbac.RunsCreate, nil, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
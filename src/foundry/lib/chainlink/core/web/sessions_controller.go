@@ -1,6 +1,9 @@
 package web
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -68,6 +71,93 @@ func (sc *SessionsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, Session{Authenticated: true}, "session")
 }
 
+const (
+	// oidcStateKey is the OAuth state of the pending OIDC login in the session map
+	oidcStateKey = "oidc_state"
+	// oidcCodeVerifierKey is the PKCE code verifier of the pending OIDC login in the session map
+	oidcCodeVerifierKey = "oidc_code_verifier"
+)
+
+// OIDCLogin redirects the user to the authorization endpoint of the OIDC
+// issuer. The state and PKCE code verifier of the login are kept in the
+// session cookie until the callback.
+func (sc *SessionsController) OIDCLogin(c *gin.Context) {
+	state, err := randomURLSafeString()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	verifier, err := randomURLSafeString()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	authURL, err := sc.App.AuthenticationProvider().AuthorizationURL(c.Request.Context(), state, verifier)
+	if errors.Is(err, clsessions.ErrNotSupported) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OIDC authentication is not enabled"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set(oidcStateKey, state)
+	session.Set(oidcCodeVerifierKey, verifier)
+	if err := session.Save(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session"), err))
+		return
+	}
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback completes an OIDC login with the authorization code returned by
+// the issuer, and redirects to the operator UI with a new session.
+func (sc *SessionsController) OIDCCallback(c *gin.Context) {
+	defer sc.App.WakeSessionReaper()
+
+	session := sessions.Default(c)
+	state, _ := session.Get(oidcStateKey).(string)
+	verifier, _ := session.Get(oidcCodeVerifierKey).(string)
+	if state == "" {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("no pending OIDC login"))
+		return
+	}
+	// The state and verifier are single use
+	session.Delete(oidcStateKey)
+	session.Delete(oidcCodeVerifierKey)
+
+	if subtle.ConstantTimeCompare([]byte(state), []byte(c.Query("state"))) != 1 {
+		_ = session.Save()
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("invalid OIDC login state"))
+		return
+	}
+	if errCode := c.Query("error"); errCode != "" {
+		_ = session.Save()
+		jsonAPIError(c, http.StatusUnauthorized, fmt.Errorf("OIDC login failed: %s: %s", errCode, c.Query("error_description")))
+		return
+	}
+
+	sid, err := sc.App.AuthenticationProvider().CreateSession(c.Request.Context(), clsessions.SessionRequest{
+		AuthorizationCode: c.Query("code"),
+		CodeVerifier:      verifier,
+	})
+	if err != nil {
+		_ = session.Save()
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := saveSessionID(session, sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/")
+}
+
 // Destroy removes the specified session ID from the database.
 func (sc *SessionsController) Destroy(c *gin.Context) {
 	defer sc.App.WakeSessionReaper()
@@ -89,6 +179,15 @@ func (sc *SessionsController) Destroy(c *gin.Context) {
 	jsonAPIResponse(c, Session{Authenticated: false}, "session")
 }
 
+// randomURLSafeString returns 32 random bytes, encoded for use in a URL.
+func randomURLSafeString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func saveSessionID(session sessions.Session, sessionID string) error {
 	session.Set(auth.SessionIDKey, sessionID)
 	return session.Save()
@@ -204,3 +204,29 @@ func TestSessionsController_Destroy_ReapSessions(t *testing.T) {
 		return sessions
 	}).Should(gomega.HaveLen(0))
 }
+
+func TestSessionsController_OIDC_NotEnabled(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	request, err := http.NewRequestWithContext(ctx, "GET", app.Server.URL+"/sessions/oidc/login", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(request)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, resp.Body.Close()) }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// A callback without a pending login is rejected
+	request, err = http.NewRequestWithContext(ctx, "GET", app.Server.URL+"/sessions/oidc/callback?code=code&state=state", nil)
+	require.NoError(t, err)
+	resp2, err := client.Do(request)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, resp2.Body.Close()) }()
+	assert.Equal(t, http.StatusUnauthorized, resp2.StatusCode)
+	assert.Nil(t, web.FindSessionCookie(resp2.Cookies()))
+}